@@ -9,27 +9,216 @@ CREATE TABLE IF NOT EXISTS users (
     password_hash BYTEA NOT NULL                   
 );
 
+CREATE TABLE IF NOT EXISTS city (
+    id UUID PRIMARY KEY,
+    name TEXT NOT NULL UNIQUE,
+    aliases TEXT[] NOT NULL DEFAULT '{}',
+    enabled BOOLEAN NOT NULL DEFAULT true
+);
+
+INSERT INTO city (id, name, aliases)
+VALUES
+(uuid_generate_v4(), 'Москва', '{}'),
+(uuid_generate_v4(), 'Санкт-Петербург', '{"Санкт Петербург"}'),
+(uuid_generate_v4(), 'Казань', '{}');
+
+CREATE TYPE pvz_status AS ENUM ('active', 'suspended', 'closed');
 CREATE TABLE IF NOT EXISTS pvz (
     id UUID PRIMARY KEY,
     registration_date DATE NOT NULL DEFAULT now(),
-    city TEXT NOT NULL
+    city TEXT NOT NULL,
+    address TEXT NOT NULL DEFAULT '',
+    status pvz_status NOT NULL DEFAULT 'active',
+    metadata JSONB NOT NULL DEFAULT '{}',
+    version INT NOT NULL DEFAULT 1,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    closed_at TIMESTAMPTZ,
+    capacity_items INT CHECK (capacity_items > 0),
+    capacity_volume_cm3 BIGINT CHECK (capacity_volume_cm3 > 0),
+    capacity_weight_grams BIGINT CHECK (capacity_weight_grams > 0),
+    timezone TEXT NOT NULL DEFAULT 'Europe/Moscow',
+    schedule_override_until TIMESTAMPTZ,
+    latitude DOUBLE PRECISION CHECK (latitude BETWEEN -90 AND 90),
+    longitude DOUBLE PRECISION CHECK (longitude BETWEEN -180 AND 180),
+    CHECK ((latitude IS NULL) = (longitude IS NULL))
 );
+CREATE INDEX IF NOT EXISTS pvz_registration_date_id_idx ON pvz (registration_date, id);
+-- Поиск ближайших ПВЗ сначала отсекает точки вне полосы широт радиуса
+CREATE INDEX IF NOT EXISTS pvz_latitude_idx ON pvz (latitude) WHERE latitude IS NOT NULL;
+
+-- Недельный график ПВЗ в его часовом поясе; день без строки — выходной
+CREATE TABLE IF NOT EXISTS pvz_working_hours (
+    pvz_id UUID NOT NULL REFERENCES pvz(id) ON DELETE CASCADE,
+    weekday SMALLINT NOT NULL CHECK (weekday BETWEEN 1 AND 7),
+    opens_at TIME NOT NULL,
+    closes_at TIME NOT NULL,
+    PRIMARY KEY (pvz_id, weekday),
+    CHECK (opens_at < closes_at)
+);
+
+-- Исключения из графика: без времени ПВЗ не работает весь день
+CREATE TABLE IF NOT EXISTS pvz_holiday (
+    pvz_id UUID NOT NULL REFERENCES pvz(id) ON DELETE CASCADE,
+    day DATE NOT NULL,
+    opens_at TIME,
+    closes_at TIME,
+    note TEXT NOT NULL DEFAULT '',
+    PRIMARY KEY (pvz_id, day),
+    CHECK ((opens_at IS NULL) = (closes_at IS NULL)),
+    CHECK (opens_at < closes_at)
+);
+
+-- Сотрудник может вести приёмку и выдачу только в закреплённых за ним ПВЗ
+CREATE TABLE IF NOT EXISTS pvz_assignment (
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    pvz_id UUID NOT NULL REFERENCES pvz(id) ON DELETE CASCADE,
+    assigned_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (user_id, pvz_id)
+);
+CREATE INDEX IF NOT EXISTS pvz_assignment_pvz_id_idx ON pvz_assignment (pvz_id);
 
 CREATE TYPE reception_status AS ENUM ('in_progress', 'close');
+-- created_by/closed_by/product.created_by — зарегистрированный пользователь или NULL (токены без sub)
 CREATE TABLE IF NOT EXISTS reception (
     id UUID PRIMARY KEY,
     reception_time TIMESTAMPTZ NOT NULL DEFAULT now(),
     pvz_id UUID NOT NULL REFERENCES pvz(id) ON DELETE CASCADE,
-    status reception_status NOT NULL
+    status reception_status NOT NULL,
+    closed_at TIMESTAMPTZ,
+    created_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    closed_by UUID REFERENCES users(id) ON DELETE SET NULL
+);
+CREATE INDEX IF NOT EXISTS reception_pvz_id_time_idx ON reception (pvz_id, reception_time);
+CREATE INDEX IF NOT EXISTS reception_time_idx ON reception (reception_time);
+CREATE UNIQUE INDEX IF NOT EXISTS reception_one_active_per_pvz_idx ON reception (pvz_id) WHERE status = 'in_progress';
+
+CREATE TABLE IF NOT EXISTS product_type (
+    code TEXT PRIMARY KEY,
+    names JSONB NOT NULL,
+    active BOOLEAN NOT NULL DEFAULT true
 );
 
-CREATE TYPE product_category AS ENUM ('электроника', 'одежда', 'обувь');
+INSERT INTO product_type (code, names)
+VALUES
+('электроника', '{"ru": "Электроника", "en": "Electronics"}'),
+('одежда', '{"ru": "Одежда", "en": "Clothes"}'),
+('обувь', '{"ru": "Обувь", "en": "Shoes"}');
+
+CREATE TYPE product_status AS ENUM ('received', 'ready_for_pickup', 'issued', 'returned');
 CREATE TABLE IF NOT EXISTS product (
     id UUID PRIMARY KEY,
     reception_time TIMESTAMPTZ NOT NULL DEFAULT now(),
     reception_id UUID NOT NULL REFERENCES reception(id) ON DELETE CASCADE,
-    category TEXT NOT NULL
+    category TEXT NOT NULL REFERENCES product_type(code) ON UPDATE CASCADE,
+    barcode TEXT,
+    barcode_format TEXT CHECK (barcode_format IN ('ean13', 'code128')),
+    sku TEXT,
+    order_number TEXT,
+    weight_grams INT CHECK (weight_grams > 0),
+    length_mm INT CHECK (length_mm > 0),
+    width_mm INT CHECK (width_mm > 0),
+    height_mm INT CHECK (height_mm > 0),
+    deleted_at TIMESTAMPTZ,
+    delete_reason TEXT CHECK (delete_reason IN ('mis_scan', 'duplicate', 'damaged', 'other')),
+    status product_status NOT NULL DEFAULT 'received',
+    status_changed_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    pickup_code TEXT,
+    created_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    CHECK ((deleted_at IS NULL) = (delete_reason IS NULL)),
+    CHECK ((status = 'ready_for_pickup') = (pickup_code IS NOT NULL))
+);
+CREATE INDEX IF NOT EXISTS product_barcode_idx ON product (barcode) WHERE barcode IS NOT NULL;
+CREATE INDEX IF NOT EXISTS product_pickup_code_idx ON product (pickup_code) WHERE pickup_code IS NOT NULL;
+CREATE INDEX IF NOT EXISTS product_in_stock_idx ON product (reception_id, reception_time, id) WHERE status IN ('received', 'ready_for_pickup') AND deleted_at IS NULL;
+
+CREATE TABLE IF NOT EXISTS product_status_history (
+    id BIGSERIAL PRIMARY KEY,
+    product_id UUID NOT NULL REFERENCES product(id) ON DELETE CASCADE,
+    pvz_id UUID NOT NULL REFERENCES pvz(id),
+    from_status product_status NOT NULL,
+    to_status product_status NOT NULL,
+    reason TEXT,
+    changed_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+CREATE INDEX IF NOT EXISTS product_status_history_product_idx ON product_status_history (product_id, id);
+CREATE INDEX IF NOT EXISTS product_status_history_pvz_idx ON product_status_history (pvz_id, changed_at);
+
+CREATE TABLE IF NOT EXISTS idempotency_key (
+    scope TEXT NOT NULL,
+    key TEXT NOT NULL,
+    request_hash BYTEA NOT NULL,
+    status_code INT,
+    response_body BYTEA,
+    content_type TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    expires_at TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (scope, key)
+);
+CREATE INDEX IF NOT EXISTS idempotency_key_expires_at_idx ON idempotency_key (expires_at);
+
+CREATE TABLE IF NOT EXISTS outbox (
+    id BIGSERIAL PRIMARY KEY,
+    event_id UUID NOT NULL UNIQUE,
+    event_type TEXT NOT NULL,
+    aggregate_id UUID NOT NULL,
+    payload JSONB NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    published_at TIMESTAMPTZ,
+    attempts INT NOT NULL DEFAULT 0,
+    last_error TEXT
+);
+CREATE INDEX IF NOT EXISTS outbox_pending_idx ON outbox (id) WHERE published_at IS NULL;
+CREATE INDEX IF NOT EXISTS outbox_aggregate_idx ON outbox (aggregate_id, id);
+
+-- Уведомление несёт только позицию события: тело читается из outbox, так что лимит NOTIFY не мешает
+CREATE OR REPLACE FUNCTION notify_outbox_event() RETURNS trigger AS $$
+BEGIN
+    PERFORM pg_notify('pvz_events', NEW.aggregate_id::text || ':' || NEW.id::text);
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER outbox_notify AFTER INSERT ON outbox
+FOR EACH ROW EXECUTE FUNCTION notify_outbox_event();
+
+CREATE TABLE IF NOT EXISTS webhook (
+    id UUID PRIMARY KEY,
+    url TEXT NOT NULL,
+    secret TEXT NOT NULL,
+    event_types TEXT[] NOT NULL,
+    pvz_id UUID REFERENCES pvz(id) ON DELETE CASCADE,
+    city TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    CHECK ((pvz_id IS NULL) <> (city IS NULL))
+);
+
+CREATE TYPE webhook_delivery_status AS ENUM ('pending', 'delivered', 'dead');
+CREATE TABLE IF NOT EXISTS webhook_delivery (
+    id UUID PRIMARY KEY,
+    webhook_id UUID NOT NULL REFERENCES webhook(id) ON DELETE CASCADE,
+    event_id UUID NOT NULL,
+    event_type TEXT NOT NULL,
+    payload JSONB NOT NULL,
+    status webhook_delivery_status NOT NULL DEFAULT 'pending',
+    attempts INT NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    last_status_code INT,
+    last_error TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    delivered_at TIMESTAMPTZ,
+    UNIQUE (webhook_id, event_id)
+);
+CREATE INDEX IF NOT EXISTS webhook_delivery_due_idx ON webhook_delivery (next_attempt_at) WHERE status = 'pending';
+
+CREATE TABLE IF NOT EXISTS webhook_attempt (
+    id BIGSERIAL PRIMARY KEY,
+    delivery_id UUID NOT NULL REFERENCES webhook_delivery(id) ON DELETE CASCADE,
+    attempted_at TIMESTAMPTZ NOT NULL,
+    status_code INT,
+    error TEXT,
+    duration_ms BIGINT NOT NULL
 );
+CREATE INDEX IF NOT EXISTS webhook_attempt_delivery_idx ON webhook_attempt (delivery_id, attempted_at);
 
 INSERT INTO users (id, email, role, password_hash)
 VALUES
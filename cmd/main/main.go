@@ -6,28 +6,70 @@ import (
 	"io"
 	"log"
 	"log/slog"
+	"net"
 	"net/http"
 	"os"
 	"os/signal"
+	"strconv"
 	"syscall"
 	"time"
 
 	"github.com/K1tten2005/avito_pvz/internal/middleware/acl"
 	"github.com/K1tten2005/avito_pvz/internal/middleware/cors"
 	"github.com/K1tten2005/avito_pvz/internal/middleware/csp"
+	"github.com/K1tten2005/avito_pvz/internal/middleware/idempotencymw"
 	"github.com/K1tten2005/avito_pvz/internal/middleware/logger"
 	"github.com/K1tten2005/avito_pvz/internal/middleware/metricsmw"
 	"github.com/gorilla/mux"
 	"github.com/jackc/pgx/v4/pgxpool"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"google.golang.org/grpc"
 
+	assignmentHandler "github.com/K1tten2005/avito_pvz/internal/pkg/assignment/delivery/http"
+	assignmentRepo "github.com/K1tten2005/avito_pvz/internal/pkg/assignment/repo"
+	assignmentUsecase "github.com/K1tten2005/avito_pvz/internal/pkg/assignment/usecase"
 	authHandler "github.com/K1tten2005/avito_pvz/internal/pkg/auth/delivery/http"
 	authRepo "github.com/K1tten2005/avito_pvz/internal/pkg/auth/repo"
 	authUsecase "github.com/K1tten2005/avito_pvz/internal/pkg/auth/usecase"
+	cityHandler "github.com/K1tten2005/avito_pvz/internal/pkg/city/delivery/http"
+	cityRepo "github.com/K1tten2005/avito_pvz/internal/pkg/city/repo"
+	cityUsecase "github.com/K1tten2005/avito_pvz/internal/pkg/city/usecase"
+	eventBroker "github.com/K1tten2005/avito_pvz/internal/pkg/eventstream/broker"
+	eventHandler "github.com/K1tten2005/avito_pvz/internal/pkg/eventstream/delivery/http"
+	eventRepo "github.com/K1tten2005/avito_pvz/internal/pkg/eventstream/repo"
+	eventUsecase "github.com/K1tten2005/avito_pvz/internal/pkg/eventstream/usecase"
+	exportHandler "github.com/K1tten2005/avito_pvz/internal/pkg/export/delivery/http"
+	exportRepo "github.com/K1tten2005/avito_pvz/internal/pkg/export/repo"
+	exportUsecase "github.com/K1tten2005/avito_pvz/internal/pkg/export/usecase"
+	idempotencyRepo "github.com/K1tten2005/avito_pvz/internal/pkg/idempotency/repo"
 	"github.com/K1tten2005/avito_pvz/internal/pkg/metrics"
+	"github.com/K1tten2005/avito_pvz/internal/pkg/outbox"
+	outboxRepo "github.com/K1tten2005/avito_pvz/internal/pkg/outbox/repo"
+	outboxSink "github.com/K1tten2005/avito_pvz/internal/pkg/outbox/sink"
+	outboxUsecase "github.com/K1tten2005/avito_pvz/internal/pkg/outbox/usecase"
+	pickupHandler "github.com/K1tten2005/avito_pvz/internal/pkg/pickup/delivery/http"
+	pickupRepo "github.com/K1tten2005/avito_pvz/internal/pkg/pickup/repo"
+	pickupUsecase "github.com/K1tten2005/avito_pvz/internal/pkg/pickup/usecase"
+	productTypeHandler "github.com/K1tten2005/avito_pvz/internal/pkg/product_type/delivery/http"
+	productTypeRepo "github.com/K1tten2005/avito_pvz/internal/pkg/product_type/repo"
+	productTypeUsecase "github.com/K1tten2005/avito_pvz/internal/pkg/product_type/usecase"
+	pvzGrpc "github.com/K1tten2005/avito_pvz/internal/pkg/pvz/delivery/grpc"
+	pvzGen "github.com/K1tten2005/avito_pvz/internal/pkg/pvz/delivery/grpc/gen"
 	pvzHandler "github.com/K1tten2005/avito_pvz/internal/pkg/pvz/delivery/http"
 	pvzRepo "github.com/K1tten2005/avito_pvz/internal/pkg/pvz/repo"
 	pvzUsecase "github.com/K1tten2005/avito_pvz/internal/pkg/pvz/usecase"
+	reportHandler "github.com/K1tten2005/avito_pvz/internal/pkg/report/delivery/http"
+	reportRepo "github.com/K1tten2005/avito_pvz/internal/pkg/report/repo"
+	reportUsecase "github.com/K1tten2005/avito_pvz/internal/pkg/report/usecase"
+	scheduleHandler "github.com/K1tten2005/avito_pvz/internal/pkg/schedule/delivery/http"
+	scheduleRepo "github.com/K1tten2005/avito_pvz/internal/pkg/schedule/repo"
+	scheduleUsecase "github.com/K1tten2005/avito_pvz/internal/pkg/schedule/usecase"
+	stockHandler "github.com/K1tten2005/avito_pvz/internal/pkg/stock/delivery/http"
+	stockRepo "github.com/K1tten2005/avito_pvz/internal/pkg/stock/repo"
+	stockUsecase "github.com/K1tten2005/avito_pvz/internal/pkg/stock/usecase"
+	webhookHandler "github.com/K1tten2005/avito_pvz/internal/pkg/webhook/delivery/http"
+	webhookRepo "github.com/K1tten2005/avito_pvz/internal/pkg/webhook/repo"
+	webhookUsecase "github.com/K1tten2005/avito_pvz/internal/pkg/webhook/usecase"
 )
 
 func initDB(logger *slog.Logger) (*pgxpool.Pool, error) {
@@ -49,6 +91,58 @@ func initDB(logger *slog.Logger) (*pgxpool.Pool, error) {
 	return pool, nil
 }
 
+const (
+	defaultUndoWindow        = 5 * time.Minute
+	defaultIdempotencyTTL    = 24 * time.Hour
+	defaultCapacityWarnRatio = 0.9
+
+	capacityReportInterval = time.Minute
+
+	outboxRelayInterval = time.Second
+	outboxBatchSize     = 100
+	outboxHTTPTimeout   = 10 * time.Second
+
+	webhookDispatchInterval = time.Second
+	webhookBatchSize        = 10
+	webhookHTTPTimeout      = 5 * time.Second
+	webhookLease            = time.Minute
+	webhookMaxAttempts      = 8
+	webhookBaseBackoff      = 10 * time.Second
+	webhookMaxBackoff       = time.Hour
+
+	eventStreamHeartbeat = 15 * time.Second
+)
+
+// initOutboxSink выбирает внешнего получателя событий по OUTBOX_SINK; nil — без внешнего получателя
+func initOutboxSink() (outbox.Sink, error) {
+	switch kind := os.Getenv("OUTBOX_SINK"); kind {
+	case "":
+		return nil, nil
+	case "file":
+		return outboxSink.CreateFileSink(os.Getenv("OUTBOX_FILE_PATH"))
+	case "http":
+		return outboxSink.CreateHTTPSink(os.Getenv("OUTBOX_HTTP_URL"), outboxHTTPTimeout), nil
+	default:
+		return nil, fmt.Errorf("unknown OUTBOX_SINK %q", kind)
+	}
+}
+
+func durationFromEnv(key string, def time.Duration) (time.Duration, error) {
+	value := os.Getenv(key)
+	if value == "" {
+		return def, nil
+	}
+	return time.ParseDuration(value)
+}
+
+func floatFromEnv(key string, def float64) (float64, error) {
+	value := os.Getenv(key)
+	if value == "" {
+		return def, nil
+	}
+	return strconv.ParseFloat(value, 64)
+}
+
 func main() {
 	logFile, err := os.OpenFile(os.Getenv("MAIN_LOG_FILE"), os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0644)
 	if err != nil {
@@ -59,8 +153,6 @@ func main() {
 
 	loggerVar := slog.New(slog.NewJSONHandler(io.MultiWriter(logFile, os.Stdout), &slog.HandlerOptions{Level: slog.LevelInfo}))
 
-	acl.InitACL(loggerVar)
-
 	pool, err := initDB(loggerVar)
 	if err != nil {
 		loggerVar.Error("Error while connecting to PostgreSQL: " + err.Error())
@@ -79,9 +171,122 @@ func main() {
 	authUsecase := authUsecase.CreateAuthUsecase(authRepo)
 	authHandler := authHandler.CreateAuthHandler(authUsecase)
 
+	cityRepo := cityRepo.CreateCityRepo(pool)
+	cityUsecase := cityUsecase.CreateCityUsecase(cityRepo)
+	cityHandler := cityHandler.CreateCityHandler(cityUsecase)
+
+	if err := cityUsecase.RefreshCache(context.Background()); err != nil {
+		loggerVar.Error("Error while loading city catalog: " + err.Error())
+		return
+	}
+
+	productTypeRepo := productTypeRepo.CreateProductTypeRepo(pool)
+	productTypeUsecase := productTypeUsecase.CreateProductTypeUsecase(productTypeRepo)
+	productTypeHandler := productTypeHandler.CreateProductTypeHandler(productTypeUsecase)
+
+	if err := productTypeUsecase.RefreshCache(context.Background()); err != nil {
+		loggerVar.Error("Error while loading product type catalog: " + err.Error())
+		return
+	}
+
+	bgCtx, bgCancel := context.WithCancel(context.Background())
+	defer bgCancel()
+
+	go cityUsecase.RunCacheRefresher(bgCtx, time.Minute)
+	go productTypeUsecase.RunCacheRefresher(bgCtx, time.Minute)
+
+	undoWindow, err := durationFromEnv("PRODUCT_UNDO_WINDOW", defaultUndoWindow)
+	if err != nil {
+		loggerVar.Error("Error while parsing PRODUCT_UNDO_WINDOW: " + err.Error())
+		return
+	}
+
+	capacityWarnRatio, err := floatFromEnv("PVZ_CAPACITY_WARN_RATIO", defaultCapacityWarnRatio)
+	if err != nil {
+		loggerVar.Error("Error while parsing PVZ_CAPACITY_WARN_RATIO: " + err.Error())
+		return
+	}
+
+	capacityMetrics, err := metrics.NewCapacityMetrics()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	outboxRepo := outboxRepo.CreateOutboxRepo(pool)
+
+	webhookRepo := webhookRepo.CreateWebhookRepo(pool)
+	webhookUsecase := webhookUsecase.CreateWebhookUsecase(webhookRepo, &http.Client{Timeout: webhookHTTPTimeout}, webhookUsecase.Config{
+		MaxAttempts: webhookMaxAttempts,
+		BaseBackoff: webhookBaseBackoff,
+		MaxBackoff:  webhookMaxBackoff,
+		BatchSize:   webhookBatchSize,
+		Lease:       webhookLease,
+	})
+	webhookHandler := webhookHandler.CreateWebhookHandler(webhookUsecase)
+
+	sinks := []outbox.Sink{webhookUsecase}
+	sink, err := initOutboxSink()
+	if err != nil {
+		loggerVar.Error("Error while creating outbox sink: " + err.Error())
+		return
+	}
+	if sink != nil {
+		sinks = append(sinks, sink)
+	}
+
+	relay := outboxUsecase.CreateRelayUsecase(outboxRepo, outboxSink.CreateMultiSink(sinks...), outboxBatchSize)
+	go relay.RunRelay(bgCtx, outboxRelayInterval)
+	go webhookUsecase.RunDispatcher(bgCtx, webhookDispatchInterval)
+
+	scheduleRepo := scheduleRepo.CreateScheduleRepo(pool)
+	scheduleUsecase := scheduleUsecase.CreateScheduleUsecase(scheduleRepo)
+	scheduleHandler := scheduleHandler.CreateScheduleHandler(scheduleUsecase)
+
 	pvzRepo := pvzRepo.CreatePvzRepo(pool)
-	pvzUsecase := pvzUsecase.CreatePvzUsecase(pvzRepo)
+	pvzUsecase := pvzUsecase.CreatePvzUsecase(pvzRepo, outboxRepo, scheduleUsecase, pvzUsecase.Config{
+		UndoWindow:        undoWindow,
+		CapacityWarnRatio: capacityWarnRatio,
+	})
 	pvzHandler := pvzHandler.CreatePvzHandler(pvzUsecase, mt0)
+	go pvzUsecase.RunUtilizationReporter(bgCtx, capacityReportInterval, capacityMetrics.SetUtilization)
+
+	idempotencyTTL, err := durationFromEnv("IDEMPOTENCY_TTL", defaultIdempotencyTTL)
+	if err != nil {
+		loggerVar.Error("Error while parsing IDEMPOTENCY_TTL: " + err.Error())
+		return
+	}
+
+	pickupRepo := pickupRepo.CreatePickupRepo(pool)
+	pickupUsecase := pickupUsecase.CreatePickupUsecase(pickupRepo, outboxRepo)
+	pickupHandler := pickupHandler.CreatePickupHandler(pickupUsecase)
+
+	reportRepo := reportRepo.CreateReportRepo(pool)
+	reportUsecase := reportUsecase.CreateReportUsecase(reportRepo)
+	reportHandler := reportHandler.CreateReportHandler(reportUsecase)
+
+	assignmentRepo := assignmentRepo.CreateAssignmentRepo(pool)
+	assignmentUsecase := assignmentUsecase.CreateAssignmentUsecase(assignmentRepo)
+	assignmentHandler := assignmentHandler.CreateAssignmentHandler(assignmentUsecase)
+	acl.InitACL(loggerVar, assignmentUsecase, pvzRepo)
+
+	stockRepo := stockRepo.CreateStockRepo(pool)
+	stockUsecase := stockUsecase.CreateStockUsecase(stockRepo)
+	stockHandler := stockHandler.CreateStockHandler(stockUsecase)
+
+	exportRepo := exportRepo.CreateExportRepo(pool)
+	exportUsecase := exportUsecase.CreateExportUsecase(exportRepo)
+	exportHandler := exportHandler.CreateExportHandler(exportUsecase)
+
+	broker := eventBroker.CreatePgBroker()
+	go broker.Listen(bgCtx, pool.Config().ConnConfig)
+
+	eventRepo := eventRepo.CreateEventRepo(pool)
+	eventUsecase := eventUsecase.CreateStreamUsecase(eventRepo, broker, eventStreamHeartbeat)
+	eventHandler := eventHandler.CreateEventStreamHandler(eventUsecase)
+
+	idempotencyRepo := idempotencyRepo.CreateIdempotencyRepo(pool)
+	idem := idempotencymw.CreateIdempotencyMiddleware(idempotencyRepo, idempotencyTTL)
+	go idem.RunCleaner(bgCtx, time.Hour)
 
 	met, err := metrics.NewHttpMetrics()
 	if err != nil {
@@ -123,12 +328,59 @@ func main() {
 		acl.ACLMiddleware,
 	)
 
-	protectedRoutes.HandleFunc("/pvz", pvzHandler.CreatePvz).Methods(http.MethodPost)
+	protectedRoutes.HandleFunc("/pvz", idem.Wrap(pvzHandler.CreatePvz)).Methods(http.MethodPost)
+	protectedRoutes.HandleFunc("/pvz/import", idem.Wrap(pvzHandler.ImportPvz)).Methods(http.MethodPost)
+	// Accept: text/csv отдаёт плоскую выгрузку вместо вложенного JSON
+	protectedRoutes.HandleFunc("/pvz", exportHandler.ExportCSV).Methods(http.MethodGet).HeadersRegexp("Accept", "text/csv")
 	protectedRoutes.HandleFunc("/pvz", pvzHandler.GetPvz).Methods(http.MethodGet)
-	protectedRoutes.HandleFunc("/receptions", pvzHandler.CreateReception).Methods(http.MethodPost)
-	protectedRoutes.HandleFunc("/products", pvzHandler.AddProduct).Methods(http.MethodPost)
-	protectedRoutes.HandleFunc("/pvz/{pvzId}/delete_last_product", pvzHandler.DeleteProduct).Methods(http.MethodPost)
-	protectedRoutes.HandleFunc("/pvz/{pvzId}/close_last_reception", pvzHandler.CloseReception).Methods(http.MethodPost)
+	protectedRoutes.HandleFunc("/pvz/nearby", pvzHandler.FindNearbyPvz).Methods(http.MethodGet)
+	protectedRoutes.HandleFunc("/pvz/{pvzId}", pvzHandler.GetPvzByID).Methods(http.MethodGet)
+	protectedRoutes.HandleFunc("/pvz/{pvzId}", idem.Wrap(pvzHandler.UpdatePvz)).Methods(http.MethodPatch)
+	protectedRoutes.HandleFunc("/pvz/{pvzId}/receptions/active", pvzHandler.GetActiveReception).Methods(http.MethodGet)
+	protectedRoutes.HandleFunc("/pvz/{pvzId}/events", eventHandler.StreamPvzEvents).Methods(http.MethodGet)
+	protectedRoutes.HandleFunc("/receptions/{id}", pvzHandler.GetReception).Methods(http.MethodGet)
+	protectedRoutes.HandleFunc("/receptions/{id}/act.pdf", pvzHandler.GetReceptionAct).Methods(http.MethodGet)
+	protectedRoutes.HandleFunc("/cities", cityHandler.GetCities).Methods(http.MethodGet)
+	protectedRoutes.HandleFunc("/cities", cityHandler.CreateCity).Methods(http.MethodPost)
+	protectedRoutes.HandleFunc("/cities/{id}", cityHandler.UpdateCity).Methods(http.MethodPatch)
+	protectedRoutes.HandleFunc("/cities/{id}", cityHandler.DeleteCity).Methods(http.MethodDelete)
+	protectedRoutes.HandleFunc("/product_types", productTypeHandler.GetProductTypes).Methods(http.MethodGet)
+	protectedRoutes.HandleFunc("/product_types", productTypeHandler.CreateProductType).Methods(http.MethodPost)
+	protectedRoutes.HandleFunc("/product_types/{code}", productTypeHandler.UpdateProductType).Methods(http.MethodPatch)
+	protectedRoutes.HandleFunc("/receptions", idem.Wrap(pvzHandler.CreateReception)).Methods(http.MethodPost)
+	protectedRoutes.HandleFunc("/products", idem.Wrap(pvzHandler.AddProduct)).Methods(http.MethodPost)
+	protectedRoutes.HandleFunc("/products", pvzHandler.FindProducts).Methods(http.MethodGet)
+	protectedRoutes.HandleFunc("/products/batch", idem.Wrap(pvzHandler.AddProducts)).Methods(http.MethodPost)
+	protectedRoutes.HandleFunc("/products/{productId}", idem.Wrap(pvzHandler.DeleteProductByID)).Methods(http.MethodDelete)
+	protectedRoutes.HandleFunc("/products/{productId}/restore", idem.Wrap(pvzHandler.RestoreProduct)).Methods(http.MethodPost)
+	protectedRoutes.HandleFunc("/pvz/{pvzId}/delete_last_product", idem.Wrap(pvzHandler.DeleteProduct)).Methods(http.MethodPost)
+	protectedRoutes.HandleFunc("/pvz/{pvzId}/close_last_reception", idem.Wrap(pvzHandler.CloseReception)).Methods(http.MethodPost)
+	protectedRoutes.HandleFunc("/pvz/{pvzId}/ready_for_pickup", idem.Wrap(pickupHandler.PreparePickup)).Methods(http.MethodPost)
+	protectedRoutes.HandleFunc("/pvz/{pvzId}/issue", idem.Wrap(pickupHandler.IssueProducts)).Methods(http.MethodPost)
+	protectedRoutes.HandleFunc("/pvz/{pvzId}/return", idem.Wrap(pickupHandler.ReturnProducts)).Methods(http.MethodPost)
+	protectedRoutes.HandleFunc("/pvz/{pvzId}/history", pickupHandler.GetPvzHistory).Methods(http.MethodGet)
+	protectedRoutes.HandleFunc("/pvz/{pvzId}/stock", stockHandler.GetStock).Methods(http.MethodGet)
+	protectedRoutes.HandleFunc("/pvz/{pvzId}/schedule", scheduleHandler.GetSchedule).Methods(http.MethodGet)
+	protectedRoutes.HandleFunc("/pvz/{pvzId}/schedule", scheduleHandler.UpdateSchedule).Methods(http.MethodPut)
+	protectedRoutes.HandleFunc("/pvz/{pvzId}/schedule/override", scheduleHandler.SetOverride).Methods(http.MethodPut)
+	protectedRoutes.HandleFunc("/pvz/{pvzId}/schedule/override", scheduleHandler.ClearOverride).Methods(http.MethodDelete)
+	protectedRoutes.HandleFunc("/pvz/{pvzId}/holidays", scheduleHandler.GetHolidays).Methods(http.MethodGet)
+	protectedRoutes.HandleFunc("/pvz/{pvzId}/holidays/{date}", scheduleHandler.PutHoliday).Methods(http.MethodPut)
+	protectedRoutes.HandleFunc("/pvz/{pvzId}/holidays/{date}", scheduleHandler.DeleteHoliday).Methods(http.MethodDelete)
+	protectedRoutes.HandleFunc("/products/{productId}/history", pickupHandler.GetProductHistory).Methods(http.MethodGet)
+	protectedRoutes.HandleFunc("/employees/{userId}/pvz", assignmentHandler.GetAssignments).Methods(http.MethodGet)
+	protectedRoutes.HandleFunc("/employees/{userId}/pvz/{pvzId}", assignmentHandler.Assign).Methods(http.MethodPut)
+	protectedRoutes.HandleFunc("/employees/{userId}/pvz/{pvzId}", assignmentHandler.Unassign).Methods(http.MethodDelete)
+	protectedRoutes.HandleFunc("/exports/receptions.csv", exportHandler.ExportCSV).Methods(http.MethodGet)
+	protectedRoutes.HandleFunc("/exports/receptions.xlsx", exportHandler.ExportXLSX).Methods(http.MethodGet)
+	protectedRoutes.HandleFunc("/reports/intake", reportHandler.GetIntakeReport).Methods(http.MethodGet)
+	protectedRoutes.HandleFunc("/webhooks", webhookHandler.GetWebhooks).Methods(http.MethodGet)
+	protectedRoutes.HandleFunc("/webhooks", webhookHandler.CreateWebhook).Methods(http.MethodPost)
+	protectedRoutes.HandleFunc("/webhooks/dead_letters", webhookHandler.GetDeadLetters).Methods(http.MethodGet)
+	protectedRoutes.HandleFunc("/webhooks/deliveries/{id}", webhookHandler.GetDelivery).Methods(http.MethodGet)
+	protectedRoutes.HandleFunc("/webhooks/deliveries/{id}/redeliver", webhookHandler.Redeliver).Methods(http.MethodPost)
+	protectedRoutes.HandleFunc("/webhooks/{id}", webhookHandler.DeleteWebhook).Methods(http.MethodDelete)
+	protectedRoutes.HandleFunc("/webhooks/{id}/deliveries", webhookHandler.GetDeliveries).Methods(http.MethodGet)
 
 
 
@@ -147,12 +399,31 @@ func main() {
 		}
 	}()
 
+	grpcSrv := grpc.NewServer(grpc.ChainUnaryInterceptor(
+		logger.CreateLoggerInterceptor(loggerVar),
+		acl.ACLInterceptor(),
+	))
+	pvzGen.RegisterPvzServiceServer(grpcSrv, pvzGrpc.CreatePvzServer(pvzUsecase))
+
+	go func() {
+		lis, err := net.Listen("tcp", ":3000")
+		if err != nil {
+			loggerVar.Error("Error while starting gRPC server: " + err.Error())
+			return
+		}
+		if err := grpcSrv.Serve(lis); err != nil {
+			loggerVar.Error("Error while serving gRPC: " + err.Error())
+		}
+	}()
+
 	stop := make(chan os.Signal, 1)
 	signal.Notify(stop, os.Interrupt, syscall.SIGTERM)
 
 	<-stop
 	loggerVar.Info("Got stop signal")
 
+	grpcSrv.GracefulStop()
+
 	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
 	defer cancel()
 
@@ -1,35 +1,170 @@
 package acl
 
 import (
+	"bytes"
+	"context"
+	"encoding/json"
 	"errors"
+	"fmt"
+	"io"
 	"log/slog"
 	"net/http"
 	"os"
+	"time"
 
+	"github.com/K1tten2005/avito_pvz/internal/models"
+	"github.com/K1tten2005/avito_pvz/internal/pkg/pvz"
 	"github.com/K1tten2005/avito_pvz/internal/pkg/utils/jwtUtils"
 	"github.com/K1tten2005/avito_pvz/internal/pkg/utils/logger"
+	"github.com/K1tten2005/avito_pvz/internal/pkg/utils/principal"
 	"github.com/K1tten2005/avito_pvz/internal/pkg/utils/send_err"
 	"github.com/casbin/casbin/v2"
 	"github.com/golang-jwt/jwt"
+	"github.com/gorilla/mux"
+	"github.com/satori/uuid"
+)
+
+const (
+	// assignmentCheckTimeout ограничивает проверку назначения сотрудника на ПВЗ
+	assignmentCheckTimeout = 3 * time.Second
+	// maxScopeBodySize ограничивает тело, которое ACL читает ради поля pvzId
+	maxScopeBodySize = 1 << 20
 )
 
 var Enforcer *casbin.Enforcer
 
-func InitACL(logger *slog.Logger) error {
+// products находит ПВЗ товара на маршрутах /products/{productId}
+var products ProductLocator
+
+// AssignmentChecker отвечает, закреплён ли сотрудник за ПВЗ
+type AssignmentChecker interface {
+	IsAssigned(ctx context.Context, userID, pvzID uuid.UUID) (bool, error)
+}
+
+// ProductLocator возвращает ПВЗ приёмки, в которую принят товар
+type ProductLocator interface {
+	GetProductPvzID(ctx context.Context, productID uuid.UUID) (uuid.UUID, error)
+}
+
+func InitACL(logger *slog.Logger, assignments AssignmentChecker, locator ProductLocator) error {
 	modelPath := "internal/middleware/acl/model.conf"
 	policyPath := "internal/middleware/acl/policy.csv"
 
-	e, err := casbin.NewEnforcer(modelPath, policyPath)
+	e, err := newEnforcer(modelPath, policyPath, assignments)
 	if err != nil {
 		logger.Error(err.Error())
 		return err
 	}
 
 	Enforcer = e
+	products = locator
 	logger.Info("Successfully launched ACL")
 	return nil
 }
 
+func newEnforcer(modelPath, policyPath string, assignments AssignmentChecker) (*casbin.Enforcer, error) {
+	e, err := casbin.NewEnforcer(modelPath, policyPath)
+	if err != nil {
+		return nil, err
+	}
+	e.AddFunction("isAssigned", isAssignedFunc(assignments))
+	return e, nil
+}
+
+// isAssignedFunc — функция матчера isAssigned(r.ctx, r.uid, r.pvz) для политик со scope assigned.
+// Токен без id пользователя или запрос без ПВЗ получают отказ, а не ошибку.
+func isAssignedFunc(assignments AssignmentChecker) func(args ...any) (any, error) {
+	return func(args ...any) (any, error) {
+		if len(args) != 3 {
+			return false, errors.New("isAssigned expects context, user id and pvz id")
+		}
+		ctx, ok := args[0].(context.Context)
+		if !ok {
+			return false, errors.New("isAssigned expects request context")
+		}
+		userStr, _ := args[1].(string)
+		pvzStr, _ := args[2].(string)
+
+		userID, err := uuid.FromString(userStr)
+		if err != nil {
+			return false, nil
+		}
+		pvzID, err := uuid.FromString(pvzStr)
+		if err != nil {
+			return false, nil
+		}
+		ctx, cancel := context.WithTimeout(ctx, assignmentCheckTimeout)
+		defer cancel()
+		return assignments.IsAssigned(ctx, userID, pvzID)
+	}
+}
+
+// userIDArg — id пользователя для матчера; токен без sub даёт пустую строку
+func userIDArg(user models.Principal) string {
+	if user.UserId == uuid.Nil {
+		return ""
+	}
+	return user.UserId.String()
+}
+
+// pvzFromRequest возвращает ПВЗ, над которым выполняется действие: из переменной пути pvzId,
+// по товару из переменной пути productId или из поля pvzId JSON-тела.
+// Тело после чтения подменяется копией для обработчика.
+func pvzFromRequest(r *http.Request) (string, error) {
+	if pvzID, ok := mux.Vars(r)["pvzId"]; ok {
+		return pvzID, nil
+	}
+	if r.Method == http.MethodGet {
+		return "", nil
+	}
+	if productID, ok := mux.Vars(r)["productId"]; ok {
+		return pvzFromProduct(r.Context(), productID)
+	}
+	return pvzFromBody(r), nil
+}
+
+// pvzFromProduct возвращает ПВЗ товара; для несуществующего товара ПВЗ нет и доступ по scope assigned закрыт
+func pvzFromProduct(ctx context.Context, productIDStr string) (string, error) {
+	productID, err := uuid.FromString(productIDStr)
+	if err != nil {
+		return "", nil
+	}
+
+	pvzID, err := products.GetProductPvzID(ctx, productID)
+	if errors.Is(err, pvz.ErrProductNotFound) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	return pvzID.String(), nil
+}
+
+// pvzFromBody читает поле pvzId JSON-тела. Читается не больше maxScopeBodySize байт:
+// тело большего размера остаётся без ПВЗ, а обработчик получает его целиком
+func pvzFromBody(r *http.Request) string {
+	if r.Body == nil {
+		return ""
+	}
+
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxScopeBodySize+1))
+	r.Body = struct {
+		io.Reader
+		io.Closer
+	}{io.MultiReader(bytes.NewReader(body), r.Body), r.Body}
+	if err != nil || len(body) > maxScopeBodySize {
+		return ""
+	}
+
+	var req struct {
+		PvzId string `json:"pvzId"`
+	}
+	if err := json.Unmarshal(body, &req); err != nil {
+		return ""
+	}
+	return req.PvzId
+}
+
 func ACLMiddleware(next http.Handler) http.Handler {
 	secret := os.Getenv("JWT_SECRET")
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -53,11 +188,19 @@ func ACLMiddleware(next http.Handler) http.Handler {
 			send_err.SendError(w, "no role", http.StatusForbidden)
 			return
 		}
-		
+		user := jwtUtils.GetPrincipalFromClaims(claims, role)
+
 		path := r.URL.Path
 		method := r.Method
 
-		allowed, err := Enforcer.Enforce(role, path, method)
+		pvzID, err := pvzFromRequest(r)
+		if err != nil {
+			logger.LogHandlerError(loggerVar, fmt.Errorf("error while resolving pvz: %w", err), http.StatusInternalServerError)
+			send_err.SendError(w, "error enforce", http.StatusInternalServerError)
+			return
+		}
+
+		allowed, err := Enforcer.Enforce(role, path, method, userIDArg(user), pvzID, r.Context())
 		if err != nil {
 			logger.LogHandlerError(loggerVar, errors.New("error enforce"), http.StatusInternalServerError)
 			send_err.SendError(w, "error enforce", http.StatusInternalServerError)
@@ -69,6 +212,6 @@ func ACLMiddleware(next http.Handler) http.Handler {
 			return
 		}
 
-		next.ServeHTTP(w, r)
+		next.ServeHTTP(w, r.WithContext(principal.WithPrincipal(r.Context(), user)))
 	})
 }
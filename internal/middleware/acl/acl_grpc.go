@@ -0,0 +1,61 @@
+package acl
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"strings"
+
+	"github.com/K1tten2005/avito_pvz/internal/pkg/utils/jwtUtils"
+	"github.com/K1tten2005/avito_pvz/internal/pkg/utils/logger"
+	"github.com/K1tten2005/avito_pvz/internal/pkg/utils/principal"
+	"github.com/golang-jwt/jwt"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// ActGRPC используется в политиках вместо HTTP-метода для gRPC-вызовов
+const ActGRPC = "GRPC"
+
+func ACLInterceptor() grpc.UnaryServerInterceptor {
+	secret := os.Getenv("JWT_SECRET")
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+		md, _ := metadata.FromIncomingContext(ctx)
+		values := md.Get("authorization")
+		if len(values) == 0 {
+			loggerVar.Error("no authorization metadata")
+			return nil, status.Error(codes.Unauthenticated, "no authorization metadata")
+		}
+		JWTStr := strings.TrimPrefix(values[0], "Bearer ")
+
+		claims := jwt.MapClaims{}
+		role, ok := jwtUtils.GetRoleFromJWT(JWTStr, claims, secret)
+		if !ok || role == "" {
+			loggerVar.Error("no role")
+			return nil, status.Error(codes.Unauthenticated, "no role")
+		}
+		user := jwtUtils.GetPrincipalFromClaims(claims, role)
+
+		// Запросы, относящиеся к одному ПВЗ, несут его в поле pvz_id
+		var pvzID string
+		if scoped, ok := req.(interface{ GetPvzId() string }); ok {
+			pvzID = scoped.GetPvzId()
+		}
+
+		allowed, err := Enforcer.Enforce(role, info.FullMethod, ActGRPC, userIDArg(user), pvzID, ctx)
+		if err != nil {
+			loggerVar.Error("error enforce")
+			return nil, status.Error(codes.Internal, "error enforce")
+		}
+		if !allowed {
+			loggerVar.Error("not enough access rights")
+			return nil, status.Error(codes.PermissionDenied, "not enough access rights")
+		}
+
+		return handler(principal.WithPrincipal(ctx, user), req)
+	}
+}
@@ -0,0 +1,171 @@
+package acl
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/K1tten2005/avito_pvz/internal/models"
+	"github.com/K1tten2005/avito_pvz/internal/pkg/pvz"
+	"github.com/K1tten2005/avito_pvz/internal/pkg/utils/principal"
+	"github.com/golang-jwt/jwt"
+	"github.com/gorilla/mux"
+	"github.com/satori/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeAssignments struct {
+	assigned map[[2]uuid.UUID]bool
+	calls    int
+	ctx      context.Context
+}
+
+func (f *fakeAssignments) IsAssigned(ctx context.Context, userID, pvzID uuid.UUID) (bool, error) {
+	f.calls++
+	f.ctx = ctx
+	return f.assigned[[2]uuid.UUID{userID, pvzID}], nil
+}
+
+type fakeProducts map[uuid.UUID]uuid.UUID
+
+func (f fakeProducts) GetProductPvzID(_ context.Context, productID uuid.UUID) (uuid.UUID, error) {
+	pvzID, ok := f[productID]
+	if !ok {
+		return uuid.Nil, pvz.ErrProductNotFound
+	}
+	return pvzID, nil
+}
+
+func TestEnforcer_PvzScope(t *testing.T) {
+	userID, pvzID, otherPvzID := uuid.NewV4(), uuid.NewV4(), uuid.NewV4()
+	assignments := &fakeAssignments{assigned: map[[2]uuid.UUID]bool{{userID, pvzID}: true}}
+
+	e, err := newEnforcer("model.conf", "policy.csv", assignments)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name      string
+		rvals     []any
+		allowed   bool
+		checksPvz bool
+	}{
+		{"assigned employee opens reception", []any{"employee", "/receptions", "POST", userID.String(), pvzID.String()}, true, true},
+		{"employee at another pvz", []any{"employee", "/receptions", "POST", userID.String(), otherPvzID.String()}, false, true},
+		{"token without user id", []any{"employee", "/pvz/" + pvzID.String() + "/issue", "POST", "", pvzID.String()}, false, false},
+		{"grpc call", []any{"employee", "/pvz.v1.PvzService/AddProduct", ActGRPC, userID.String(), pvzID.String()}, true, true},
+		{"employee deletes product at another pvz", []any{"employee", "/products/" + uuid.NewV4().String(), "DELETE", userID.String(), otherPvzID.String()}, false, true},
+		{"employee reads any pvz", []any{"employee", "/pvz/" + otherPvzID.String(), "GET", userID.String(), otherPvzID.String()}, true, false},
+		{"moderator is not scoped", []any{"moderator", "/pvz/" + otherPvzID.String(), "PATCH", "", otherPvzID.String()}, true, false},
+		{"moderator cannot open reception", []any{"moderator", "/receptions", "POST", "", pvzID.String()}, false, false},
+	}
+
+	type ctxKey struct{}
+	ctx := context.WithValue(context.Background(), ctxKey{}, "request")
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assignments.calls = 0
+			allowed, err := e.Enforce(append(tt.rvals, ctx)...)
+			require.NoError(t, err)
+			assert.Equal(t, tt.allowed, allowed)
+			assert.Equal(t, tt.checksPvz, assignments.calls > 0)
+			if tt.checksPvz {
+				// проверка назначения идёт в контексте запроса и с таймаутом
+				assert.Equal(t, "request", assignments.ctx.Value(ctxKey{}))
+				_, hasDeadline := assignments.ctx.Deadline()
+				assert.True(t, hasDeadline)
+			}
+		})
+	}
+}
+
+func TestPvzFromBody_Oversized(t *testing.T) {
+	body := `{"pvzId":"` + uuid.NewV4().String() + `","pad":"` + strings.Repeat("x", maxScopeBodySize) + `"}`
+	req := httptest.NewRequest(http.MethodPost, "/receptions", strings.NewReader(body))
+
+	assert.Empty(t, pvzFromBody(req))
+
+	// обработчик всё равно получает тело целиком
+	rest, err := io.ReadAll(req.Body)
+	require.NoError(t, err)
+	assert.Equal(t, body, string(rest))
+}
+
+func TestACLMiddleware(t *testing.T) {
+	const secret = "test_secret"
+	t.Setenv("JWT_SECRET", secret)
+
+	userID, pvzID, otherPvzID := uuid.NewV4(), uuid.NewV4(), uuid.NewV4()
+	e, err := newEnforcer("model.conf", "policy.csv", &fakeAssignments{assigned: map[[2]uuid.UUID]bool{{userID, pvzID}: true}})
+	require.NoError(t, err)
+	Enforcer = e
+
+	productID, otherProductID := uuid.NewV4(), uuid.NewV4()
+	products = fakeProducts{productID: pvzID, otherProductID: otherPvzID}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"sub":   userID.String(),
+		"email": "employee@mail.ru",
+		"role":  "employee",
+		"exp":   time.Now().Add(time.Hour).Unix(),
+	})
+	tokenStr, err := token.SignedString([]byte(secret))
+	require.NoError(t, err)
+
+	var handledBody string
+	var handledUser models.Principal
+	router := mux.NewRouter()
+	router.Use(ACLMiddleware)
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		handledBody = string(body)
+		handledUser, _ = principal.FromContext(r.Context())
+		w.WriteHeader(http.StatusOK)
+	}
+	router.HandleFunc("/receptions", handler).Methods(http.MethodPost)
+	router.HandleFunc("/pvz/{pvzId}/issue", handler).Methods(http.MethodPost)
+	router.HandleFunc("/products/{productId}", handler).Methods(http.MethodDelete)
+	router.HandleFunc("/products/{productId}/restore", handler).Methods(http.MethodPost)
+
+	tests := []struct {
+		name       string
+		method     string
+		path       string
+		body       string
+		wantStatus int
+	}{
+		{"pvz from body", http.MethodPost, "/receptions", `{"pvzId":"` + pvzID.String() + `"}`, http.StatusOK},
+		{"other pvz from body", http.MethodPost, "/receptions", `{"pvzId":"` + otherPvzID.String() + `"}`, http.StatusForbidden},
+		{"malformed body", http.MethodPost, "/receptions", `{"pvzId":`, http.StatusForbidden},
+		{"pvz from path", http.MethodPost, "/pvz/" + pvzID.String() + "/issue", `{"code":"123456"}`, http.StatusOK},
+		{"other pvz from path", http.MethodPost, "/pvz/" + otherPvzID.String() + "/issue", `{"code":"123456"}`, http.StatusForbidden},
+		{"pvz from product", http.MethodDelete, "/products/" + productID.String(), `{"reason":"mis_scan"}`, http.StatusOK},
+		{"product at another pvz", http.MethodDelete, "/products/" + otherProductID.String(), `{"reason":"mis_scan"}`, http.StatusForbidden},
+		{"restore product at another pvz", http.MethodPost, "/products/" + otherProductID.String() + "/restore", "", http.StatusForbidden},
+		{"oversized body", http.MethodPost, "/receptions", `{"pvzId":"` + pvzID.String() + `","pad":"` + strings.Repeat("x", maxScopeBodySize) + `"}`, http.StatusForbidden},
+		{"unknown product", http.MethodDelete, "/products/" + uuid.NewV4().String(), `{"reason":"mis_scan"}`, http.StatusForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handledBody = ""
+			req := httptest.NewRequest(tt.method, tt.path, strings.NewReader(tt.body))
+			req.AddCookie(&http.Cookie{Name: "AvitoJWT", Value: tokenStr})
+			rec := httptest.NewRecorder()
+
+			router.ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.wantStatus, rec.Code)
+			if tt.wantStatus == http.StatusOK {
+				// обработчик получает тело целиком, даже если ACL его уже прочитал
+				assert.Equal(t, tt.body, handledBody)
+				assert.Equal(t, models.Principal{UserId: userID, Email: "employee@mail.ru", Role: "employee"}, handledUser)
+			}
+		})
+	}
+}
@@ -0,0 +1,228 @@
+package idempotencymw
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/K1tten2005/avito_pvz/internal/models"
+	"github.com/K1tten2005/avito_pvz/internal/pkg/idempotency"
+	"github.com/K1tten2005/avito_pvz/internal/pkg/utils/logger"
+	"github.com/K1tten2005/avito_pvz/internal/pkg/utils/principal"
+	"github.com/K1tten2005/avito_pvz/internal/pkg/utils/send_err"
+)
+
+const (
+	HeaderKey      = "Idempotency-Key"
+	HeaderReplayed = "Idempotent-Replayed"
+
+	maxKeyLength = 255
+	jwtCookie    = "AvitoJWT"
+	// maxBodySize не меньше лимита тела импорта ПВЗ: middleware читает тело раньше обработчика
+	maxBodySize = 5 << 20
+)
+
+type Middleware struct {
+	repo idempotency.IdempotencyRepo
+	ttl  time.Duration
+}
+
+func CreateIdempotencyMiddleware(repo idempotency.IdempotencyRepo, ttl time.Duration) *Middleware {
+	return &Middleware{repo: repo, ttl: ttl}
+}
+
+// responseRecorder дублирует ответ обработчика, чтобы сохранить его для повторов
+type responseRecorder struct {
+	http.ResponseWriter
+	statusCode int
+	body       bytes.Buffer
+}
+
+func (rr *responseRecorder) WriteHeader(code int) {
+	if rr.statusCode == 0 {
+		rr.statusCode = code
+	}
+	rr.ResponseWriter.WriteHeader(code)
+}
+
+func (rr *responseRecorder) Write(b []byte) (int, error) {
+	if rr.statusCode == 0 {
+		rr.statusCode = http.StatusOK
+	}
+	rr.body.Write(b)
+	return rr.ResponseWriter.Write(b)
+}
+
+// Wrap включает поддержку Idempotency-Key для мутирующего обработчика.
+// Запросы без заголовка проходят без изменений.
+func (m *Middleware) Wrap(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		loggerVar := logger.GetLoggerFromContext(r.Context()).With(slog.String("func", logger.GetFuncName()))
+
+		key := r.Header.Get(HeaderKey)
+		if key == "" {
+			next(w, r)
+			return
+		}
+		if len(key) > maxKeyLength {
+			logger.LogHandlerError(loggerVar, errors.New("idempotency key is too long"), http.StatusBadRequest)
+			send_err.SendError(w, "idempotency key is too long", http.StatusBadRequest)
+			return
+		}
+
+		scope, err := keyScope(r)
+		if err != nil {
+			logger.LogHandlerError(loggerVar, err, http.StatusForbidden)
+			send_err.SendError(w, err.Error(), http.StatusForbidden)
+			return
+		}
+
+		body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxBodySize))
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			logger.LogHandlerError(loggerVar, fmt.Errorf("error while reading body: %w", err), http.StatusRequestEntityTooLarge)
+			send_err.SendError(w, "request body is too large", http.StatusRequestEntityTooLarge)
+			return
+		}
+		if err != nil {
+			logger.LogHandlerError(loggerVar, fmt.Errorf("error while reading body: %w", err), http.StatusBadRequest)
+			send_err.SendError(w, "error while reading body", http.StatusBadRequest)
+			return
+		}
+		r.Body = io.NopCloser(bytes.NewReader(body))
+		requestHash := requestFingerprint(r, body)
+
+		reserved, err := m.repo.Reserve(r.Context(), scope, key, requestHash, time.Now().Add(m.ttl))
+		if err != nil {
+			logger.LogHandlerError(loggerVar, fmt.Errorf("error on a level below (repo): %w", err), http.StatusInternalServerError)
+			send_err.SendError(w, "error while checking idempotency key", http.StatusInternalServerError)
+			return
+		}
+		if !reserved {
+			m.replay(w, r, loggerVar, scope, key, requestHash)
+			return
+		}
+
+		rec := &responseRecorder{ResponseWriter: w}
+		next(rec, r)
+		if rec.statusCode == 0 {
+			// обработчик ничего не записал — net/http ответит 200
+			rec.statusCode = http.StatusOK
+		}
+
+		// отвязываемся от отмены запроса: ответ клиенту уже ушёл, а ключ нужно сохранить
+		ctx := context.WithoutCancel(r.Context())
+		if rec.statusCode >= http.StatusInternalServerError {
+			// серверные ошибки не кэшируем, чтобы повтор мог выполниться заново
+			if err := m.repo.Delete(ctx, scope, key); err != nil {
+				loggerVar.Error(err.Error())
+			}
+			return
+		}
+
+		record := models.IdempotencyRecord{
+			RequestHash: requestHash,
+			StatusCode:  rec.statusCode,
+			Body:        rec.body.Bytes(),
+			ContentType: rec.Header().Get("Content-Type"),
+		}
+		if err := m.repo.Save(ctx, scope, key, record); err != nil {
+			loggerVar.Error(err.Error())
+		}
+	}
+}
+
+func (m *Middleware) replay(w http.ResponseWriter, r *http.Request, loggerVar *slog.Logger, scope, key string, requestHash []byte) {
+	record, err := m.repo.Get(r.Context(), scope, key)
+	if errors.Is(err, idempotency.ErrKeyNotFound) {
+		// ключ истёк между Reserve и Get — клиенту стоит просто повторить запрос
+		logger.LogHandlerError(loggerVar, err, http.StatusConflict)
+		send_err.SendError(w, "request with this idempotency key is in progress", http.StatusConflict)
+		return
+	}
+	if err != nil {
+		logger.LogHandlerError(loggerVar, fmt.Errorf("error on a level below (repo): %w", err), http.StatusInternalServerError)
+		send_err.SendError(w, "error while checking idempotency key", http.StatusInternalServerError)
+		return
+	}
+
+	if !bytes.Equal(record.RequestHash, requestHash) {
+		logger.LogHandlerError(loggerVar, errors.New("idempotency key reused with different request"), http.StatusUnprocessableEntity)
+		send_err.SendError(w, "idempotency key reused with different request", http.StatusUnprocessableEntity)
+		return
+	}
+	if record.StatusCode == 0 {
+		logger.LogHandlerError(loggerVar, errors.New("request with this idempotency key is in progress"), http.StatusConflict)
+		send_err.SendError(w, "request with this idempotency key is in progress", http.StatusConflict)
+		return
+	}
+
+	if record.ContentType != "" {
+		w.Header().Set("Content-Type", record.ContentType)
+	}
+	w.Header().Set(HeaderReplayed, "true")
+	w.WriteHeader(record.StatusCode)
+	if _, err := w.Write(record.Body); err != nil {
+		loggerVar.Error(err.Error())
+		return
+	}
+	logger.LogHandlerInfo(loggerVar, "Replayed", record.StatusCode)
+}
+
+// RunCleaner периодически удаляет истёкшие ключи, пока не отменён ctx
+func (m *Middleware) RunCleaner(ctx context.Context, interval time.Duration) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := m.repo.DeleteExpired(ctx); err != nil {
+				loggerVar.Error(err.Error())
+			}
+		}
+	}
+}
+
+// keyScope разделяет ключи по пользователю, чтобы повтор после перевыпуска токена нашёл ответ.
+// Токены без пользователя разделяются по самому токену.
+func keyScope(r *http.Request) (string, error) {
+	if userID := principal.UserID(r.Context()); userID != nil {
+		return userID.String(), nil
+	}
+
+	cookieJWT, err := r.Cookie(jwtCookie)
+	if err != nil {
+		return "", errors.New("no jwt cookie")
+	}
+	return hashHex([]byte(cookieJWT.Value)), nil
+}
+
+func requestFingerprint(r *http.Request, body []byte) []byte {
+	h := sha256.New()
+	h.Write([]byte(r.Method))
+	h.Write([]byte{0})
+	h.Write([]byte(r.URL.Path))
+	h.Write([]byte{0})
+	// параметры запроса меняют смысл операции, например dryRun у импорта
+	h.Write([]byte(r.URL.RawQuery))
+	h.Write([]byte{0})
+	h.Write(body)
+	return h.Sum(nil)
+}
+
+func hashHex(b []byte) string {
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,201 @@
+package idempotencymw
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/K1tten2005/avito_pvz/internal/models"
+	"github.com/K1tten2005/avito_pvz/internal/pkg/idempotency/mocks"
+	"github.com/K1tten2005/avito_pvz/internal/pkg/utils/principal"
+	"github.com/golang/mock/gomock"
+	"github.com/satori/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMiddleware_Wrap(t *testing.T) {
+	const body = `{"pvzId":"8a1e0b7c-0000-0000-0000-000000000000"}`
+	fingerprint := requestFingerprint(httptest.NewRequest(http.MethodPost, "/receptions", nil), []byte(body))
+
+	tests := []struct {
+		name           string
+		key            string
+		requestBody    string
+		mockBehavior   func(repo *mocks.MockIdempotencyRepo)
+		handlerStatus  int
+		expectedCalls  int
+		expectedStatus int
+		expectedBody   string
+		replayed       bool
+	}{
+		{
+			name:           "no key",
+			requestBody:    body,
+			mockBehavior:   func(repo *mocks.MockIdempotencyRepo) {},
+			handlerStatus:  http.StatusCreated,
+			expectedCalls:  1,
+			expectedStatus: http.StatusCreated,
+		},
+		{
+			name:        "first request is stored",
+			key:         "k1",
+			requestBody: body,
+			mockBehavior: func(repo *mocks.MockIdempotencyRepo) {
+				repo.EXPECT().Reserve(gomock.Any(), gomock.Any(), "k1", fingerprint, gomock.Any()).Return(true, nil)
+				repo.EXPECT().Save(gomock.Any(), gomock.Any(), "k1", models.IdempotencyRecord{
+					RequestHash: fingerprint,
+					StatusCode:  http.StatusCreated,
+					Body:        []byte("created"),
+				}).Return(nil)
+			},
+			handlerStatus:  http.StatusCreated,
+			expectedCalls:  1,
+			expectedStatus: http.StatusCreated,
+			expectedBody:   "created",
+		},
+		{
+			name:        "handler writes nothing",
+			key:         "k1",
+			requestBody: body,
+			mockBehavior: func(repo *mocks.MockIdempotencyRepo) {
+				repo.EXPECT().Reserve(gomock.Any(), gomock.Any(), "k1", fingerprint, gomock.Any()).Return(true, nil)
+				repo.EXPECT().Save(gomock.Any(), gomock.Any(), "k1", models.IdempotencyRecord{
+					RequestHash: fingerprint,
+					StatusCode:  http.StatusOK,
+				}).Return(nil)
+			},
+			expectedCalls:  1,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "body too large",
+			key:            "k1",
+			requestBody:    strings.Repeat("x", maxBodySize+1),
+			mockBehavior:   func(repo *mocks.MockIdempotencyRepo) {},
+			expectedStatus: http.StatusRequestEntityTooLarge,
+		},
+		{
+			name:        "server error releases key",
+			key:         "k1",
+			requestBody: body,
+			mockBehavior: func(repo *mocks.MockIdempotencyRepo) {
+				repo.EXPECT().Reserve(gomock.Any(), gomock.Any(), "k1", fingerprint, gomock.Any()).Return(true, nil)
+				repo.EXPECT().Delete(gomock.Any(), gomock.Any(), "k1").Return(nil)
+			},
+			handlerStatus:  http.StatusInternalServerError,
+			expectedCalls:  1,
+			expectedStatus: http.StatusInternalServerError,
+		},
+		{
+			name:        "retry is replayed",
+			key:         "k1",
+			requestBody: body,
+			mockBehavior: func(repo *mocks.MockIdempotencyRepo) {
+				repo.EXPECT().Reserve(gomock.Any(), gomock.Any(), "k1", fingerprint, gomock.Any()).Return(false, nil)
+				repo.EXPECT().Get(gomock.Any(), gomock.Any(), "k1").Return(models.IdempotencyRecord{
+					RequestHash: fingerprint,
+					StatusCode:  http.StatusCreated,
+					Body:        []byte("created"),
+				}, nil)
+			},
+			expectedStatus: http.StatusCreated,
+			expectedBody:   "created",
+			replayed:       true,
+		},
+		{
+			name:        "different body",
+			key:         "k1",
+			requestBody: `{"pvzId":"other"}`,
+			mockBehavior: func(repo *mocks.MockIdempotencyRepo) {
+				repo.EXPECT().Reserve(gomock.Any(), gomock.Any(), "k1", gomock.Any(), gomock.Any()).Return(false, nil)
+				repo.EXPECT().Get(gomock.Any(), gomock.Any(), "k1").Return(models.IdempotencyRecord{
+					RequestHash: fingerprint,
+					StatusCode:  http.StatusCreated,
+				}, nil)
+			},
+			expectedStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name:        "first request in progress",
+			key:         "k1",
+			requestBody: body,
+			mockBehavior: func(repo *mocks.MockIdempotencyRepo) {
+				repo.EXPECT().Reserve(gomock.Any(), gomock.Any(), "k1", fingerprint, gomock.Any()).Return(false, nil)
+				repo.EXPECT().Get(gomock.Any(), gomock.Any(), "k1").Return(models.IdempotencyRecord{RequestHash: fingerprint}, nil)
+			},
+			expectedStatus: http.StatusConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repo := mocks.NewMockIdempotencyRepo(ctrl)
+			tt.mockBehavior(repo)
+
+			calls := 0
+			handler := CreateIdempotencyMiddleware(repo, time.Hour).Wrap(func(w http.ResponseWriter, r *http.Request) {
+				calls++
+				if tt.handlerStatus != 0 {
+					w.WriteHeader(tt.handlerStatus)
+				}
+				if tt.expectedBody != "" {
+					w.Write([]byte(tt.expectedBody))
+				}
+			})
+
+			req := httptest.NewRequest(http.MethodPost, "/receptions", strings.NewReader(tt.requestBody))
+			req.AddCookie(&http.Cookie{Name: jwtCookie, Value: "token"})
+			if tt.key != "" {
+				req.Header.Set(HeaderKey, tt.key)
+			}
+			rr := httptest.NewRecorder()
+
+			handler.ServeHTTP(rr, req)
+
+			assert.Equal(t, tt.expectedCalls, calls)
+			assert.Equal(t, tt.expectedStatus, rr.Code)
+			if tt.expectedBody != "" {
+				assert.Equal(t, tt.expectedBody, rr.Body.String())
+			}
+			if tt.replayed {
+				assert.Equal(t, "true", rr.Header().Get(HeaderReplayed))
+			}
+		})
+	}
+}
+
+func TestKeyScope(t *testing.T) {
+	userID := uuid.NewV4()
+	withUser := func(r *http.Request) *http.Request {
+		return r.WithContext(principal.WithPrincipal(r.Context(), models.Principal{UserId: userID, Role: models.RoleEmployee}))
+	}
+
+	oldToken := httptest.NewRequest(http.MethodPost, "/receptions", nil)
+	oldToken.AddCookie(&http.Cookie{Name: jwtCookie, Value: "old"})
+	newToken := httptest.NewRequest(http.MethodPost, "/receptions", nil)
+	newToken.AddCookie(&http.Cookie{Name: jwtCookie, Value: "new"})
+
+	// после перевыпуска токена ключи того же пользователя остаются в его области
+	oldScope, err := keyScope(withUser(oldToken))
+	require.NoError(t, err)
+	newScope, err := keyScope(withUser(newToken))
+	require.NoError(t, err)
+	assert.Equal(t, userID.String(), oldScope)
+	assert.Equal(t, oldScope, newScope)
+
+	// без пользователя в контексте область задаёт сам токен
+	oldScope, err = keyScope(oldToken)
+	require.NoError(t, err)
+	newScope, err = keyScope(newToken)
+	require.NoError(t, err)
+	assert.NotEqual(t, oldScope, newScope)
+
+	_, err = keyScope(httptest.NewRequest(http.MethodPost, "/receptions", nil))
+	assert.Error(t, err)
+}
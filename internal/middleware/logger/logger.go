@@ -7,6 +7,7 @@ import (
 
 	"github.com/satori/uuid"
 	"github.com/gorilla/mux"
+	"google.golang.org/grpc"
 )
 
 type ctxKey string
@@ -22,3 +23,13 @@ func CreateLoggerMiddleware(loggerVar *slog.Logger) mux.MiddlewareFunc {
 		})
 	}
 }
+
+func CreateLoggerInterceptor(loggerVar *slog.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		ctx = context.WithValue(ctx, LoggerKey, loggerVar.With(
+			slog.String("ID", uuid.NewV4().String()),
+			slog.String("method", info.FullMethod),
+		))
+		return handler(ctx, req)
+	}
+}
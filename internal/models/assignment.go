@@ -0,0 +1,14 @@
+package models
+
+import (
+	"time"
+
+	"github.com/satori/uuid"
+)
+
+// easyjson:json
+type PvzAssignment struct {
+	UserId     uuid.UUID `json:"userId"`
+	PvzId      uuid.UUID `json:"pvzId"`
+	AssignedAt time.Time `json:"assignedAt"`
+}
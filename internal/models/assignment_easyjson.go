@@ -0,0 +1,105 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package models
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjson490b3eb7DecodeGithubComK1tten2005AvitoPvzInternalModels(in *jlexer.Lexer, out *PvzAssignment) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "userId":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.UserId).UnmarshalText(data))
+			}
+		case "pvzId":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.PvzId).UnmarshalText(data))
+			}
+		case "assignedAt":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.AssignedAt).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson490b3eb7EncodeGithubComK1tten2005AvitoPvzInternalModels(out *jwriter.Writer, in PvzAssignment) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"userId\":"
+		out.RawString(prefix[1:])
+		out.RawText((in.UserId).MarshalText())
+	}
+	{
+		const prefix string = ",\"pvzId\":"
+		out.RawString(prefix)
+		out.RawText((in.PvzId).MarshalText())
+	}
+	{
+		const prefix string = ",\"assignedAt\":"
+		out.RawString(prefix)
+		out.Raw((in.AssignedAt).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v PvzAssignment) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson490b3eb7EncodeGithubComK1tten2005AvitoPvzInternalModels(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v PvzAssignment) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson490b3eb7EncodeGithubComK1tten2005AvitoPvzInternalModels(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *PvzAssignment) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson490b3eb7DecodeGithubComK1tten2005AvitoPvzInternalModels(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *PvzAssignment) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson490b3eb7DecodeGithubComK1tten2005AvitoPvzInternalModels(l, v)
+}
@@ -0,0 +1,32 @@
+package models
+
+import (
+	"html"
+
+	"github.com/satori/uuid"
+)
+
+// easyjson:json
+type City struct {
+	Id      uuid.UUID `json:"id"`
+	Name    string    `json:"name"`
+	Aliases []string  `json:"aliases"`
+	Enabled bool      `json:"enabled"`
+}
+
+// easyjson:json
+type CityReq struct {
+	Name    *string  `json:"name"`
+	Aliases []string `json:"aliases"`
+	Enabled *bool    `json:"enabled"`
+}
+
+func (c *CityReq) Sanitize() {
+	if c.Name != nil {
+		name := html.EscapeString(*c.Name)
+		c.Name = &name
+	}
+	for i := range c.Aliases {
+		c.Aliases[i] = html.EscapeString(c.Aliases[i])
+	}
+}
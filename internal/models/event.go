@@ -0,0 +1,43 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/satori/uuid"
+)
+
+// easyjson:json
+type Event struct {
+	// Seq — позиция в outbox: по ней релей отмечает события, а SSE-поток выставляет id
+	Seq         int64           `json:"-"`
+	Id          uuid.UUID       `json:"id"`
+	Type        string          `json:"type"`
+	AggregateId uuid.UUID       `json:"aggregateId"`
+	CreatedAt   time.Time       `json:"createdAt"`
+	Payload     json.RawMessage `json:"payload"`
+}
+
+const (
+	EventPvzCreated      = "pvz.created"
+	EventReceptionOpened = "reception.opened"
+	EventReceptionClosed = "reception.closed"
+	EventProductAdded    = "product.added"
+	EventProductDeleted  = "product.deleted"
+	EventProductRestored = "product.restored"
+	// EventPickupReady несёт код выдачи, чтобы партнёр передал его покупателю
+	EventPickupReady     = "pickup.ready"
+	EventProductIssued   = "product.issued"
+	EventProductReturned = "product.returned"
+)
+
+// StreamEventTypes — события, которые уходят в живой поток приёмки ПВЗ
+var StreamEventTypes = []string{
+	EventReceptionOpened,
+	EventReceptionClosed,
+	EventProductAdded,
+	EventProductDeleted,
+	EventProductRestored,
+	EventProductIssued,
+	EventProductReturned,
+}
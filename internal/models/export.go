@@ -0,0 +1,32 @@
+package models
+
+import (
+	"time"
+
+	"github.com/satori/uuid"
+)
+
+// ExportFilter — приёмки с reception_time в [StartDate, EndDate]; пустые границы не ограничивают
+type ExportFilter struct {
+	StartDate *time.Time
+	EndDate   *time.Time
+	City      string
+}
+
+// ExportRow — плоская строка выгрузки: приёмка и один её товар.
+// Приёмка без товаров выгружается одной строкой с пустыми полями товара.
+type ExportRow struct {
+	PvzId             uuid.UUID
+	City              string
+	ReceptionId       uuid.UUID
+	ReceptionTime     time.Time
+	ReceptionStatus   string
+	ReceptionClosedAt *time.Time
+	ProductId         *uuid.UUID
+	ProductTime       *time.Time
+	ProductType       string
+	Barcode           string
+	Sku               string
+	OrderNumber       string
+	WeightGrams       *int
+}
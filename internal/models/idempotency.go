@@ -0,0 +1,10 @@
+package models
+
+// IdempotencyRecord — сохранённый ответ на запрос с заголовком Idempotency-Key
+type IdempotencyRecord struct {
+	RequestHash []byte
+	// StatusCode равен нулю, пока первый запрос ещё выполняется
+	StatusCode  int
+	Body        []byte
+	ContentType string
+}
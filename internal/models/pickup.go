@@ -0,0 +1,73 @@
+package models
+
+import (
+	"html"
+	"time"
+
+	"github.com/satori/uuid"
+)
+
+const (
+	ProductStatusReceived       = "received"
+	ProductStatusReadyForPickup = "ready_for_pickup"
+	ProductStatusIssued         = "issued"
+	ProductStatusReturned       = "returned"
+)
+
+const (
+	ReturnReasonNotCollected = "not_collected"
+	ReturnReasonRefused      = "refused"
+	ReturnReasonDamaged      = "damaged"
+	ReturnReasonOther        = "other"
+)
+
+// easyjson:json
+type PreparePickupReq struct {
+	ProductIds  []uuid.UUID `json:"productIds"`
+	OrderNumber string      `json:"orderNumber"`
+}
+
+func (p *PreparePickupReq) Sanitize() {
+	p.OrderNumber = html.EscapeString(p.OrderNumber)
+}
+
+// easyjson:json
+type Pickup struct {
+	PvzId       uuid.UUID `json:"pvzId"`
+	Code        string    `json:"code"`
+	OrderNumber string    `json:"orderNumber,omitempty"`
+	Products    []Product `json:"products"`
+}
+
+// easyjson:json
+type IssueReq struct {
+	Code string `json:"code"`
+	// ProductIds ограничивает выдачу частью товаров с этим кодом
+	ProductIds []uuid.UUID `json:"productIds"`
+}
+
+// easyjson:json
+type ReturnReq struct {
+	ProductIds []uuid.UUID `json:"productIds"`
+	Reason     string      `json:"reason"`
+}
+
+// easyjson:json
+type ProductStatusChange struct {
+	Id         int64     `json:"id"`
+	ProductId  uuid.UUID `json:"productId"`
+	PvzId      uuid.UUID `json:"pvzId"`
+	FromStatus string    `json:"fromStatus"`
+	ToStatus   string    `json:"toStatus"`
+	Reason     string    `json:"reason,omitempty"`
+	ChangedAt  time.Time `json:"changedAt"`
+}
+
+type ProductHistoryFilter struct {
+	ProductId *uuid.UUID
+	PvzId     *uuid.UUID
+	ToStatus  string
+	StartDate *time.Time
+	EndDate   *time.Time
+	Limit     int
+}
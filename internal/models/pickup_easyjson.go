@@ -0,0 +1,576 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package models
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+	uuid "github.com/satori/uuid"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjsonDb6e7538DecodeGithubComK1tten2005AvitoPvzInternalModels(in *jlexer.Lexer, out *ReturnReq) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "productIds":
+			if in.IsNull() {
+				in.Skip()
+				out.ProductIds = nil
+			} else {
+				in.Delim('[')
+				if out.ProductIds == nil {
+					if !in.IsDelim(']') {
+						out.ProductIds = make([]uuid.UUID, 0, 4)
+					} else {
+						out.ProductIds = []uuid.UUID{}
+					}
+				} else {
+					out.ProductIds = (out.ProductIds)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v1 uuid.UUID
+					if data := in.UnsafeBytes(); in.Ok() {
+						in.AddError((v1).UnmarshalText(data))
+					}
+					out.ProductIds = append(out.ProductIds, v1)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "reason":
+			out.Reason = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonDb6e7538EncodeGithubComK1tten2005AvitoPvzInternalModels(out *jwriter.Writer, in ReturnReq) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"productIds\":"
+		out.RawString(prefix[1:])
+		if in.ProductIds == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v2, v3 := range in.ProductIds {
+				if v2 > 0 {
+					out.RawByte(',')
+				}
+				out.RawText((v3).MarshalText())
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"reason\":"
+		out.RawString(prefix)
+		out.String(string(in.Reason))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ReturnReq) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonDb6e7538EncodeGithubComK1tten2005AvitoPvzInternalModels(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ReturnReq) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonDb6e7538EncodeGithubComK1tten2005AvitoPvzInternalModels(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ReturnReq) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonDb6e7538DecodeGithubComK1tten2005AvitoPvzInternalModels(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ReturnReq) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonDb6e7538DecodeGithubComK1tten2005AvitoPvzInternalModels(l, v)
+}
+func easyjsonDb6e7538DecodeGithubComK1tten2005AvitoPvzInternalModels1(in *jlexer.Lexer, out *ProductStatusChange) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.Id = int64(in.Int64())
+		case "productId":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.ProductId).UnmarshalText(data))
+			}
+		case "pvzId":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.PvzId).UnmarshalText(data))
+			}
+		case "fromStatus":
+			out.FromStatus = string(in.String())
+		case "toStatus":
+			out.ToStatus = string(in.String())
+		case "reason":
+			out.Reason = string(in.String())
+		case "changedAt":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.ChangedAt).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonDb6e7538EncodeGithubComK1tten2005AvitoPvzInternalModels1(out *jwriter.Writer, in ProductStatusChange) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Int64(int64(in.Id))
+	}
+	{
+		const prefix string = ",\"productId\":"
+		out.RawString(prefix)
+		out.RawText((in.ProductId).MarshalText())
+	}
+	{
+		const prefix string = ",\"pvzId\":"
+		out.RawString(prefix)
+		out.RawText((in.PvzId).MarshalText())
+	}
+	{
+		const prefix string = ",\"fromStatus\":"
+		out.RawString(prefix)
+		out.String(string(in.FromStatus))
+	}
+	{
+		const prefix string = ",\"toStatus\":"
+		out.RawString(prefix)
+		out.String(string(in.ToStatus))
+	}
+	if in.Reason != "" {
+		const prefix string = ",\"reason\":"
+		out.RawString(prefix)
+		out.String(string(in.Reason))
+	}
+	{
+		const prefix string = ",\"changedAt\":"
+		out.RawString(prefix)
+		out.Raw((in.ChangedAt).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ProductStatusChange) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonDb6e7538EncodeGithubComK1tten2005AvitoPvzInternalModels1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ProductStatusChange) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonDb6e7538EncodeGithubComK1tten2005AvitoPvzInternalModels1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ProductStatusChange) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonDb6e7538DecodeGithubComK1tten2005AvitoPvzInternalModels1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ProductStatusChange) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonDb6e7538DecodeGithubComK1tten2005AvitoPvzInternalModels1(l, v)
+}
+func easyjsonDb6e7538DecodeGithubComK1tten2005AvitoPvzInternalModels2(in *jlexer.Lexer, out *PreparePickupReq) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "productIds":
+			if in.IsNull() {
+				in.Skip()
+				out.ProductIds = nil
+			} else {
+				in.Delim('[')
+				if out.ProductIds == nil {
+					if !in.IsDelim(']') {
+						out.ProductIds = make([]uuid.UUID, 0, 4)
+					} else {
+						out.ProductIds = []uuid.UUID{}
+					}
+				} else {
+					out.ProductIds = (out.ProductIds)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v4 uuid.UUID
+					if data := in.UnsafeBytes(); in.Ok() {
+						in.AddError((v4).UnmarshalText(data))
+					}
+					out.ProductIds = append(out.ProductIds, v4)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "orderNumber":
+			out.OrderNumber = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonDb6e7538EncodeGithubComK1tten2005AvitoPvzInternalModels2(out *jwriter.Writer, in PreparePickupReq) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"productIds\":"
+		out.RawString(prefix[1:])
+		if in.ProductIds == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v5, v6 := range in.ProductIds {
+				if v5 > 0 {
+					out.RawByte(',')
+				}
+				out.RawText((v6).MarshalText())
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"orderNumber\":"
+		out.RawString(prefix)
+		out.String(string(in.OrderNumber))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v PreparePickupReq) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonDb6e7538EncodeGithubComK1tten2005AvitoPvzInternalModels2(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v PreparePickupReq) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonDb6e7538EncodeGithubComK1tten2005AvitoPvzInternalModels2(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *PreparePickupReq) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonDb6e7538DecodeGithubComK1tten2005AvitoPvzInternalModels2(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *PreparePickupReq) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonDb6e7538DecodeGithubComK1tten2005AvitoPvzInternalModels2(l, v)
+}
+func easyjsonDb6e7538DecodeGithubComK1tten2005AvitoPvzInternalModels3(in *jlexer.Lexer, out *Pickup) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "pvzId":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.PvzId).UnmarshalText(data))
+			}
+		case "code":
+			out.Code = string(in.String())
+		case "orderNumber":
+			out.OrderNumber = string(in.String())
+		case "products":
+			if in.IsNull() {
+				in.Skip()
+				out.Products = nil
+			} else {
+				in.Delim('[')
+				if out.Products == nil {
+					if !in.IsDelim(']') {
+						out.Products = make([]Product, 0, 0)
+					} else {
+						out.Products = []Product{}
+					}
+				} else {
+					out.Products = (out.Products)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v7 Product
+					(v7).UnmarshalEasyJSON(in)
+					out.Products = append(out.Products, v7)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonDb6e7538EncodeGithubComK1tten2005AvitoPvzInternalModels3(out *jwriter.Writer, in Pickup) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"pvzId\":"
+		out.RawString(prefix[1:])
+		out.RawText((in.PvzId).MarshalText())
+	}
+	{
+		const prefix string = ",\"code\":"
+		out.RawString(prefix)
+		out.String(string(in.Code))
+	}
+	if in.OrderNumber != "" {
+		const prefix string = ",\"orderNumber\":"
+		out.RawString(prefix)
+		out.String(string(in.OrderNumber))
+	}
+	{
+		const prefix string = ",\"products\":"
+		out.RawString(prefix)
+		if in.Products == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v8, v9 := range in.Products {
+				if v8 > 0 {
+					out.RawByte(',')
+				}
+				(v9).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Pickup) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonDb6e7538EncodeGithubComK1tten2005AvitoPvzInternalModels3(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Pickup) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonDb6e7538EncodeGithubComK1tten2005AvitoPvzInternalModels3(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Pickup) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonDb6e7538DecodeGithubComK1tten2005AvitoPvzInternalModels3(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Pickup) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonDb6e7538DecodeGithubComK1tten2005AvitoPvzInternalModels3(l, v)
+}
+func easyjsonDb6e7538DecodeGithubComK1tten2005AvitoPvzInternalModels4(in *jlexer.Lexer, out *IssueReq) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "code":
+			out.Code = string(in.String())
+		case "productIds":
+			if in.IsNull() {
+				in.Skip()
+				out.ProductIds = nil
+			} else {
+				in.Delim('[')
+				if out.ProductIds == nil {
+					if !in.IsDelim(']') {
+						out.ProductIds = make([]uuid.UUID, 0, 4)
+					} else {
+						out.ProductIds = []uuid.UUID{}
+					}
+				} else {
+					out.ProductIds = (out.ProductIds)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v10 uuid.UUID
+					if data := in.UnsafeBytes(); in.Ok() {
+						in.AddError((v10).UnmarshalText(data))
+					}
+					out.ProductIds = append(out.ProductIds, v10)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonDb6e7538EncodeGithubComK1tten2005AvitoPvzInternalModels4(out *jwriter.Writer, in IssueReq) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"code\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Code))
+	}
+	{
+		const prefix string = ",\"productIds\":"
+		out.RawString(prefix)
+		if in.ProductIds == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v11, v12 := range in.ProductIds {
+				if v11 > 0 {
+					out.RawByte(',')
+				}
+				out.RawText((v12).MarshalText())
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v IssueReq) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonDb6e7538EncodeGithubComK1tten2005AvitoPvzInternalModels4(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v IssueReq) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonDb6e7538EncodeGithubComK1tten2005AvitoPvzInternalModels4(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *IssueReq) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonDb6e7538DecodeGithubComK1tten2005AvitoPvzInternalModels4(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *IssueReq) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonDb6e7538DecodeGithubComK1tten2005AvitoPvzInternalModels4(l, v)
+}
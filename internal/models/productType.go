@@ -0,0 +1,27 @@
+package models
+
+import "html"
+
+// easyjson:json
+type ProductType struct {
+	Code   string            `json:"code"`
+	Names  map[string]string `json:"names"`
+	Active bool              `json:"active"`
+}
+
+// easyjson:json
+type ProductTypeReq struct {
+	Code   *string           `json:"code"`
+	Names  map[string]string `json:"names"`
+	Active *bool             `json:"active"`
+}
+
+func (p *ProductTypeReq) Sanitize() {
+	if p.Code != nil {
+		code := html.EscapeString(*p.Code)
+		p.Code = &code
+	}
+	for lang, name := range p.Names {
+		p.Names[lang] = html.EscapeString(name)
+	}
+}
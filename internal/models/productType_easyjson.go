@@ -0,0 +1,263 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package models
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjson782086afDecodeGithubComK1tten2005AvitoPvzInternalModels(in *jlexer.Lexer, out *ProductTypeReq) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "code":
+			if in.IsNull() {
+				in.Skip()
+				out.Code = nil
+			} else {
+				if out.Code == nil {
+					out.Code = new(string)
+				}
+				*out.Code = string(in.String())
+			}
+		case "names":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				out.Names = make(map[string]string)
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v1 string
+					v1 = string(in.String())
+					(out.Names)[key] = v1
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		case "active":
+			if in.IsNull() {
+				in.Skip()
+				out.Active = nil
+			} else {
+				if out.Active == nil {
+					out.Active = new(bool)
+				}
+				*out.Active = bool(in.Bool())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson782086afEncodeGithubComK1tten2005AvitoPvzInternalModels(out *jwriter.Writer, in ProductTypeReq) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"code\":"
+		out.RawString(prefix[1:])
+		if in.Code == nil {
+			out.RawString("null")
+		} else {
+			out.String(string(*in.Code))
+		}
+	}
+	{
+		const prefix string = ",\"names\":"
+		out.RawString(prefix)
+		if in.Names == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+			out.RawString(`null`)
+		} else {
+			out.RawByte('{')
+			v2First := true
+			for v2Name, v2Value := range in.Names {
+				if v2First {
+					v2First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v2Name))
+				out.RawByte(':')
+				out.String(string(v2Value))
+			}
+			out.RawByte('}')
+		}
+	}
+	{
+		const prefix string = ",\"active\":"
+		out.RawString(prefix)
+		if in.Active == nil {
+			out.RawString("null")
+		} else {
+			out.Bool(bool(*in.Active))
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ProductTypeReq) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson782086afEncodeGithubComK1tten2005AvitoPvzInternalModels(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ProductTypeReq) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson782086afEncodeGithubComK1tten2005AvitoPvzInternalModels(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ProductTypeReq) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson782086afDecodeGithubComK1tten2005AvitoPvzInternalModels(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ProductTypeReq) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson782086afDecodeGithubComK1tten2005AvitoPvzInternalModels(l, v)
+}
+func easyjson782086afDecodeGithubComK1tten2005AvitoPvzInternalModels1(in *jlexer.Lexer, out *ProductType) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "code":
+			out.Code = string(in.String())
+		case "names":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				out.Names = make(map[string]string)
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v3 string
+					v3 = string(in.String())
+					(out.Names)[key] = v3
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		case "active":
+			out.Active = bool(in.Bool())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson782086afEncodeGithubComK1tten2005AvitoPvzInternalModels1(out *jwriter.Writer, in ProductType) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"code\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Code))
+	}
+	{
+		const prefix string = ",\"names\":"
+		out.RawString(prefix)
+		if in.Names == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+			out.RawString(`null`)
+		} else {
+			out.RawByte('{')
+			v4First := true
+			for v4Name, v4Value := range in.Names {
+				if v4First {
+					v4First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v4Name))
+				out.RawByte(':')
+				out.String(string(v4Value))
+			}
+			out.RawByte('}')
+		}
+	}
+	{
+		const prefix string = ",\"active\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Active))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ProductType) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson782086afEncodeGithubComK1tten2005AvitoPvzInternalModels1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ProductType) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson782086afEncodeGithubComK1tten2005AvitoPvzInternalModels1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ProductType) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson782086afDecodeGithubComK1tten2005AvitoPvzInternalModels1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ProductType) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson782086afDecodeGithubComK1tten2005AvitoPvzInternalModels1(l, v)
+}
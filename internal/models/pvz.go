@@ -9,12 +9,158 @@ import (
 
 // easyjson:json
 type PVZ struct {
-	Id               uuid.UUID   `json:"id"`
-	RegistrationDate time.Time   `json:"registrationDate"`
-	City             string      `json:"city"`
-	Receptions       []Reception `json:"receptions"`
+	Id               uuid.UUID         `json:"id"`
+	RegistrationDate time.Time         `json:"registrationDate"`
+	City             string            `json:"city"`
+	Address          string            `json:"address"`
+	Latitude         *float64          `json:"latitude,omitempty"`
+	Longitude        *float64          `json:"longitude,omitempty"`
+	Status           string            `json:"status"`
+	Metadata         map[string]string `json:"metadata"`
+	Version          int               `json:"version"`
+	ClosedAt         *time.Time        `json:"closedAt,omitempty"`
+	Capacity         PvzCapacity       `json:"capacity"`
+	Utilization      *PvzUtilization   `json:"utilization,omitempty"`
+	Receptions       []Reception       `json:"receptions"`
 }
 
+// PvzCapacity — лимиты вместимости ПВЗ; пустое поле означает, что лимита нет
+type PvzCapacity struct {
+	MaxItems       *int `json:"maxItems,omitempty"`
+	MaxVolumeCm3   *int `json:"maxVolumeCm3,omitempty"`
+	MaxWeightGrams *int `json:"maxWeightGrams,omitempty"`
+}
+
+// IsSet сообщает, задан ли хотя бы один лимит
+func (c PvzCapacity) IsSet() bool {
+	return c.MaxItems != nil || c.MaxVolumeCm3 != nil || c.MaxWeightGrams != nil
+}
+
+// PvzUtilization — сколько места занимают товары на полке ПВЗ.
+// Объём и вес учитываются только у товаров, для которых их указали при приёмке.
+type PvzUtilization struct {
+	Items       int `json:"items"`
+	VolumeCm3   int `json:"volumeCm3"`
+	WeightGrams int `json:"weightGrams"`
+	// Ratio — наибольшая доля заполнения среди заданных лимитов
+	Ratio      *float64 `json:"ratio,omitempty"`
+	NearlyFull bool     `json:"nearlyFull"`
+}
+
+// easyjson:json
+type UpdatePvzReq struct {
+	City      *string           `json:"city"`
+	Address   *string           `json:"address"`
+	Latitude  *float64          `json:"latitude"`
+	Longitude *float64          `json:"longitude"`
+	Status    *string           `json:"status"`
+	Metadata  map[string]string `json:"metadata"`
+	Capacity  *PvzCapacity      `json:"capacity"`
+	Version   *int              `json:"version"`
+}
+
+// easyjson:json
+type PvzPage struct {
+	Items      []PVZ  `json:"items"`
+	NextCursor string `json:"nextCursor,omitempty"`
+	Total      int    `json:"total"`
+}
+
+// PvzCursor указывает на последний ПВЗ предыдущей страницы.
+type PvzCursor struct {
+	RegistrationDate time.Time `json:"d"`
+	Id               uuid.UUID `json:"id"`
+}
+
+// PvzImportRow — строка CSV-файла импорта ПВЗ в исходном виде
+type PvzImportRow struct {
+	Line             int
+	Id               string
+	City             string
+	RegistrationDate string
+	Address          string
+	Latitude         string
+	Longitude        string
+}
+
+// easyjson:json
+type PvzImportReport struct {
+	DryRun   bool            `json:"dryRun"`
+	Total    int             `json:"total"`
+	Imported int             `json:"imported"`
+	Items    []PvzImportItem `json:"items"`
+}
+
+// easyjson:json
+type PvzImportItem struct {
+	Line   int    `json:"line"`
+	Status string `json:"status"`
+	Pvz    *PVZ   `json:"pvz,omitempty"`
+	Error  string `json:"error,omitempty"`
+}
+
+// easyjson:json
+type NearbyPvz struct {
+	Pvz            PVZ     `json:"pvz"`
+	DistanceMeters float64 `json:"distanceMeters"`
+}
+
+// NearbyPvzFilter — точка поиска, радиус в метрах и фильтры выдачи ближайших ПВЗ.
+// Пустой Status означает все ПВЗ, кроме закрытых.
+type NearbyPvzFilter struct {
+	Latitude     float64
+	Longitude    float64
+	RadiusMeters float64
+	Status       string
+	// HasCapacity оставляет только ПВЗ, в которых не исчерпан ни один лимит вместимости
+	HasCapacity bool
+	Limit       int
+}
+
+const (
+	ImportItemValid    = "valid"
+	ImportItemCreated  = "created"
+	ImportItemRejected = "rejected"
+)
+
+type PvzFilter struct {
+	StartDate       *time.Time
+	EndDate         *time.Time
+	Cursor          *PvzCursor
+	Page            int
+	Limit           int
+	IncludeArchived bool
+}
+
+const (
+	PvzStatusActive    = "active"
+	PvzStatusSuspended = "suspended"
+	PvzStatusClosed    = "closed"
+)
+
 func (p *PVZ) Sanitize() {
 	p.City = html.EscapeString(p.City)
+	p.Address = html.EscapeString(p.Address)
+	for key, val := range p.Metadata {
+		p.Metadata[key] = html.EscapeString(val)
+	}
+}
+
+func (p *PvzImportRow) Sanitize() {
+	p.City = html.EscapeString(p.City)
+	p.Address = html.EscapeString(p.Address)
+}
+
+func (p *UpdatePvzReq) Sanitize() {
+	if p.City != nil {
+		city := html.EscapeString(*p.City)
+		p.City = &city
+	}
+	if p.Address != nil {
+		address := html.EscapeString(*p.Address)
+		p.Address = &address
+	}
+	for key, val := range p.Metadata {
+		p.Metadata[key] = html.EscapeString(val)
+	}
 }
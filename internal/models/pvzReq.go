@@ -8,10 +8,36 @@ import (
 
 // easyjson:json
 type AddProductReq struct {
-	Type  string    `json:"type"`
-	PvzId uuid.UUID `json:"pvzId"`
+	Type            string      `json:"type"`
+	PvzId           uuid.UUID   `json:"pvzId"`
+	Barcode         string      `json:"barcode"`
+	BarcodeFormat   string      `json:"barcodeFormat"`
+	BarcodeChecksum *int        `json:"barcodeChecksum"`
+	Sku             string      `json:"sku"`
+	OrderNumber     string      `json:"orderNumber"`
+	WeightGrams     *int        `json:"weightGrams"`
+	Dimensions      *Dimensions `json:"dimensions"`
 }
 
 func (p *AddProductReq) Sanitize() {
 	p.Type = html.EscapeString(p.Type)
+	p.Sku = html.EscapeString(p.Sku)
+	p.OrderNumber = html.EscapeString(p.OrderNumber)
+}
+
+// easyjson:json
+type AddProductsBatchReq struct {
+	PvzId uuid.UUID       `json:"pvzId"`
+	Items []AddProductReq `json:"items"`
+}
+
+func (p *AddProductsBatchReq) Sanitize() {
+	for i := range p.Items {
+		p.Items[i].Sanitize()
+	}
+}
+
+// easyjson:json
+type DeleteProductReq struct {
+	Reason string `json:"reason"`
 }
@@ -17,7 +17,180 @@ var (
 	_ easyjson.Marshaler
 )
 
-func easyjson6d8536e8DecodeGithubComK1tten2005AvitoPvzInternalModels(in *jlexer.Lexer, out *AddProductReq) {
+func easyjson6d8536e8DecodeGithubComK1tten2005AvitoPvzInternalModels(in *jlexer.Lexer, out *DeleteProductReq) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "reason":
+			out.Reason = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6d8536e8EncodeGithubComK1tten2005AvitoPvzInternalModels(out *jwriter.Writer, in DeleteProductReq) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"reason\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Reason))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v DeleteProductReq) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6d8536e8EncodeGithubComK1tten2005AvitoPvzInternalModels(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v DeleteProductReq) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6d8536e8EncodeGithubComK1tten2005AvitoPvzInternalModels(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *DeleteProductReq) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6d8536e8DecodeGithubComK1tten2005AvitoPvzInternalModels(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *DeleteProductReq) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6d8536e8DecodeGithubComK1tten2005AvitoPvzInternalModels(l, v)
+}
+func easyjson6d8536e8DecodeGithubComK1tten2005AvitoPvzInternalModels1(in *jlexer.Lexer, out *AddProductsBatchReq) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "pvzId":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.PvzId).UnmarshalText(data))
+			}
+		case "items":
+			if in.IsNull() {
+				in.Skip()
+				out.Items = nil
+			} else {
+				in.Delim('[')
+				if out.Items == nil {
+					if !in.IsDelim(']') {
+						out.Items = make([]AddProductReq, 0, 0)
+					} else {
+						out.Items = []AddProductReq{}
+					}
+				} else {
+					out.Items = (out.Items)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v1 AddProductReq
+					(v1).UnmarshalEasyJSON(in)
+					out.Items = append(out.Items, v1)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6d8536e8EncodeGithubComK1tten2005AvitoPvzInternalModels1(out *jwriter.Writer, in AddProductsBatchReq) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"pvzId\":"
+		out.RawString(prefix[1:])
+		out.RawText((in.PvzId).MarshalText())
+	}
+	{
+		const prefix string = ",\"items\":"
+		out.RawString(prefix)
+		if in.Items == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v2, v3 := range in.Items {
+				if v2 > 0 {
+					out.RawByte(',')
+				}
+				(v3).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v AddProductsBatchReq) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6d8536e8EncodeGithubComK1tten2005AvitoPvzInternalModels1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v AddProductsBatchReq) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6d8536e8EncodeGithubComK1tten2005AvitoPvzInternalModels1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *AddProductsBatchReq) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6d8536e8DecodeGithubComK1tten2005AvitoPvzInternalModels1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *AddProductsBatchReq) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6d8536e8DecodeGithubComK1tten2005AvitoPvzInternalModels1(l, v)
+}
+func easyjson6d8536e8DecodeGithubComK1tten2005AvitoPvzInternalModels2(in *jlexer.Lexer, out *AddProductReq) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -42,6 +215,44 @@ func easyjson6d8536e8DecodeGithubComK1tten2005AvitoPvzInternalModels(in *jlexer.
 			if data := in.UnsafeBytes(); in.Ok() {
 				in.AddError((out.PvzId).UnmarshalText(data))
 			}
+		case "barcode":
+			out.Barcode = string(in.String())
+		case "barcodeFormat":
+			out.BarcodeFormat = string(in.String())
+		case "barcodeChecksum":
+			if in.IsNull() {
+				in.Skip()
+				out.BarcodeChecksum = nil
+			} else {
+				if out.BarcodeChecksum == nil {
+					out.BarcodeChecksum = new(int)
+				}
+				*out.BarcodeChecksum = int(in.Int())
+			}
+		case "sku":
+			out.Sku = string(in.String())
+		case "orderNumber":
+			out.OrderNumber = string(in.String())
+		case "weightGrams":
+			if in.IsNull() {
+				in.Skip()
+				out.WeightGrams = nil
+			} else {
+				if out.WeightGrams == nil {
+					out.WeightGrams = new(int)
+				}
+				*out.WeightGrams = int(in.Int())
+			}
+		case "dimensions":
+			if in.IsNull() {
+				in.Skip()
+				out.Dimensions = nil
+			} else {
+				if out.Dimensions == nil {
+					out.Dimensions = new(Dimensions)
+				}
+				(*out.Dimensions).UnmarshalEasyJSON(in)
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -52,7 +263,7 @@ func easyjson6d8536e8DecodeGithubComK1tten2005AvitoPvzInternalModels(in *jlexer.
 		in.Consumed()
 	}
 }
-func easyjson6d8536e8EncodeGithubComK1tten2005AvitoPvzInternalModels(out *jwriter.Writer, in AddProductReq) {
+func easyjson6d8536e8EncodeGithubComK1tten2005AvitoPvzInternalModels2(out *jwriter.Writer, in AddProductReq) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -66,29 +277,76 @@ func easyjson6d8536e8EncodeGithubComK1tten2005AvitoPvzInternalModels(out *jwrite
 		out.RawString(prefix)
 		out.RawText((in.PvzId).MarshalText())
 	}
+	{
+		const prefix string = ",\"barcode\":"
+		out.RawString(prefix)
+		out.String(string(in.Barcode))
+	}
+	{
+		const prefix string = ",\"barcodeFormat\":"
+		out.RawString(prefix)
+		out.String(string(in.BarcodeFormat))
+	}
+	{
+		const prefix string = ",\"barcodeChecksum\":"
+		out.RawString(prefix)
+		if in.BarcodeChecksum == nil {
+			out.RawString("null")
+		} else {
+			out.Int(int(*in.BarcodeChecksum))
+		}
+	}
+	{
+		const prefix string = ",\"sku\":"
+		out.RawString(prefix)
+		out.String(string(in.Sku))
+	}
+	{
+		const prefix string = ",\"orderNumber\":"
+		out.RawString(prefix)
+		out.String(string(in.OrderNumber))
+	}
+	{
+		const prefix string = ",\"weightGrams\":"
+		out.RawString(prefix)
+		if in.WeightGrams == nil {
+			out.RawString("null")
+		} else {
+			out.Int(int(*in.WeightGrams))
+		}
+	}
+	{
+		const prefix string = ",\"dimensions\":"
+		out.RawString(prefix)
+		if in.Dimensions == nil {
+			out.RawString("null")
+		} else {
+			(*in.Dimensions).MarshalEasyJSON(out)
+		}
+	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
 func (v AddProductReq) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6d8536e8EncodeGithubComK1tten2005AvitoPvzInternalModels(&w, v)
+	easyjson6d8536e8EncodeGithubComK1tten2005AvitoPvzInternalModels2(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v AddProductReq) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6d8536e8EncodeGithubComK1tten2005AvitoPvzInternalModels(w, v)
+	easyjson6d8536e8EncodeGithubComK1tten2005AvitoPvzInternalModels2(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *AddProductReq) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6d8536e8DecodeGithubComK1tten2005AvitoPvzInternalModels(&r, v)
+	easyjson6d8536e8DecodeGithubComK1tten2005AvitoPvzInternalModels2(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *AddProductReq) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6d8536e8DecodeGithubComK1tten2005AvitoPvzInternalModels(l, v)
+	easyjson6d8536e8DecodeGithubComK1tten2005AvitoPvzInternalModels2(l, v)
 }
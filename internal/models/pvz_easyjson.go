@@ -7,6 +7,7 @@ import (
 	easyjson "github.com/mailru/easyjson"
 	jlexer "github.com/mailru/easyjson/jlexer"
 	jwriter "github.com/mailru/easyjson/jwriter"
+	time "time"
 )
 
 // suppress unused package warning
@@ -17,7 +18,653 @@ var (
 	_ easyjson.Marshaler
 )
 
-func easyjson232327a2DecodeGithubComK1tten2005AvitoPvzInternalModels(in *jlexer.Lexer, out *PVZ) {
+func easyjson232327a2DecodeGithubComK1tten2005AvitoPvzInternalModels(in *jlexer.Lexer, out *UpdatePvzReq) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "city":
+			if in.IsNull() {
+				in.Skip()
+				out.City = nil
+			} else {
+				if out.City == nil {
+					out.City = new(string)
+				}
+				*out.City = string(in.String())
+			}
+		case "address":
+			if in.IsNull() {
+				in.Skip()
+				out.Address = nil
+			} else {
+				if out.Address == nil {
+					out.Address = new(string)
+				}
+				*out.Address = string(in.String())
+			}
+		case "latitude":
+			if in.IsNull() {
+				in.Skip()
+				out.Latitude = nil
+			} else {
+				if out.Latitude == nil {
+					out.Latitude = new(float64)
+				}
+				*out.Latitude = float64(in.Float64())
+			}
+		case "longitude":
+			if in.IsNull() {
+				in.Skip()
+				out.Longitude = nil
+			} else {
+				if out.Longitude == nil {
+					out.Longitude = new(float64)
+				}
+				*out.Longitude = float64(in.Float64())
+			}
+		case "status":
+			if in.IsNull() {
+				in.Skip()
+				out.Status = nil
+			} else {
+				if out.Status == nil {
+					out.Status = new(string)
+				}
+				*out.Status = string(in.String())
+			}
+		case "metadata":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				out.Metadata = make(map[string]string)
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v1 string
+					v1 = string(in.String())
+					(out.Metadata)[key] = v1
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		case "capacity":
+			if in.IsNull() {
+				in.Skip()
+				out.Capacity = nil
+			} else {
+				if out.Capacity == nil {
+					out.Capacity = new(PvzCapacity)
+				}
+				easyjson232327a2DecodeGithubComK1tten2005AvitoPvzInternalModels1(in, out.Capacity)
+			}
+		case "version":
+			if in.IsNull() {
+				in.Skip()
+				out.Version = nil
+			} else {
+				if out.Version == nil {
+					out.Version = new(int)
+				}
+				*out.Version = int(in.Int())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson232327a2EncodeGithubComK1tten2005AvitoPvzInternalModels(out *jwriter.Writer, in UpdatePvzReq) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"city\":"
+		out.RawString(prefix[1:])
+		if in.City == nil {
+			out.RawString("null")
+		} else {
+			out.String(string(*in.City))
+		}
+	}
+	{
+		const prefix string = ",\"address\":"
+		out.RawString(prefix)
+		if in.Address == nil {
+			out.RawString("null")
+		} else {
+			out.String(string(*in.Address))
+		}
+	}
+	{
+		const prefix string = ",\"latitude\":"
+		out.RawString(prefix)
+		if in.Latitude == nil {
+			out.RawString("null")
+		} else {
+			out.Float64(float64(*in.Latitude))
+		}
+	}
+	{
+		const prefix string = ",\"longitude\":"
+		out.RawString(prefix)
+		if in.Longitude == nil {
+			out.RawString("null")
+		} else {
+			out.Float64(float64(*in.Longitude))
+		}
+	}
+	{
+		const prefix string = ",\"status\":"
+		out.RawString(prefix)
+		if in.Status == nil {
+			out.RawString("null")
+		} else {
+			out.String(string(*in.Status))
+		}
+	}
+	{
+		const prefix string = ",\"metadata\":"
+		out.RawString(prefix)
+		if in.Metadata == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+			out.RawString(`null`)
+		} else {
+			out.RawByte('{')
+			v2First := true
+			for v2Name, v2Value := range in.Metadata {
+				if v2First {
+					v2First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v2Name))
+				out.RawByte(':')
+				out.String(string(v2Value))
+			}
+			out.RawByte('}')
+		}
+	}
+	{
+		const prefix string = ",\"capacity\":"
+		out.RawString(prefix)
+		if in.Capacity == nil {
+			out.RawString("null")
+		} else {
+			easyjson232327a2EncodeGithubComK1tten2005AvitoPvzInternalModels1(out, *in.Capacity)
+		}
+	}
+	{
+		const prefix string = ",\"version\":"
+		out.RawString(prefix)
+		if in.Version == nil {
+			out.RawString("null")
+		} else {
+			out.Int(int(*in.Version))
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v UpdatePvzReq) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson232327a2EncodeGithubComK1tten2005AvitoPvzInternalModels(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v UpdatePvzReq) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson232327a2EncodeGithubComK1tten2005AvitoPvzInternalModels(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *UpdatePvzReq) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson232327a2DecodeGithubComK1tten2005AvitoPvzInternalModels(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *UpdatePvzReq) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson232327a2DecodeGithubComK1tten2005AvitoPvzInternalModels(l, v)
+}
+func easyjson232327a2DecodeGithubComK1tten2005AvitoPvzInternalModels1(in *jlexer.Lexer, out *PvzCapacity) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "maxItems":
+			if in.IsNull() {
+				in.Skip()
+				out.MaxItems = nil
+			} else {
+				if out.MaxItems == nil {
+					out.MaxItems = new(int)
+				}
+				*out.MaxItems = int(in.Int())
+			}
+		case "maxVolumeCm3":
+			if in.IsNull() {
+				in.Skip()
+				out.MaxVolumeCm3 = nil
+			} else {
+				if out.MaxVolumeCm3 == nil {
+					out.MaxVolumeCm3 = new(int)
+				}
+				*out.MaxVolumeCm3 = int(in.Int())
+			}
+		case "maxWeightGrams":
+			if in.IsNull() {
+				in.Skip()
+				out.MaxWeightGrams = nil
+			} else {
+				if out.MaxWeightGrams == nil {
+					out.MaxWeightGrams = new(int)
+				}
+				*out.MaxWeightGrams = int(in.Int())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson232327a2EncodeGithubComK1tten2005AvitoPvzInternalModels1(out *jwriter.Writer, in PvzCapacity) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.MaxItems != nil {
+		const prefix string = ",\"maxItems\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.Int(int(*in.MaxItems))
+	}
+	if in.MaxVolumeCm3 != nil {
+		const prefix string = ",\"maxVolumeCm3\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Int(int(*in.MaxVolumeCm3))
+	}
+	if in.MaxWeightGrams != nil {
+		const prefix string = ",\"maxWeightGrams\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Int(int(*in.MaxWeightGrams))
+	}
+	out.RawByte('}')
+}
+func easyjson232327a2DecodeGithubComK1tten2005AvitoPvzInternalModels2(in *jlexer.Lexer, out *PvzPage) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "items":
+			if in.IsNull() {
+				in.Skip()
+				out.Items = nil
+			} else {
+				in.Delim('[')
+				if out.Items == nil {
+					if !in.IsDelim(']') {
+						out.Items = make([]PVZ, 0, 0)
+					} else {
+						out.Items = []PVZ{}
+					}
+				} else {
+					out.Items = (out.Items)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v3 PVZ
+					(v3).UnmarshalEasyJSON(in)
+					out.Items = append(out.Items, v3)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "nextCursor":
+			out.NextCursor = string(in.String())
+		case "total":
+			out.Total = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson232327a2EncodeGithubComK1tten2005AvitoPvzInternalModels2(out *jwriter.Writer, in PvzPage) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"items\":"
+		out.RawString(prefix[1:])
+		if in.Items == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v4, v5 := range in.Items {
+				if v4 > 0 {
+					out.RawByte(',')
+				}
+				(v5).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	if in.NextCursor != "" {
+		const prefix string = ",\"nextCursor\":"
+		out.RawString(prefix)
+		out.String(string(in.NextCursor))
+	}
+	{
+		const prefix string = ",\"total\":"
+		out.RawString(prefix)
+		out.Int(int(in.Total))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v PvzPage) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson232327a2EncodeGithubComK1tten2005AvitoPvzInternalModels2(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v PvzPage) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson232327a2EncodeGithubComK1tten2005AvitoPvzInternalModels2(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *PvzPage) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson232327a2DecodeGithubComK1tten2005AvitoPvzInternalModels2(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *PvzPage) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson232327a2DecodeGithubComK1tten2005AvitoPvzInternalModels2(l, v)
+}
+func easyjson232327a2DecodeGithubComK1tten2005AvitoPvzInternalModels3(in *jlexer.Lexer, out *PvzImportReport) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "dryRun":
+			out.DryRun = bool(in.Bool())
+		case "total":
+			out.Total = int(in.Int())
+		case "imported":
+			out.Imported = int(in.Int())
+		case "items":
+			if in.IsNull() {
+				in.Skip()
+				out.Items = nil
+			} else {
+				in.Delim('[')
+				if out.Items == nil {
+					if !in.IsDelim(']') {
+						out.Items = make([]PvzImportItem, 0, 1)
+					} else {
+						out.Items = []PvzImportItem{}
+					}
+				} else {
+					out.Items = (out.Items)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v6 PvzImportItem
+					(v6).UnmarshalEasyJSON(in)
+					out.Items = append(out.Items, v6)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson232327a2EncodeGithubComK1tten2005AvitoPvzInternalModels3(out *jwriter.Writer, in PvzImportReport) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"dryRun\":"
+		out.RawString(prefix[1:])
+		out.Bool(bool(in.DryRun))
+	}
+	{
+		const prefix string = ",\"total\":"
+		out.RawString(prefix)
+		out.Int(int(in.Total))
+	}
+	{
+		const prefix string = ",\"imported\":"
+		out.RawString(prefix)
+		out.Int(int(in.Imported))
+	}
+	{
+		const prefix string = ",\"items\":"
+		out.RawString(prefix)
+		if in.Items == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v7, v8 := range in.Items {
+				if v7 > 0 {
+					out.RawByte(',')
+				}
+				(v8).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v PvzImportReport) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson232327a2EncodeGithubComK1tten2005AvitoPvzInternalModels3(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v PvzImportReport) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson232327a2EncodeGithubComK1tten2005AvitoPvzInternalModels3(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *PvzImportReport) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson232327a2DecodeGithubComK1tten2005AvitoPvzInternalModels3(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *PvzImportReport) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson232327a2DecodeGithubComK1tten2005AvitoPvzInternalModels3(l, v)
+}
+func easyjson232327a2DecodeGithubComK1tten2005AvitoPvzInternalModels4(in *jlexer.Lexer, out *PvzImportItem) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "line":
+			out.Line = int(in.Int())
+		case "status":
+			out.Status = string(in.String())
+		case "pvz":
+			if in.IsNull() {
+				in.Skip()
+				out.Pvz = nil
+			} else {
+				if out.Pvz == nil {
+					out.Pvz = new(PVZ)
+				}
+				(*out.Pvz).UnmarshalEasyJSON(in)
+			}
+		case "error":
+			out.Error = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson232327a2EncodeGithubComK1tten2005AvitoPvzInternalModels4(out *jwriter.Writer, in PvzImportItem) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"line\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.Line))
+	}
+	{
+		const prefix string = ",\"status\":"
+		out.RawString(prefix)
+		out.String(string(in.Status))
+	}
+	if in.Pvz != nil {
+		const prefix string = ",\"pvz\":"
+		out.RawString(prefix)
+		(*in.Pvz).MarshalEasyJSON(out)
+	}
+	if in.Error != "" {
+		const prefix string = ",\"error\":"
+		out.RawString(prefix)
+		out.String(string(in.Error))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v PvzImportItem) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson232327a2EncodeGithubComK1tten2005AvitoPvzInternalModels4(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v PvzImportItem) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson232327a2EncodeGithubComK1tten2005AvitoPvzInternalModels4(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *PvzImportItem) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson232327a2DecodeGithubComK1tten2005AvitoPvzInternalModels4(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *PvzImportItem) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson232327a2DecodeGithubComK1tten2005AvitoPvzInternalModels4(l, v)
+}
+func easyjson232327a2DecodeGithubComK1tten2005AvitoPvzInternalModels5(in *jlexer.Lexer, out *PVZ) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -46,6 +693,72 @@ func easyjson232327a2DecodeGithubComK1tten2005AvitoPvzInternalModels(in *jlexer.
 			}
 		case "city":
 			out.City = string(in.String())
+		case "address":
+			out.Address = string(in.String())
+		case "latitude":
+			if in.IsNull() {
+				in.Skip()
+				out.Latitude = nil
+			} else {
+				if out.Latitude == nil {
+					out.Latitude = new(float64)
+				}
+				*out.Latitude = float64(in.Float64())
+			}
+		case "longitude":
+			if in.IsNull() {
+				in.Skip()
+				out.Longitude = nil
+			} else {
+				if out.Longitude == nil {
+					out.Longitude = new(float64)
+				}
+				*out.Longitude = float64(in.Float64())
+			}
+		case "status":
+			out.Status = string(in.String())
+		case "metadata":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				out.Metadata = make(map[string]string)
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v9 string
+					v9 = string(in.String())
+					(out.Metadata)[key] = v9
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		case "version":
+			out.Version = int(in.Int())
+		case "closedAt":
+			if in.IsNull() {
+				in.Skip()
+				out.ClosedAt = nil
+			} else {
+				if out.ClosedAt == nil {
+					out.ClosedAt = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.ClosedAt).UnmarshalJSON(data))
+				}
+			}
+		case "capacity":
+			easyjson232327a2DecodeGithubComK1tten2005AvitoPvzInternalModels1(in, &out.Capacity)
+		case "utilization":
+			if in.IsNull() {
+				in.Skip()
+				out.Utilization = nil
+			} else {
+				if out.Utilization == nil {
+					out.Utilization = new(PvzUtilization)
+				}
+				easyjson232327a2DecodeGithubComK1tten2005AvitoPvzInternalModels6(in, out.Utilization)
+			}
 		case "receptions":
 			if in.IsNull() {
 				in.Skip()
@@ -62,9 +775,9 @@ func easyjson232327a2DecodeGithubComK1tten2005AvitoPvzInternalModels(in *jlexer.
 					out.Receptions = (out.Receptions)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v1 Reception
-					(v1).UnmarshalEasyJSON(in)
-					out.Receptions = append(out.Receptions, v1)
+					var v10 Reception
+					(v10).UnmarshalEasyJSON(in)
+					out.Receptions = append(out.Receptions, v10)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -79,7 +792,7 @@ func easyjson232327a2DecodeGithubComK1tten2005AvitoPvzInternalModels(in *jlexer.
 		in.Consumed()
 	}
 }
-func easyjson232327a2EncodeGithubComK1tten2005AvitoPvzInternalModels(out *jwriter.Writer, in PVZ) {
+func easyjson232327a2EncodeGithubComK1tten2005AvitoPvzInternalModels5(out *jwriter.Writer, in PVZ) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -98,6 +811,67 @@ func easyjson232327a2EncodeGithubComK1tten2005AvitoPvzInternalModels(out *jwrite
 		out.RawString(prefix)
 		out.String(string(in.City))
 	}
+	{
+		const prefix string = ",\"address\":"
+		out.RawString(prefix)
+		out.String(string(in.Address))
+	}
+	if in.Latitude != nil {
+		const prefix string = ",\"latitude\":"
+		out.RawString(prefix)
+		out.Float64(float64(*in.Latitude))
+	}
+	if in.Longitude != nil {
+		const prefix string = ",\"longitude\":"
+		out.RawString(prefix)
+		out.Float64(float64(*in.Longitude))
+	}
+	{
+		const prefix string = ",\"status\":"
+		out.RawString(prefix)
+		out.String(string(in.Status))
+	}
+	{
+		const prefix string = ",\"metadata\":"
+		out.RawString(prefix)
+		if in.Metadata == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+			out.RawString(`null`)
+		} else {
+			out.RawByte('{')
+			v11First := true
+			for v11Name, v11Value := range in.Metadata {
+				if v11First {
+					v11First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v11Name))
+				out.RawByte(':')
+				out.String(string(v11Value))
+			}
+			out.RawByte('}')
+		}
+	}
+	{
+		const prefix string = ",\"version\":"
+		out.RawString(prefix)
+		out.Int(int(in.Version))
+	}
+	if in.ClosedAt != nil {
+		const prefix string = ",\"closedAt\":"
+		out.RawString(prefix)
+		out.Raw((*in.ClosedAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"capacity\":"
+		out.RawString(prefix)
+		easyjson232327a2EncodeGithubComK1tten2005AvitoPvzInternalModels1(out, in.Capacity)
+	}
+	if in.Utilization != nil {
+		const prefix string = ",\"utilization\":"
+		out.RawString(prefix)
+		easyjson232327a2EncodeGithubComK1tten2005AvitoPvzInternalModels6(out, *in.Utilization)
+	}
 	{
 		const prefix string = ",\"receptions\":"
 		out.RawString(prefix)
@@ -105,11 +879,11 @@ func easyjson232327a2EncodeGithubComK1tten2005AvitoPvzInternalModels(out *jwrite
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v2, v3 := range in.Receptions {
-				if v2 > 0 {
+			for v12, v13 := range in.Receptions {
+				if v12 > 0 {
 					out.RawByte(',')
 				}
-				(v3).MarshalEasyJSON(out)
+				(v13).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
@@ -120,23 +894,174 @@ func easyjson232327a2EncodeGithubComK1tten2005AvitoPvzInternalModels(out *jwrite
 // MarshalJSON supports json.Marshaler interface
 func (v PVZ) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson232327a2EncodeGithubComK1tten2005AvitoPvzInternalModels(&w, v)
+	easyjson232327a2EncodeGithubComK1tten2005AvitoPvzInternalModels5(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v PVZ) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson232327a2EncodeGithubComK1tten2005AvitoPvzInternalModels(w, v)
+	easyjson232327a2EncodeGithubComK1tten2005AvitoPvzInternalModels5(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *PVZ) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson232327a2DecodeGithubComK1tten2005AvitoPvzInternalModels(&r, v)
+	easyjson232327a2DecodeGithubComK1tten2005AvitoPvzInternalModels5(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *PVZ) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson232327a2DecodeGithubComK1tten2005AvitoPvzInternalModels(l, v)
+	easyjson232327a2DecodeGithubComK1tten2005AvitoPvzInternalModels5(l, v)
+}
+func easyjson232327a2DecodeGithubComK1tten2005AvitoPvzInternalModels6(in *jlexer.Lexer, out *PvzUtilization) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "items":
+			out.Items = int(in.Int())
+		case "volumeCm3":
+			out.VolumeCm3 = int(in.Int())
+		case "weightGrams":
+			out.WeightGrams = int(in.Int())
+		case "ratio":
+			if in.IsNull() {
+				in.Skip()
+				out.Ratio = nil
+			} else {
+				if out.Ratio == nil {
+					out.Ratio = new(float64)
+				}
+				*out.Ratio = float64(in.Float64())
+			}
+		case "nearlyFull":
+			out.NearlyFull = bool(in.Bool())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson232327a2EncodeGithubComK1tten2005AvitoPvzInternalModels6(out *jwriter.Writer, in PvzUtilization) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"items\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.Items))
+	}
+	{
+		const prefix string = ",\"volumeCm3\":"
+		out.RawString(prefix)
+		out.Int(int(in.VolumeCm3))
+	}
+	{
+		const prefix string = ",\"weightGrams\":"
+		out.RawString(prefix)
+		out.Int(int(in.WeightGrams))
+	}
+	if in.Ratio != nil {
+		const prefix string = ",\"ratio\":"
+		out.RawString(prefix)
+		out.Float64(float64(*in.Ratio))
+	}
+	{
+		const prefix string = ",\"nearlyFull\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.NearlyFull))
+	}
+	out.RawByte('}')
+}
+func easyjson232327a2DecodeGithubComK1tten2005AvitoPvzInternalModels7(in *jlexer.Lexer, out *NearbyPvz) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "pvz":
+			(out.Pvz).UnmarshalEasyJSON(in)
+		case "distanceMeters":
+			out.DistanceMeters = float64(in.Float64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson232327a2EncodeGithubComK1tten2005AvitoPvzInternalModels7(out *jwriter.Writer, in NearbyPvz) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"pvz\":"
+		out.RawString(prefix[1:])
+		(in.Pvz).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"distanceMeters\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.DistanceMeters))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v NearbyPvz) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson232327a2EncodeGithubComK1tten2005AvitoPvzInternalModels7(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v NearbyPvz) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson232327a2EncodeGithubComK1tten2005AvitoPvzInternalModels7(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *NearbyPvz) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson232327a2DecodeGithubComK1tten2005AvitoPvzInternalModels7(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *NearbyPvz) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson232327a2DecodeGithubComK1tten2005AvitoPvzInternalModels7(l, v)
 }
@@ -8,22 +8,105 @@ import (
 
 // easyjson:json
 type Product struct {
-	Id          uuid.UUID `json:"id"`
-	DateTime    time.Time `json:"dateTime"`
-	Type        string    `json:"type"`
-	ReceptionId uuid.UUID `json:"reception_id"`
+	Id            uuid.UUID   `json:"id"`
+	DateTime      time.Time   `json:"dateTime"`
+	Type          string      `json:"type"`
+	ReceptionId   uuid.UUID   `json:"reception_id"`
+	Barcode       string      `json:"barcode,omitempty"`
+	BarcodeFormat string      `json:"barcodeFormat,omitempty"`
+	Sku           string      `json:"sku,omitempty"`
+	OrderNumber   string      `json:"orderNumber,omitempty"`
+	WeightGrams   *int        `json:"weightGrams,omitempty"`
+	Dimensions    *Dimensions `json:"dimensions,omitempty"`
+	Warnings      []string    `json:"warnings,omitempty"`
+	DeletedAt     *time.Time  `json:"deletedAt,omitempty"`
+	DeleteReason  string      `json:"deleteReason,omitempty"`
+	Status        string      `json:"status,omitempty"`
+	CreatedBy     *uuid.UUID  `json:"createdBy,omitempty"`
+}
+
+// easyjson:json
+type ProductDeletion struct {
+	Product   Product   `json:"product"`
+	UndoUntil time.Time `json:"undoUntil"`
+}
+
+// easyjson:json
+type Dimensions struct {
+	LengthMm int `json:"lengthMm"`
+	WidthMm  int `json:"widthMm"`
+	HeightMm int `json:"heightMm"`
+}
+
+// easyjson:json
+type ProductLocation struct {
+	Product         Product   `json:"product"`
+	PvzId           uuid.UUID `json:"pvzId"`
+	City            string    `json:"city"`
+	ReceptionStatus string    `json:"receptionStatus"`
+}
+
+// easyjson:json
+type ProductBatchResult struct {
+	ReceptionId *uuid.UUID         `json:"receptionId,omitempty"`
+	Created     int                `json:"created"`
+	Items       []ProductBatchItem `json:"items"`
+	Warnings    []string           `json:"warnings,omitempty"`
+}
+
+// easyjson:json
+type ProductBatchItem struct {
+	Index   int      `json:"index"`
+	Status  string   `json:"status"`
+	Product *Product `json:"product,omitempty"`
+	Error   string   `json:"error,omitempty"`
 }
 
 // easyjson:json
 type Reception struct {
-	Id       uuid.UUID `json:"id"`
-	DateTime time.Time `json:"dateTime"`
-	PvzId    uuid.UUID `json:"pvzId"`
-	Products []Product `json:"products"`
-	Status   string    `json:"status"`
+	Id        uuid.UUID  `json:"id"`
+	DateTime  time.Time  `json:"dateTime"`
+	PvzId     uuid.UUID  `json:"pvzId"`
+	Products  []Product  `json:"products"`
+	Status    string     `json:"status"`
+	ClosedAt  *time.Time `json:"closedAt,omitempty"`
+	CreatedBy *uuid.UUID `json:"createdBy,omitempty"`
+	ClosedBy  *uuid.UUID `json:"closedBy,omitempty"`
+}
+
+// easyjson:json
+type ReceptionAct struct {
+	Reception     Reception          `json:"reception"`
+	City          string             `json:"city"`
+	ClosedByEmail string             `json:"closedByEmail,omitempty"`
+	Totals        []ProductTypeTotal `json:"totals"`
+}
+
+// easyjson:json
+type ProductTypeTotal struct {
+	Type  string `json:"type"`
+	Count int    `json:"count"`
 }
 
 const(
 	StatusInProgress = "in_progress"
 	StatusClose = "close"
 )
+
+const (
+	DeleteReasonMisScan   = "mis_scan"
+	DeleteReasonDuplicate = "duplicate"
+	DeleteReasonDamaged   = "damaged"
+	DeleteReasonOther     = "other"
+)
+
+const (
+	BatchItemCreated  = "created"
+	BatchItemRejected = "rejected"
+	BatchItemSkipped  = "skipped"
+)
+
+const (
+	BarcodeFormatEAN13   = "ean13"
+	BarcodeFormatCode128 = "code128"
+)
@@ -7,6 +7,8 @@ import (
 	easyjson "github.com/mailru/easyjson"
 	jlexer "github.com/mailru/easyjson/jlexer"
 	jwriter "github.com/mailru/easyjson/jwriter"
+	uuid "github.com/satori/uuid"
+	time "time"
 )
 
 // suppress unused package warning
@@ -17,7 +19,126 @@ var (
 	_ easyjson.Marshaler
 )
 
-func easyjson26500425DecodeGithubComK1tten2005AvitoPvzInternalModels(in *jlexer.Lexer, out *Reception) {
+func easyjson26500425DecodeGithubComK1tten2005AvitoPvzInternalModels(in *jlexer.Lexer, out *ReceptionAct) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "reception":
+			(out.Reception).UnmarshalEasyJSON(in)
+		case "city":
+			out.City = string(in.String())
+		case "closedByEmail":
+			out.ClosedByEmail = string(in.String())
+		case "totals":
+			if in.IsNull() {
+				in.Skip()
+				out.Totals = nil
+			} else {
+				in.Delim('[')
+				if out.Totals == nil {
+					if !in.IsDelim(']') {
+						out.Totals = make([]ProductTypeTotal, 0, 2)
+					} else {
+						out.Totals = []ProductTypeTotal{}
+					}
+				} else {
+					out.Totals = (out.Totals)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v1 ProductTypeTotal
+					(v1).UnmarshalEasyJSON(in)
+					out.Totals = append(out.Totals, v1)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson26500425EncodeGithubComK1tten2005AvitoPvzInternalModels(out *jwriter.Writer, in ReceptionAct) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"reception\":"
+		out.RawString(prefix[1:])
+		(in.Reception).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"city\":"
+		out.RawString(prefix)
+		out.String(string(in.City))
+	}
+	if in.ClosedByEmail != "" {
+		const prefix string = ",\"closedByEmail\":"
+		out.RawString(prefix)
+		out.String(string(in.ClosedByEmail))
+	}
+	{
+		const prefix string = ",\"totals\":"
+		out.RawString(prefix)
+		if in.Totals == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v2, v3 := range in.Totals {
+				if v2 > 0 {
+					out.RawByte(',')
+				}
+				(v3).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ReceptionAct) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson26500425EncodeGithubComK1tten2005AvitoPvzInternalModels(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ReceptionAct) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson26500425EncodeGithubComK1tten2005AvitoPvzInternalModels(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ReceptionAct) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson26500425DecodeGithubComK1tten2005AvitoPvzInternalModels(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ReceptionAct) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson26500425DecodeGithubComK1tten2005AvitoPvzInternalModels(l, v)
+}
+func easyjson26500425DecodeGithubComK1tten2005AvitoPvzInternalModels1(in *jlexer.Lexer, out *Reception) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -64,15 +185,51 @@ func easyjson26500425DecodeGithubComK1tten2005AvitoPvzInternalModels(in *jlexer.
 					out.Products = (out.Products)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v1 Product
-					(v1).UnmarshalEasyJSON(in)
-					out.Products = append(out.Products, v1)
+					var v4 Product
+					(v4).UnmarshalEasyJSON(in)
+					out.Products = append(out.Products, v4)
 					in.WantComma()
 				}
 				in.Delim(']')
 			}
 		case "status":
 			out.Status = string(in.String())
+		case "closedAt":
+			if in.IsNull() {
+				in.Skip()
+				out.ClosedAt = nil
+			} else {
+				if out.ClosedAt == nil {
+					out.ClosedAt = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.ClosedAt).UnmarshalJSON(data))
+				}
+			}
+		case "createdBy":
+			if in.IsNull() {
+				in.Skip()
+				out.CreatedBy = nil
+			} else {
+				if out.CreatedBy == nil {
+					out.CreatedBy = new(uuid.UUID)
+				}
+				if data := in.UnsafeBytes(); in.Ok() {
+					in.AddError((*out.CreatedBy).UnmarshalText(data))
+				}
+			}
+		case "closedBy":
+			if in.IsNull() {
+				in.Skip()
+				out.ClosedBy = nil
+			} else {
+				if out.ClosedBy == nil {
+					out.ClosedBy = new(uuid.UUID)
+				}
+				if data := in.UnsafeBytes(); in.Ok() {
+					in.AddError((*out.ClosedBy).UnmarshalText(data))
+				}
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -83,7 +240,7 @@ func easyjson26500425DecodeGithubComK1tten2005AvitoPvzInternalModels(in *jlexer.
 		in.Consumed()
 	}
 }
-func easyjson26500425EncodeGithubComK1tten2005AvitoPvzInternalModels(out *jwriter.Writer, in Reception) {
+func easyjson26500425EncodeGithubComK1tten2005AvitoPvzInternalModels1(out *jwriter.Writer, in Reception) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -109,11 +266,11 @@ func easyjson26500425EncodeGithubComK1tten2005AvitoPvzInternalModels(out *jwrite
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v2, v3 := range in.Products {
-				if v2 > 0 {
+			for v5, v6 := range in.Products {
+				if v5 > 0 {
 					out.RawByte(',')
 				}
-				(v3).MarshalEasyJSON(out)
+				(v6).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
@@ -123,33 +280,48 @@ func easyjson26500425EncodeGithubComK1tten2005AvitoPvzInternalModels(out *jwrite
 		out.RawString(prefix)
 		out.String(string(in.Status))
 	}
+	if in.ClosedAt != nil {
+		const prefix string = ",\"closedAt\":"
+		out.RawString(prefix)
+		out.Raw((*in.ClosedAt).MarshalJSON())
+	}
+	if in.CreatedBy != nil {
+		const prefix string = ",\"createdBy\":"
+		out.RawString(prefix)
+		out.RawText((*in.CreatedBy).MarshalText())
+	}
+	if in.ClosedBy != nil {
+		const prefix string = ",\"closedBy\":"
+		out.RawString(prefix)
+		out.RawText((*in.ClosedBy).MarshalText())
+	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
 func (v Reception) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson26500425EncodeGithubComK1tten2005AvitoPvzInternalModels(&w, v)
+	easyjson26500425EncodeGithubComK1tten2005AvitoPvzInternalModels1(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v Reception) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson26500425EncodeGithubComK1tten2005AvitoPvzInternalModels(w, v)
+	easyjson26500425EncodeGithubComK1tten2005AvitoPvzInternalModels1(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *Reception) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson26500425DecodeGithubComK1tten2005AvitoPvzInternalModels(&r, v)
+	easyjson26500425DecodeGithubComK1tten2005AvitoPvzInternalModels1(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *Reception) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson26500425DecodeGithubComK1tten2005AvitoPvzInternalModels(l, v)
+	easyjson26500425DecodeGithubComK1tten2005AvitoPvzInternalModels1(l, v)
 }
-func easyjson26500425DecodeGithubComK1tten2005AvitoPvzInternalModels1(in *jlexer.Lexer, out *Product) {
+func easyjson26500425DecodeGithubComK1tten2005AvitoPvzInternalModels2(in *jlexer.Lexer, out *ProductTypeTotal) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -168,20 +340,89 @@ func easyjson26500425DecodeGithubComK1tten2005AvitoPvzInternalModels1(in *jlexer
 			continue
 		}
 		switch key {
-		case "id":
-			if data := in.UnsafeBytes(); in.Ok() {
-				in.AddError((out.Id).UnmarshalText(data))
-			}
-		case "dateTime":
-			if data := in.Raw(); in.Ok() {
-				in.AddError((out.DateTime).UnmarshalJSON(data))
-			}
 		case "type":
 			out.Type = string(in.String())
-		case "reception_id":
+		case "count":
+			out.Count = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson26500425EncodeGithubComK1tten2005AvitoPvzInternalModels2(out *jwriter.Writer, in ProductTypeTotal) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Type))
+	}
+	{
+		const prefix string = ",\"count\":"
+		out.RawString(prefix)
+		out.Int(int(in.Count))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ProductTypeTotal) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson26500425EncodeGithubComK1tten2005AvitoPvzInternalModels2(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ProductTypeTotal) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson26500425EncodeGithubComK1tten2005AvitoPvzInternalModels2(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ProductTypeTotal) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson26500425DecodeGithubComK1tten2005AvitoPvzInternalModels2(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ProductTypeTotal) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson26500425DecodeGithubComK1tten2005AvitoPvzInternalModels2(l, v)
+}
+func easyjson26500425DecodeGithubComK1tten2005AvitoPvzInternalModels3(in *jlexer.Lexer, out *ProductLocation) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "product":
+			(out.Product).UnmarshalEasyJSON(in)
+		case "pvzId":
 			if data := in.UnsafeBytes(); in.Ok() {
-				in.AddError((out.ReceptionId).UnmarshalText(data))
+				in.AddError((out.PvzId).UnmarshalText(data))
 			}
+		case "city":
+			out.City = string(in.String())
+		case "receptionStatus":
+			out.ReceptionStatus = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -192,53 +433,704 @@ func easyjson26500425DecodeGithubComK1tten2005AvitoPvzInternalModels1(in *jlexer
 		in.Consumed()
 	}
 }
-func easyjson26500425EncodeGithubComK1tten2005AvitoPvzInternalModels1(out *jwriter.Writer, in Product) {
+func easyjson26500425EncodeGithubComK1tten2005AvitoPvzInternalModels3(out *jwriter.Writer, in ProductLocation) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"id\":"
+		const prefix string = ",\"product\":"
 		out.RawString(prefix[1:])
-		out.RawText((in.Id).MarshalText())
+		(in.Product).MarshalEasyJSON(out)
 	}
 	{
-		const prefix string = ",\"dateTime\":"
+		const prefix string = ",\"pvzId\":"
 		out.RawString(prefix)
-		out.Raw((in.DateTime).MarshalJSON())
+		out.RawText((in.PvzId).MarshalText())
 	}
 	{
-		const prefix string = ",\"type\":"
+		const prefix string = ",\"city\":"
 		out.RawString(prefix)
-		out.String(string(in.Type))
+		out.String(string(in.City))
 	}
 	{
-		const prefix string = ",\"reception_id\":"
+		const prefix string = ",\"receptionStatus\":"
 		out.RawString(prefix)
-		out.RawText((in.ReceptionId).MarshalText())
+		out.String(string(in.ReceptionStatus))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v Product) MarshalJSON() ([]byte, error) {
+func (v ProductLocation) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson26500425EncodeGithubComK1tten2005AvitoPvzInternalModels1(&w, v)
+	easyjson26500425EncodeGithubComK1tten2005AvitoPvzInternalModels3(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v Product) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson26500425EncodeGithubComK1tten2005AvitoPvzInternalModels1(w, v)
+func (v ProductLocation) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson26500425EncodeGithubComK1tten2005AvitoPvzInternalModels3(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *Product) UnmarshalJSON(data []byte) error {
+func (v *ProductLocation) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson26500425DecodeGithubComK1tten2005AvitoPvzInternalModels1(&r, v)
+	easyjson26500425DecodeGithubComK1tten2005AvitoPvzInternalModels3(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *Product) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson26500425DecodeGithubComK1tten2005AvitoPvzInternalModels1(l, v)
+func (v *ProductLocation) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson26500425DecodeGithubComK1tten2005AvitoPvzInternalModels3(l, v)
+}
+func easyjson26500425DecodeGithubComK1tten2005AvitoPvzInternalModels4(in *jlexer.Lexer, out *ProductDeletion) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "product":
+			(out.Product).UnmarshalEasyJSON(in)
+		case "undoUntil":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.UndoUntil).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson26500425EncodeGithubComK1tten2005AvitoPvzInternalModels4(out *jwriter.Writer, in ProductDeletion) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"product\":"
+		out.RawString(prefix[1:])
+		(in.Product).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"undoUntil\":"
+		out.RawString(prefix)
+		out.Raw((in.UndoUntil).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ProductDeletion) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson26500425EncodeGithubComK1tten2005AvitoPvzInternalModels4(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ProductDeletion) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson26500425EncodeGithubComK1tten2005AvitoPvzInternalModels4(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ProductDeletion) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson26500425DecodeGithubComK1tten2005AvitoPvzInternalModels4(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ProductDeletion) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson26500425DecodeGithubComK1tten2005AvitoPvzInternalModels4(l, v)
+}
+func easyjson26500425DecodeGithubComK1tten2005AvitoPvzInternalModels5(in *jlexer.Lexer, out *ProductBatchResult) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "receptionId":
+			if in.IsNull() {
+				in.Skip()
+				out.ReceptionId = nil
+			} else {
+				if out.ReceptionId == nil {
+					out.ReceptionId = new(uuid.UUID)
+				}
+				if data := in.UnsafeBytes(); in.Ok() {
+					in.AddError((*out.ReceptionId).UnmarshalText(data))
+				}
+			}
+		case "created":
+			out.Created = int(in.Int())
+		case "items":
+			if in.IsNull() {
+				in.Skip()
+				out.Items = nil
+			} else {
+				in.Delim('[')
+				if out.Items == nil {
+					if !in.IsDelim(']') {
+						out.Items = make([]ProductBatchItem, 0, 1)
+					} else {
+						out.Items = []ProductBatchItem{}
+					}
+				} else {
+					out.Items = (out.Items)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v7 ProductBatchItem
+					(v7).UnmarshalEasyJSON(in)
+					out.Items = append(out.Items, v7)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "warnings":
+			if in.IsNull() {
+				in.Skip()
+				out.Warnings = nil
+			} else {
+				in.Delim('[')
+				if out.Warnings == nil {
+					if !in.IsDelim(']') {
+						out.Warnings = make([]string, 0, 4)
+					} else {
+						out.Warnings = []string{}
+					}
+				} else {
+					out.Warnings = (out.Warnings)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v8 string
+					v8 = string(in.String())
+					out.Warnings = append(out.Warnings, v8)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson26500425EncodeGithubComK1tten2005AvitoPvzInternalModels5(out *jwriter.Writer, in ProductBatchResult) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.ReceptionId != nil {
+		const prefix string = ",\"receptionId\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.RawText((*in.ReceptionId).MarshalText())
+	}
+	{
+		const prefix string = ",\"created\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Int(int(in.Created))
+	}
+	{
+		const prefix string = ",\"items\":"
+		out.RawString(prefix)
+		if in.Items == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v9, v10 := range in.Items {
+				if v9 > 0 {
+					out.RawByte(',')
+				}
+				(v10).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	if len(in.Warnings) != 0 {
+		const prefix string = ",\"warnings\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v11, v12 := range in.Warnings {
+				if v11 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v12))
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ProductBatchResult) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson26500425EncodeGithubComK1tten2005AvitoPvzInternalModels5(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ProductBatchResult) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson26500425EncodeGithubComK1tten2005AvitoPvzInternalModels5(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ProductBatchResult) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson26500425DecodeGithubComK1tten2005AvitoPvzInternalModels5(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ProductBatchResult) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson26500425DecodeGithubComK1tten2005AvitoPvzInternalModels5(l, v)
+}
+func easyjson26500425DecodeGithubComK1tten2005AvitoPvzInternalModels6(in *jlexer.Lexer, out *ProductBatchItem) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "index":
+			out.Index = int(in.Int())
+		case "status":
+			out.Status = string(in.String())
+		case "product":
+			if in.IsNull() {
+				in.Skip()
+				out.Product = nil
+			} else {
+				if out.Product == nil {
+					out.Product = new(Product)
+				}
+				(*out.Product).UnmarshalEasyJSON(in)
+			}
+		case "error":
+			out.Error = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson26500425EncodeGithubComK1tten2005AvitoPvzInternalModels6(out *jwriter.Writer, in ProductBatchItem) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"index\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.Index))
+	}
+	{
+		const prefix string = ",\"status\":"
+		out.RawString(prefix)
+		out.String(string(in.Status))
+	}
+	if in.Product != nil {
+		const prefix string = ",\"product\":"
+		out.RawString(prefix)
+		(*in.Product).MarshalEasyJSON(out)
+	}
+	if in.Error != "" {
+		const prefix string = ",\"error\":"
+		out.RawString(prefix)
+		out.String(string(in.Error))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ProductBatchItem) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson26500425EncodeGithubComK1tten2005AvitoPvzInternalModels6(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ProductBatchItem) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson26500425EncodeGithubComK1tten2005AvitoPvzInternalModels6(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ProductBatchItem) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson26500425DecodeGithubComK1tten2005AvitoPvzInternalModels6(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ProductBatchItem) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson26500425DecodeGithubComK1tten2005AvitoPvzInternalModels6(l, v)
+}
+func easyjson26500425DecodeGithubComK1tten2005AvitoPvzInternalModels7(in *jlexer.Lexer, out *Product) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.Id).UnmarshalText(data))
+			}
+		case "dateTime":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.DateTime).UnmarshalJSON(data))
+			}
+		case "type":
+			out.Type = string(in.String())
+		case "reception_id":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.ReceptionId).UnmarshalText(data))
+			}
+		case "barcode":
+			out.Barcode = string(in.String())
+		case "barcodeFormat":
+			out.BarcodeFormat = string(in.String())
+		case "sku":
+			out.Sku = string(in.String())
+		case "orderNumber":
+			out.OrderNumber = string(in.String())
+		case "weightGrams":
+			if in.IsNull() {
+				in.Skip()
+				out.WeightGrams = nil
+			} else {
+				if out.WeightGrams == nil {
+					out.WeightGrams = new(int)
+				}
+				*out.WeightGrams = int(in.Int())
+			}
+		case "dimensions":
+			if in.IsNull() {
+				in.Skip()
+				out.Dimensions = nil
+			} else {
+				if out.Dimensions == nil {
+					out.Dimensions = new(Dimensions)
+				}
+				(*out.Dimensions).UnmarshalEasyJSON(in)
+			}
+		case "warnings":
+			if in.IsNull() {
+				in.Skip()
+				out.Warnings = nil
+			} else {
+				in.Delim('[')
+				if out.Warnings == nil {
+					if !in.IsDelim(']') {
+						out.Warnings = make([]string, 0, 4)
+					} else {
+						out.Warnings = []string{}
+					}
+				} else {
+					out.Warnings = (out.Warnings)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v13 string
+					v13 = string(in.String())
+					out.Warnings = append(out.Warnings, v13)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "deletedAt":
+			if in.IsNull() {
+				in.Skip()
+				out.DeletedAt = nil
+			} else {
+				if out.DeletedAt == nil {
+					out.DeletedAt = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.DeletedAt).UnmarshalJSON(data))
+				}
+			}
+		case "deleteReason":
+			out.DeleteReason = string(in.String())
+		case "status":
+			out.Status = string(in.String())
+		case "createdBy":
+			if in.IsNull() {
+				in.Skip()
+				out.CreatedBy = nil
+			} else {
+				if out.CreatedBy == nil {
+					out.CreatedBy = new(uuid.UUID)
+				}
+				if data := in.UnsafeBytes(); in.Ok() {
+					in.AddError((*out.CreatedBy).UnmarshalText(data))
+				}
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson26500425EncodeGithubComK1tten2005AvitoPvzInternalModels7(out *jwriter.Writer, in Product) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.RawText((in.Id).MarshalText())
+	}
+	{
+		const prefix string = ",\"dateTime\":"
+		out.RawString(prefix)
+		out.Raw((in.DateTime).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix)
+		out.String(string(in.Type))
+	}
+	{
+		const prefix string = ",\"reception_id\":"
+		out.RawString(prefix)
+		out.RawText((in.ReceptionId).MarshalText())
+	}
+	if in.Barcode != "" {
+		const prefix string = ",\"barcode\":"
+		out.RawString(prefix)
+		out.String(string(in.Barcode))
+	}
+	if in.BarcodeFormat != "" {
+		const prefix string = ",\"barcodeFormat\":"
+		out.RawString(prefix)
+		out.String(string(in.BarcodeFormat))
+	}
+	if in.Sku != "" {
+		const prefix string = ",\"sku\":"
+		out.RawString(prefix)
+		out.String(string(in.Sku))
+	}
+	if in.OrderNumber != "" {
+		const prefix string = ",\"orderNumber\":"
+		out.RawString(prefix)
+		out.String(string(in.OrderNumber))
+	}
+	if in.WeightGrams != nil {
+		const prefix string = ",\"weightGrams\":"
+		out.RawString(prefix)
+		out.Int(int(*in.WeightGrams))
+	}
+	if in.Dimensions != nil {
+		const prefix string = ",\"dimensions\":"
+		out.RawString(prefix)
+		(*in.Dimensions).MarshalEasyJSON(out)
+	}
+	if len(in.Warnings) != 0 {
+		const prefix string = ",\"warnings\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v14, v15 := range in.Warnings {
+				if v14 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v15))
+			}
+			out.RawByte(']')
+		}
+	}
+	if in.DeletedAt != nil {
+		const prefix string = ",\"deletedAt\":"
+		out.RawString(prefix)
+		out.Raw((*in.DeletedAt).MarshalJSON())
+	}
+	if in.DeleteReason != "" {
+		const prefix string = ",\"deleteReason\":"
+		out.RawString(prefix)
+		out.String(string(in.DeleteReason))
+	}
+	if in.Status != "" {
+		const prefix string = ",\"status\":"
+		out.RawString(prefix)
+		out.String(string(in.Status))
+	}
+	if in.CreatedBy != nil {
+		const prefix string = ",\"createdBy\":"
+		out.RawString(prefix)
+		out.RawText((*in.CreatedBy).MarshalText())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Product) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson26500425EncodeGithubComK1tten2005AvitoPvzInternalModels7(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Product) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson26500425EncodeGithubComK1tten2005AvitoPvzInternalModels7(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Product) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson26500425DecodeGithubComK1tten2005AvitoPvzInternalModels7(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Product) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson26500425DecodeGithubComK1tten2005AvitoPvzInternalModels7(l, v)
+}
+func easyjson26500425DecodeGithubComK1tten2005AvitoPvzInternalModels8(in *jlexer.Lexer, out *Dimensions) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "lengthMm":
+			out.LengthMm = int(in.Int())
+		case "widthMm":
+			out.WidthMm = int(in.Int())
+		case "heightMm":
+			out.HeightMm = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson26500425EncodeGithubComK1tten2005AvitoPvzInternalModels8(out *jwriter.Writer, in Dimensions) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"lengthMm\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.LengthMm))
+	}
+	{
+		const prefix string = ",\"widthMm\":"
+		out.RawString(prefix)
+		out.Int(int(in.WidthMm))
+	}
+	{
+		const prefix string = ",\"heightMm\":"
+		out.RawString(prefix)
+		out.Int(int(in.HeightMm))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Dimensions) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson26500425EncodeGithubComK1tten2005AvitoPvzInternalModels8(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Dimensions) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson26500425EncodeGithubComK1tten2005AvitoPvzInternalModels8(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Dimensions) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson26500425DecodeGithubComK1tten2005AvitoPvzInternalModels8(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Dimensions) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson26500425DecodeGithubComK1tten2005AvitoPvzInternalModels8(l, v)
 }
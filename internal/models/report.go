@@ -0,0 +1,47 @@
+package models
+
+import "time"
+
+// easyjson:json
+type IntakeReport struct {
+	GroupBy string            `json:"groupBy"`
+	From    time.Time         `json:"from"`
+	To      time.Time         `json:"to"`
+	City    string            `json:"city,omitempty"`
+	Rows    []IntakeReportRow `json:"rows"`
+}
+
+// easyjson:json
+type IntakeReportRow struct {
+	// Key — город, id ПВЗ, тип товара или начало дня/часа в UTC, смотря по группировке
+	Key                     string         `json:"key"`
+	ReceptionsOpened        int            `json:"receptionsOpened"`
+	ReceptionsClosed        int            `json:"receptionsClosed"`
+	Products                int            `json:"products"`
+	ProductsByType          map[string]int `json:"productsByType"`
+	AvgReceptionDurationSec *float64       `json:"avgReceptionDurationSec,omitempty"`
+	P95ReceptionDurationSec *float64       `json:"p95ReceptionDurationSec,omitempty"`
+}
+
+// IntakeReportFilter — приёмки, открытые в [From, To)
+type IntakeReportFilter struct {
+	GroupBy string
+	From    time.Time
+	To      time.Time
+	City    string
+}
+
+// IntakeProductCount — число товаров одного типа в группе отчёта
+type IntakeProductCount struct {
+	Key   string
+	Type  string
+	Count int
+}
+
+const (
+	ReportGroupByCity        = "city"
+	ReportGroupByPvz         = "pvz"
+	ReportGroupByProductType = "product_type"
+	ReportGroupByDay         = "day"
+	ReportGroupByHour        = "hour"
+)
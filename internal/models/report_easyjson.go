@@ -0,0 +1,303 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package models
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjsonBd361432DecodeGithubComK1tten2005AvitoPvzInternalModels(in *jlexer.Lexer, out *IntakeReportRow) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "key":
+			out.Key = string(in.String())
+		case "receptionsOpened":
+			out.ReceptionsOpened = int(in.Int())
+		case "receptionsClosed":
+			out.ReceptionsClosed = int(in.Int())
+		case "products":
+			out.Products = int(in.Int())
+		case "productsByType":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				out.ProductsByType = make(map[string]int)
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v1 int
+					v1 = int(in.Int())
+					(out.ProductsByType)[key] = v1
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		case "avgReceptionDurationSec":
+			if in.IsNull() {
+				in.Skip()
+				out.AvgReceptionDurationSec = nil
+			} else {
+				if out.AvgReceptionDurationSec == nil {
+					out.AvgReceptionDurationSec = new(float64)
+				}
+				*out.AvgReceptionDurationSec = float64(in.Float64())
+			}
+		case "p95ReceptionDurationSec":
+			if in.IsNull() {
+				in.Skip()
+				out.P95ReceptionDurationSec = nil
+			} else {
+				if out.P95ReceptionDurationSec == nil {
+					out.P95ReceptionDurationSec = new(float64)
+				}
+				*out.P95ReceptionDurationSec = float64(in.Float64())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonBd361432EncodeGithubComK1tten2005AvitoPvzInternalModels(out *jwriter.Writer, in IntakeReportRow) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"key\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Key))
+	}
+	{
+		const prefix string = ",\"receptionsOpened\":"
+		out.RawString(prefix)
+		out.Int(int(in.ReceptionsOpened))
+	}
+	{
+		const prefix string = ",\"receptionsClosed\":"
+		out.RawString(prefix)
+		out.Int(int(in.ReceptionsClosed))
+	}
+	{
+		const prefix string = ",\"products\":"
+		out.RawString(prefix)
+		out.Int(int(in.Products))
+	}
+	{
+		const prefix string = ",\"productsByType\":"
+		out.RawString(prefix)
+		if in.ProductsByType == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+			out.RawString(`null`)
+		} else {
+			out.RawByte('{')
+			v2First := true
+			for v2Name, v2Value := range in.ProductsByType {
+				if v2First {
+					v2First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v2Name))
+				out.RawByte(':')
+				out.Int(int(v2Value))
+			}
+			out.RawByte('}')
+		}
+	}
+	if in.AvgReceptionDurationSec != nil {
+		const prefix string = ",\"avgReceptionDurationSec\":"
+		out.RawString(prefix)
+		out.Float64(float64(*in.AvgReceptionDurationSec))
+	}
+	if in.P95ReceptionDurationSec != nil {
+		const prefix string = ",\"p95ReceptionDurationSec\":"
+		out.RawString(prefix)
+		out.Float64(float64(*in.P95ReceptionDurationSec))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v IntakeReportRow) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonBd361432EncodeGithubComK1tten2005AvitoPvzInternalModels(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v IntakeReportRow) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonBd361432EncodeGithubComK1tten2005AvitoPvzInternalModels(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *IntakeReportRow) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonBd361432DecodeGithubComK1tten2005AvitoPvzInternalModels(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *IntakeReportRow) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonBd361432DecodeGithubComK1tten2005AvitoPvzInternalModels(l, v)
+}
+func easyjsonBd361432DecodeGithubComK1tten2005AvitoPvzInternalModels1(in *jlexer.Lexer, out *IntakeReport) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "groupBy":
+			out.GroupBy = string(in.String())
+		case "from":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.From).UnmarshalJSON(data))
+			}
+		case "to":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.To).UnmarshalJSON(data))
+			}
+		case "city":
+			out.City = string(in.String())
+		case "rows":
+			if in.IsNull() {
+				in.Skip()
+				out.Rows = nil
+			} else {
+				in.Delim('[')
+				if out.Rows == nil {
+					if !in.IsDelim(']') {
+						out.Rows = make([]IntakeReportRow, 0, 1)
+					} else {
+						out.Rows = []IntakeReportRow{}
+					}
+				} else {
+					out.Rows = (out.Rows)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v3 IntakeReportRow
+					(v3).UnmarshalEasyJSON(in)
+					out.Rows = append(out.Rows, v3)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonBd361432EncodeGithubComK1tten2005AvitoPvzInternalModels1(out *jwriter.Writer, in IntakeReport) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"groupBy\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.GroupBy))
+	}
+	{
+		const prefix string = ",\"from\":"
+		out.RawString(prefix)
+		out.Raw((in.From).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"to\":"
+		out.RawString(prefix)
+		out.Raw((in.To).MarshalJSON())
+	}
+	if in.City != "" {
+		const prefix string = ",\"city\":"
+		out.RawString(prefix)
+		out.String(string(in.City))
+	}
+	{
+		const prefix string = ",\"rows\":"
+		out.RawString(prefix)
+		if in.Rows == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v4, v5 := range in.Rows {
+				if v4 > 0 {
+					out.RawByte(',')
+				}
+				(v5).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v IntakeReport) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonBd361432EncodeGithubComK1tten2005AvitoPvzInternalModels1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v IntakeReport) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonBd361432EncodeGithubComK1tten2005AvitoPvzInternalModels1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *IntakeReport) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonBd361432DecodeGithubComK1tten2005AvitoPvzInternalModels1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *IntakeReport) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonBd361432DecodeGithubComK1tten2005AvitoPvzInternalModels1(l, v)
+}
@@ -0,0 +1,49 @@
+package models
+
+import (
+	"time"
+
+	"github.com/satori/uuid"
+)
+
+// easyjson:json
+type PvzSchedule struct {
+	PvzId       uuid.UUID      `json:"pvzId"`
+	Timezone    string         `json:"timezone"`
+	WeeklyHours []WorkingHours `json:"weeklyHours"`
+	// OverrideUntil — до этого момента модератор разрешил приёмку вне графика
+	OverrideUntil *time.Time `json:"overrideUntil,omitempty"`
+}
+
+// WorkingHours — часы работы в один день недели: 1 — понедельник, 7 — воскресенье.
+// Время в формате 15:04 в часовом поясе ПВЗ, closesAt может быть 24:00.
+type WorkingHours struct {
+	Weekday  int    `json:"weekday"`
+	OpensAt  string `json:"opensAt"`
+	ClosesAt string `json:"closesAt"`
+}
+
+// easyjson:json
+type UpdateScheduleReq struct {
+	Timezone    string         `json:"timezone"`
+	WeeklyHours []WorkingHours `json:"weeklyHours"`
+}
+
+// easyjson:json
+type ScheduleOverrideReq struct {
+	Until time.Time `json:"until"`
+}
+
+// easyjson:json
+type PvzHoliday struct {
+	Date     string  `json:"date"`
+	OpensAt  *string `json:"opensAt,omitempty"`
+	ClosesAt *string `json:"closesAt,omitempty"`
+	Note     string  `json:"note,omitempty"`
+}
+
+type HolidayFilter struct {
+	PvzId uuid.UUID
+	From  *time.Time
+	To    *time.Time
+}
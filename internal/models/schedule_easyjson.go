@@ -0,0 +1,483 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package models
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+	time "time"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjsonA7c3c05fDecodeGithubComK1tten2005AvitoPvzInternalModels(in *jlexer.Lexer, out *UpdateScheduleReq) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "timezone":
+			out.Timezone = string(in.String())
+		case "weeklyHours":
+			if in.IsNull() {
+				in.Skip()
+				out.WeeklyHours = nil
+			} else {
+				in.Delim('[')
+				if out.WeeklyHours == nil {
+					if !in.IsDelim(']') {
+						out.WeeklyHours = make([]WorkingHours, 0, 1)
+					} else {
+						out.WeeklyHours = []WorkingHours{}
+					}
+				} else {
+					out.WeeklyHours = (out.WeeklyHours)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v1 WorkingHours
+					easyjsonA7c3c05fDecodeGithubComK1tten2005AvitoPvzInternalModels1(in, &v1)
+					out.WeeklyHours = append(out.WeeklyHours, v1)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonA7c3c05fEncodeGithubComK1tten2005AvitoPvzInternalModels(out *jwriter.Writer, in UpdateScheduleReq) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"timezone\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Timezone))
+	}
+	{
+		const prefix string = ",\"weeklyHours\":"
+		out.RawString(prefix)
+		if in.WeeklyHours == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v2, v3 := range in.WeeklyHours {
+				if v2 > 0 {
+					out.RawByte(',')
+				}
+				easyjsonA7c3c05fEncodeGithubComK1tten2005AvitoPvzInternalModels1(out, v3)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v UpdateScheduleReq) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonA7c3c05fEncodeGithubComK1tten2005AvitoPvzInternalModels(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v UpdateScheduleReq) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonA7c3c05fEncodeGithubComK1tten2005AvitoPvzInternalModels(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *UpdateScheduleReq) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonA7c3c05fDecodeGithubComK1tten2005AvitoPvzInternalModels(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *UpdateScheduleReq) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonA7c3c05fDecodeGithubComK1tten2005AvitoPvzInternalModels(l, v)
+}
+func easyjsonA7c3c05fDecodeGithubComK1tten2005AvitoPvzInternalModels1(in *jlexer.Lexer, out *WorkingHours) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "weekday":
+			out.Weekday = int(in.Int())
+		case "opensAt":
+			out.OpensAt = string(in.String())
+		case "closesAt":
+			out.ClosesAt = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonA7c3c05fEncodeGithubComK1tten2005AvitoPvzInternalModels1(out *jwriter.Writer, in WorkingHours) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"weekday\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.Weekday))
+	}
+	{
+		const prefix string = ",\"opensAt\":"
+		out.RawString(prefix)
+		out.String(string(in.OpensAt))
+	}
+	{
+		const prefix string = ",\"closesAt\":"
+		out.RawString(prefix)
+		out.String(string(in.ClosesAt))
+	}
+	out.RawByte('}')
+}
+func easyjsonA7c3c05fDecodeGithubComK1tten2005AvitoPvzInternalModels2(in *jlexer.Lexer, out *ScheduleOverrideReq) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "until":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Until).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonA7c3c05fEncodeGithubComK1tten2005AvitoPvzInternalModels2(out *jwriter.Writer, in ScheduleOverrideReq) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"until\":"
+		out.RawString(prefix[1:])
+		out.Raw((in.Until).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ScheduleOverrideReq) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonA7c3c05fEncodeGithubComK1tten2005AvitoPvzInternalModels2(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ScheduleOverrideReq) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonA7c3c05fEncodeGithubComK1tten2005AvitoPvzInternalModels2(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ScheduleOverrideReq) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonA7c3c05fDecodeGithubComK1tten2005AvitoPvzInternalModels2(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ScheduleOverrideReq) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonA7c3c05fDecodeGithubComK1tten2005AvitoPvzInternalModels2(l, v)
+}
+func easyjsonA7c3c05fDecodeGithubComK1tten2005AvitoPvzInternalModels3(in *jlexer.Lexer, out *PvzSchedule) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "pvzId":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.PvzId).UnmarshalText(data))
+			}
+		case "timezone":
+			out.Timezone = string(in.String())
+		case "weeklyHours":
+			if in.IsNull() {
+				in.Skip()
+				out.WeeklyHours = nil
+			} else {
+				in.Delim('[')
+				if out.WeeklyHours == nil {
+					if !in.IsDelim(']') {
+						out.WeeklyHours = make([]WorkingHours, 0, 1)
+					} else {
+						out.WeeklyHours = []WorkingHours{}
+					}
+				} else {
+					out.WeeklyHours = (out.WeeklyHours)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v4 WorkingHours
+					easyjsonA7c3c05fDecodeGithubComK1tten2005AvitoPvzInternalModels1(in, &v4)
+					out.WeeklyHours = append(out.WeeklyHours, v4)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "overrideUntil":
+			if in.IsNull() {
+				in.Skip()
+				out.OverrideUntil = nil
+			} else {
+				if out.OverrideUntil == nil {
+					out.OverrideUntil = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.OverrideUntil).UnmarshalJSON(data))
+				}
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonA7c3c05fEncodeGithubComK1tten2005AvitoPvzInternalModels3(out *jwriter.Writer, in PvzSchedule) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"pvzId\":"
+		out.RawString(prefix[1:])
+		out.RawText((in.PvzId).MarshalText())
+	}
+	{
+		const prefix string = ",\"timezone\":"
+		out.RawString(prefix)
+		out.String(string(in.Timezone))
+	}
+	{
+		const prefix string = ",\"weeklyHours\":"
+		out.RawString(prefix)
+		if in.WeeklyHours == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v5, v6 := range in.WeeklyHours {
+				if v5 > 0 {
+					out.RawByte(',')
+				}
+				easyjsonA7c3c05fEncodeGithubComK1tten2005AvitoPvzInternalModels1(out, v6)
+			}
+			out.RawByte(']')
+		}
+	}
+	if in.OverrideUntil != nil {
+		const prefix string = ",\"overrideUntil\":"
+		out.RawString(prefix)
+		out.Raw((*in.OverrideUntil).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v PvzSchedule) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonA7c3c05fEncodeGithubComK1tten2005AvitoPvzInternalModels3(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v PvzSchedule) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonA7c3c05fEncodeGithubComK1tten2005AvitoPvzInternalModels3(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *PvzSchedule) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonA7c3c05fDecodeGithubComK1tten2005AvitoPvzInternalModels3(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *PvzSchedule) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonA7c3c05fDecodeGithubComK1tten2005AvitoPvzInternalModels3(l, v)
+}
+func easyjsonA7c3c05fDecodeGithubComK1tten2005AvitoPvzInternalModels4(in *jlexer.Lexer, out *PvzHoliday) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "date":
+			out.Date = string(in.String())
+		case "opensAt":
+			if in.IsNull() {
+				in.Skip()
+				out.OpensAt = nil
+			} else {
+				if out.OpensAt == nil {
+					out.OpensAt = new(string)
+				}
+				*out.OpensAt = string(in.String())
+			}
+		case "closesAt":
+			if in.IsNull() {
+				in.Skip()
+				out.ClosesAt = nil
+			} else {
+				if out.ClosesAt == nil {
+					out.ClosesAt = new(string)
+				}
+				*out.ClosesAt = string(in.String())
+			}
+		case "note":
+			out.Note = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonA7c3c05fEncodeGithubComK1tten2005AvitoPvzInternalModels4(out *jwriter.Writer, in PvzHoliday) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"date\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Date))
+	}
+	if in.OpensAt != nil {
+		const prefix string = ",\"opensAt\":"
+		out.RawString(prefix)
+		out.String(string(*in.OpensAt))
+	}
+	if in.ClosesAt != nil {
+		const prefix string = ",\"closesAt\":"
+		out.RawString(prefix)
+		out.String(string(*in.ClosesAt))
+	}
+	if in.Note != "" {
+		const prefix string = ",\"note\":"
+		out.RawString(prefix)
+		out.String(string(in.Note))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v PvzHoliday) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonA7c3c05fEncodeGithubComK1tten2005AvitoPvzInternalModels4(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v PvzHoliday) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonA7c3c05fEncodeGithubComK1tten2005AvitoPvzInternalModels4(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *PvzHoliday) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonA7c3c05fDecodeGithubComK1tten2005AvitoPvzInternalModels4(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *PvzHoliday) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonA7c3c05fDecodeGithubComK1tten2005AvitoPvzInternalModels4(l, v)
+}
@@ -0,0 +1,48 @@
+package models
+
+import (
+	"time"
+
+	"github.com/satori/uuid"
+)
+
+// easyjson:json
+type Stock struct {
+	PvzId          uuid.UUID          `json:"pvzId"`
+	Total          int                `json:"total"`
+	ReadyForPickup int                `json:"readyForPickup"`
+	ByType         []ProductTypeTotal `json:"byType"`
+	ByAge          []StockAgeBucket   `json:"byAge"`
+	Items          []Product          `json:"items"`
+	NextCursor     string             `json:"nextCursor,omitempty"`
+}
+
+// easyjson:json
+type StockAgeBucket struct {
+	Label    string `json:"label"`
+	FromDays int    `json:"fromDays"`
+	ToDays   *int   `json:"toDays,omitempty"`
+	Count    int    `json:"count"`
+}
+
+// StockCount — число товаров на полке с одним типом, статусом и корзиной возраста
+type StockCount struct {
+	Type      string
+	Status    string
+	AgeBucket int
+	Count     int
+}
+
+// StockCursor указывает на последний товар предыдущей страницы остатков
+type StockCursor struct {
+	DateTime time.Time `json:"d"`
+	Id       uuid.UUID `json:"id"`
+}
+
+type StockFilter struct {
+	PvzId  uuid.UUID
+	Type   string
+	Status string
+	Cursor *StockCursor
+	Limit  int
+}
@@ -0,0 +1,320 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package models
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjsonC64b6cbcDecodeGithubComK1tten2005AvitoPvzInternalModels(in *jlexer.Lexer, out *StockAgeBucket) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "label":
+			out.Label = string(in.String())
+		case "fromDays":
+			out.FromDays = int(in.Int())
+		case "toDays":
+			if in.IsNull() {
+				in.Skip()
+				out.ToDays = nil
+			} else {
+				if out.ToDays == nil {
+					out.ToDays = new(int)
+				}
+				*out.ToDays = int(in.Int())
+			}
+		case "count":
+			out.Count = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonC64b6cbcEncodeGithubComK1tten2005AvitoPvzInternalModels(out *jwriter.Writer, in StockAgeBucket) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"label\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Label))
+	}
+	{
+		const prefix string = ",\"fromDays\":"
+		out.RawString(prefix)
+		out.Int(int(in.FromDays))
+	}
+	if in.ToDays != nil {
+		const prefix string = ",\"toDays\":"
+		out.RawString(prefix)
+		out.Int(int(*in.ToDays))
+	}
+	{
+		const prefix string = ",\"count\":"
+		out.RawString(prefix)
+		out.Int(int(in.Count))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v StockAgeBucket) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonC64b6cbcEncodeGithubComK1tten2005AvitoPvzInternalModels(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v StockAgeBucket) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonC64b6cbcEncodeGithubComK1tten2005AvitoPvzInternalModels(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *StockAgeBucket) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonC64b6cbcDecodeGithubComK1tten2005AvitoPvzInternalModels(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *StockAgeBucket) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonC64b6cbcDecodeGithubComK1tten2005AvitoPvzInternalModels(l, v)
+}
+func easyjsonC64b6cbcDecodeGithubComK1tten2005AvitoPvzInternalModels1(in *jlexer.Lexer, out *Stock) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "pvzId":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.PvzId).UnmarshalText(data))
+			}
+		case "total":
+			out.Total = int(in.Int())
+		case "readyForPickup":
+			out.ReadyForPickup = int(in.Int())
+		case "byType":
+			if in.IsNull() {
+				in.Skip()
+				out.ByType = nil
+			} else {
+				in.Delim('[')
+				if out.ByType == nil {
+					if !in.IsDelim(']') {
+						out.ByType = make([]ProductTypeTotal, 0, 2)
+					} else {
+						out.ByType = []ProductTypeTotal{}
+					}
+				} else {
+					out.ByType = (out.ByType)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v1 ProductTypeTotal
+					(v1).UnmarshalEasyJSON(in)
+					out.ByType = append(out.ByType, v1)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "byAge":
+			if in.IsNull() {
+				in.Skip()
+				out.ByAge = nil
+			} else {
+				in.Delim('[')
+				if out.ByAge == nil {
+					if !in.IsDelim(']') {
+						out.ByAge = make([]StockAgeBucket, 0, 1)
+					} else {
+						out.ByAge = []StockAgeBucket{}
+					}
+				} else {
+					out.ByAge = (out.ByAge)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v2 StockAgeBucket
+					(v2).UnmarshalEasyJSON(in)
+					out.ByAge = append(out.ByAge, v2)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "items":
+			if in.IsNull() {
+				in.Skip()
+				out.Items = nil
+			} else {
+				in.Delim('[')
+				if out.Items == nil {
+					if !in.IsDelim(']') {
+						out.Items = make([]Product, 0, 0)
+					} else {
+						out.Items = []Product{}
+					}
+				} else {
+					out.Items = (out.Items)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v3 Product
+					(v3).UnmarshalEasyJSON(in)
+					out.Items = append(out.Items, v3)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "nextCursor":
+			out.NextCursor = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonC64b6cbcEncodeGithubComK1tten2005AvitoPvzInternalModels1(out *jwriter.Writer, in Stock) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"pvzId\":"
+		out.RawString(prefix[1:])
+		out.RawText((in.PvzId).MarshalText())
+	}
+	{
+		const prefix string = ",\"total\":"
+		out.RawString(prefix)
+		out.Int(int(in.Total))
+	}
+	{
+		const prefix string = ",\"readyForPickup\":"
+		out.RawString(prefix)
+		out.Int(int(in.ReadyForPickup))
+	}
+	{
+		const prefix string = ",\"byType\":"
+		out.RawString(prefix)
+		if in.ByType == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v4, v5 := range in.ByType {
+				if v4 > 0 {
+					out.RawByte(',')
+				}
+				(v5).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"byAge\":"
+		out.RawString(prefix)
+		if in.ByAge == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v6, v7 := range in.ByAge {
+				if v6 > 0 {
+					out.RawByte(',')
+				}
+				(v7).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"items\":"
+		out.RawString(prefix)
+		if in.Items == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v8, v9 := range in.Items {
+				if v8 > 0 {
+					out.RawByte(',')
+				}
+				(v9).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	if in.NextCursor != "" {
+		const prefix string = ",\"nextCursor\":"
+		out.RawString(prefix)
+		out.String(string(in.NextCursor))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Stock) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonC64b6cbcEncodeGithubComK1tten2005AvitoPvzInternalModels1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Stock) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonC64b6cbcEncodeGithubComK1tten2005AvitoPvzInternalModels1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Stock) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonC64b6cbcDecodeGithubComK1tten2005AvitoPvzInternalModels1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Stock) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonC64b6cbcDecodeGithubComK1tten2005AvitoPvzInternalModels1(l, v)
+}
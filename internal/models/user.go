@@ -14,6 +14,13 @@ type User struct {
 	PasswordHash []byte    `json:"-"`
 }
 
+// Principal — пользователь, от имени которого выполняется запрос, по данным JWT
+type Principal struct {
+	UserId uuid.UUID
+	Email  string
+	Role   string
+}
+
 const (
 	RoleEmployee  = "employee"
 	RoleModerator = "moderator"
@@ -0,0 +1,75 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/satori/uuid"
+)
+
+// easyjson:json
+type Webhook struct {
+	Id         uuid.UUID  `json:"id"`
+	Url        string     `json:"url"`
+	Secret     string     `json:"secret,omitempty"`
+	EventTypes []string   `json:"eventTypes"`
+	PvzId      *uuid.UUID `json:"pvzId,omitempty"`
+	City       *string    `json:"city,omitempty"`
+	CreatedAt  time.Time  `json:"createdAt"`
+}
+
+// easyjson:json
+type WebhookReq struct {
+	Url        string     `json:"url"`
+	EventTypes []string   `json:"eventTypes"`
+	PvzId      *uuid.UUID `json:"pvzId"`
+	City       *string    `json:"city"`
+}
+
+// easyjson:json
+type WebhookDelivery struct {
+	Id             uuid.UUID        `json:"id"`
+	WebhookId      uuid.UUID        `json:"webhookId"`
+	EventId        uuid.UUID        `json:"eventId"`
+	EventType      string           `json:"eventType"`
+	Status         string           `json:"status"`
+	Attempts       int              `json:"attempts"`
+	NextAttemptAt  time.Time        `json:"nextAttemptAt"`
+	LastStatusCode *int             `json:"lastStatusCode,omitempty"`
+	LastError      string           `json:"lastError,omitempty"`
+	CreatedAt      time.Time        `json:"createdAt"`
+	DeliveredAt    *time.Time       `json:"deliveredAt,omitempty"`
+	History        []WebhookAttempt `json:"history,omitempty"`
+
+	// Payload, Url и Secret нужны только диспетчеру
+	Payload json.RawMessage `json:"-"`
+	Url     string          `json:"-"`
+	Secret  string          `json:"-"`
+}
+
+// easyjson:json
+type WebhookAttempt struct {
+	AttemptedAt time.Time `json:"attemptedAt"`
+	StatusCode  *int      `json:"statusCode,omitempty"`
+	Error       string    `json:"error,omitempty"`
+	DurationMs  int64     `json:"durationMs"`
+}
+
+const (
+	DeliveryStatusPending   = "pending"
+	DeliveryStatusDelivered = "delivered"
+	DeliveryStatusDead      = "dead"
+)
+
+// WebhookEventTypes — события, на которые можно подписаться
+var WebhookEventTypes = []string{
+	EventPvzCreated,
+	EventReceptionOpened,
+	EventReceptionClosed,
+	EventProductAdded,
+	EventProductDeleted,
+	EventProductRestored,
+	EventPickupReady,
+	EventProductIssued,
+	EventProductReturned,
+}
@@ -0,0 +1,626 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package models
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+	uuid "github.com/satori/uuid"
+	time "time"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjson3f91c269DecodeGithubComK1tten2005AvitoPvzInternalModels(in *jlexer.Lexer, out *WebhookReq) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "url":
+			out.Url = string(in.String())
+		case "eventTypes":
+			if in.IsNull() {
+				in.Skip()
+				out.EventTypes = nil
+			} else {
+				in.Delim('[')
+				if out.EventTypes == nil {
+					if !in.IsDelim(']') {
+						out.EventTypes = make([]string, 0, 4)
+					} else {
+						out.EventTypes = []string{}
+					}
+				} else {
+					out.EventTypes = (out.EventTypes)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v1 string
+					v1 = string(in.String())
+					out.EventTypes = append(out.EventTypes, v1)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "pvzId":
+			if in.IsNull() {
+				in.Skip()
+				out.PvzId = nil
+			} else {
+				if out.PvzId == nil {
+					out.PvzId = new(uuid.UUID)
+				}
+				if data := in.UnsafeBytes(); in.Ok() {
+					in.AddError((*out.PvzId).UnmarshalText(data))
+				}
+			}
+		case "city":
+			if in.IsNull() {
+				in.Skip()
+				out.City = nil
+			} else {
+				if out.City == nil {
+					out.City = new(string)
+				}
+				*out.City = string(in.String())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson3f91c269EncodeGithubComK1tten2005AvitoPvzInternalModels(out *jwriter.Writer, in WebhookReq) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"url\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Url))
+	}
+	{
+		const prefix string = ",\"eventTypes\":"
+		out.RawString(prefix)
+		if in.EventTypes == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v2, v3 := range in.EventTypes {
+				if v2 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v3))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"pvzId\":"
+		out.RawString(prefix)
+		if in.PvzId == nil {
+			out.RawString("null")
+		} else {
+			out.RawText((*in.PvzId).MarshalText())
+		}
+	}
+	{
+		const prefix string = ",\"city\":"
+		out.RawString(prefix)
+		if in.City == nil {
+			out.RawString("null")
+		} else {
+			out.String(string(*in.City))
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v WebhookReq) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson3f91c269EncodeGithubComK1tten2005AvitoPvzInternalModels(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v WebhookReq) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson3f91c269EncodeGithubComK1tten2005AvitoPvzInternalModels(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *WebhookReq) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson3f91c269DecodeGithubComK1tten2005AvitoPvzInternalModels(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *WebhookReq) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson3f91c269DecodeGithubComK1tten2005AvitoPvzInternalModels(l, v)
+}
+func easyjson3f91c269DecodeGithubComK1tten2005AvitoPvzInternalModels1(in *jlexer.Lexer, out *WebhookDelivery) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.Id).UnmarshalText(data))
+			}
+		case "webhookId":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.WebhookId).UnmarshalText(data))
+			}
+		case "eventId":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.EventId).UnmarshalText(data))
+			}
+		case "eventType":
+			out.EventType = string(in.String())
+		case "status":
+			out.Status = string(in.String())
+		case "attempts":
+			out.Attempts = int(in.Int())
+		case "nextAttemptAt":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.NextAttemptAt).UnmarshalJSON(data))
+			}
+		case "lastStatusCode":
+			if in.IsNull() {
+				in.Skip()
+				out.LastStatusCode = nil
+			} else {
+				if out.LastStatusCode == nil {
+					out.LastStatusCode = new(int)
+				}
+				*out.LastStatusCode = int(in.Int())
+			}
+		case "lastError":
+			out.LastError = string(in.String())
+		case "createdAt":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreatedAt).UnmarshalJSON(data))
+			}
+		case "deliveredAt":
+			if in.IsNull() {
+				in.Skip()
+				out.DeliveredAt = nil
+			} else {
+				if out.DeliveredAt == nil {
+					out.DeliveredAt = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.DeliveredAt).UnmarshalJSON(data))
+				}
+			}
+		case "history":
+			if in.IsNull() {
+				in.Skip()
+				out.History = nil
+			} else {
+				in.Delim('[')
+				if out.History == nil {
+					if !in.IsDelim(']') {
+						out.History = make([]WebhookAttempt, 0, 1)
+					} else {
+						out.History = []WebhookAttempt{}
+					}
+				} else {
+					out.History = (out.History)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v4 WebhookAttempt
+					(v4).UnmarshalEasyJSON(in)
+					out.History = append(out.History, v4)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson3f91c269EncodeGithubComK1tten2005AvitoPvzInternalModels1(out *jwriter.Writer, in WebhookDelivery) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.RawText((in.Id).MarshalText())
+	}
+	{
+		const prefix string = ",\"webhookId\":"
+		out.RawString(prefix)
+		out.RawText((in.WebhookId).MarshalText())
+	}
+	{
+		const prefix string = ",\"eventId\":"
+		out.RawString(prefix)
+		out.RawText((in.EventId).MarshalText())
+	}
+	{
+		const prefix string = ",\"eventType\":"
+		out.RawString(prefix)
+		out.String(string(in.EventType))
+	}
+	{
+		const prefix string = ",\"status\":"
+		out.RawString(prefix)
+		out.String(string(in.Status))
+	}
+	{
+		const prefix string = ",\"attempts\":"
+		out.RawString(prefix)
+		out.Int(int(in.Attempts))
+	}
+	{
+		const prefix string = ",\"nextAttemptAt\":"
+		out.RawString(prefix)
+		out.Raw((in.NextAttemptAt).MarshalJSON())
+	}
+	if in.LastStatusCode != nil {
+		const prefix string = ",\"lastStatusCode\":"
+		out.RawString(prefix)
+		out.Int(int(*in.LastStatusCode))
+	}
+	if in.LastError != "" {
+		const prefix string = ",\"lastError\":"
+		out.RawString(prefix)
+		out.String(string(in.LastError))
+	}
+	{
+		const prefix string = ",\"createdAt\":"
+		out.RawString(prefix)
+		out.Raw((in.CreatedAt).MarshalJSON())
+	}
+	if in.DeliveredAt != nil {
+		const prefix string = ",\"deliveredAt\":"
+		out.RawString(prefix)
+		out.Raw((*in.DeliveredAt).MarshalJSON())
+	}
+	if len(in.History) != 0 {
+		const prefix string = ",\"history\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v5, v6 := range in.History {
+				if v5 > 0 {
+					out.RawByte(',')
+				}
+				(v6).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v WebhookDelivery) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson3f91c269EncodeGithubComK1tten2005AvitoPvzInternalModels1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v WebhookDelivery) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson3f91c269EncodeGithubComK1tten2005AvitoPvzInternalModels1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *WebhookDelivery) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson3f91c269DecodeGithubComK1tten2005AvitoPvzInternalModels1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *WebhookDelivery) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson3f91c269DecodeGithubComK1tten2005AvitoPvzInternalModels1(l, v)
+}
+func easyjson3f91c269DecodeGithubComK1tten2005AvitoPvzInternalModels2(in *jlexer.Lexer, out *WebhookAttempt) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "attemptedAt":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.AttemptedAt).UnmarshalJSON(data))
+			}
+		case "statusCode":
+			if in.IsNull() {
+				in.Skip()
+				out.StatusCode = nil
+			} else {
+				if out.StatusCode == nil {
+					out.StatusCode = new(int)
+				}
+				*out.StatusCode = int(in.Int())
+			}
+		case "error":
+			out.Error = string(in.String())
+		case "durationMs":
+			out.DurationMs = int64(in.Int64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson3f91c269EncodeGithubComK1tten2005AvitoPvzInternalModels2(out *jwriter.Writer, in WebhookAttempt) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"attemptedAt\":"
+		out.RawString(prefix[1:])
+		out.Raw((in.AttemptedAt).MarshalJSON())
+	}
+	if in.StatusCode != nil {
+		const prefix string = ",\"statusCode\":"
+		out.RawString(prefix)
+		out.Int(int(*in.StatusCode))
+	}
+	if in.Error != "" {
+		const prefix string = ",\"error\":"
+		out.RawString(prefix)
+		out.String(string(in.Error))
+	}
+	{
+		const prefix string = ",\"durationMs\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.DurationMs))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v WebhookAttempt) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson3f91c269EncodeGithubComK1tten2005AvitoPvzInternalModels2(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v WebhookAttempt) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson3f91c269EncodeGithubComK1tten2005AvitoPvzInternalModels2(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *WebhookAttempt) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson3f91c269DecodeGithubComK1tten2005AvitoPvzInternalModels2(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *WebhookAttempt) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson3f91c269DecodeGithubComK1tten2005AvitoPvzInternalModels2(l, v)
+}
+func easyjson3f91c269DecodeGithubComK1tten2005AvitoPvzInternalModels3(in *jlexer.Lexer, out *Webhook) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.Id).UnmarshalText(data))
+			}
+		case "url":
+			out.Url = string(in.String())
+		case "secret":
+			out.Secret = string(in.String())
+		case "eventTypes":
+			if in.IsNull() {
+				in.Skip()
+				out.EventTypes = nil
+			} else {
+				in.Delim('[')
+				if out.EventTypes == nil {
+					if !in.IsDelim(']') {
+						out.EventTypes = make([]string, 0, 4)
+					} else {
+						out.EventTypes = []string{}
+					}
+				} else {
+					out.EventTypes = (out.EventTypes)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v7 string
+					v7 = string(in.String())
+					out.EventTypes = append(out.EventTypes, v7)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "pvzId":
+			if in.IsNull() {
+				in.Skip()
+				out.PvzId = nil
+			} else {
+				if out.PvzId == nil {
+					out.PvzId = new(uuid.UUID)
+				}
+				if data := in.UnsafeBytes(); in.Ok() {
+					in.AddError((*out.PvzId).UnmarshalText(data))
+				}
+			}
+		case "city":
+			if in.IsNull() {
+				in.Skip()
+				out.City = nil
+			} else {
+				if out.City == nil {
+					out.City = new(string)
+				}
+				*out.City = string(in.String())
+			}
+		case "createdAt":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreatedAt).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson3f91c269EncodeGithubComK1tten2005AvitoPvzInternalModels3(out *jwriter.Writer, in Webhook) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.RawText((in.Id).MarshalText())
+	}
+	{
+		const prefix string = ",\"url\":"
+		out.RawString(prefix)
+		out.String(string(in.Url))
+	}
+	if in.Secret != "" {
+		const prefix string = ",\"secret\":"
+		out.RawString(prefix)
+		out.String(string(in.Secret))
+	}
+	{
+		const prefix string = ",\"eventTypes\":"
+		out.RawString(prefix)
+		if in.EventTypes == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v8, v9 := range in.EventTypes {
+				if v8 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v9))
+			}
+			out.RawByte(']')
+		}
+	}
+	if in.PvzId != nil {
+		const prefix string = ",\"pvzId\":"
+		out.RawString(prefix)
+		out.RawText((*in.PvzId).MarshalText())
+	}
+	if in.City != nil {
+		const prefix string = ",\"city\":"
+		out.RawString(prefix)
+		out.String(string(*in.City))
+	}
+	{
+		const prefix string = ",\"createdAt\":"
+		out.RawString(prefix)
+		out.Raw((in.CreatedAt).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Webhook) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson3f91c269EncodeGithubComK1tten2005AvitoPvzInternalModels3(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Webhook) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson3f91c269EncodeGithubComK1tten2005AvitoPvzInternalModels3(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Webhook) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson3f91c269DecodeGithubComK1tten2005AvitoPvzInternalModels3(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Webhook) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson3f91c269DecodeGithubComK1tten2005AvitoPvzInternalModels3(l, v)
+}
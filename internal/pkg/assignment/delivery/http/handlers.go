@@ -0,0 +1,122 @@
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	"github.com/K1tten2005/avito_pvz/internal/pkg/assignment"
+	"github.com/K1tten2005/avito_pvz/internal/pkg/utils/logger"
+	"github.com/K1tten2005/avito_pvz/internal/pkg/utils/send_err"
+	"github.com/gorilla/mux"
+	"github.com/satori/uuid"
+)
+
+type AssignmentHandler struct {
+	uc assignment.AssignmentUsecase
+}
+
+func CreateAssignmentHandler(uc assignment.AssignmentUsecase) *AssignmentHandler {
+	return &AssignmentHandler{uc: uc}
+}
+
+func (h *AssignmentHandler) GetAssignments(w http.ResponseWriter, r *http.Request) {
+	loggerVar := logger.GetLoggerFromContext(r.Context()).With(slog.String("func", logger.GetFuncName()))
+
+	userID, ok := pathUUID(w, r, loggerVar, "userId")
+	if !ok {
+		return
+	}
+
+	result, err := h.uc.GetAssignments(r.Context(), userID)
+	if err != nil {
+		statusCode := errStatus(err)
+		logger.LogHandlerError(loggerVar, fmt.Errorf("error on a level below (usecase): %w", err), statusCode)
+		send_err.SendError(w, err.Error(), statusCode)
+		return
+	}
+
+	sendJSON(w, loggerVar, result, http.StatusOK)
+}
+
+func (h *AssignmentHandler) Assign(w http.ResponseWriter, r *http.Request) {
+	loggerVar := logger.GetLoggerFromContext(r.Context()).With(slog.String("func", logger.GetFuncName()))
+
+	userID, ok := pathUUID(w, r, loggerVar, "userId")
+	if !ok {
+		return
+	}
+	pvzID, ok := pathUUID(w, r, loggerVar, "pvzId")
+	if !ok {
+		return
+	}
+
+	result, err := h.uc.Assign(r.Context(), userID, pvzID)
+	if err != nil {
+		statusCode := errStatus(err)
+		logger.LogHandlerError(loggerVar, fmt.Errorf("error on a level below (usecase): %w", err), statusCode)
+		send_err.SendError(w, err.Error(), statusCode)
+		return
+	}
+
+	sendJSON(w, loggerVar, result, http.StatusOK)
+}
+
+func (h *AssignmentHandler) Unassign(w http.ResponseWriter, r *http.Request) {
+	loggerVar := logger.GetLoggerFromContext(r.Context()).With(slog.String("func", logger.GetFuncName()))
+
+	userID, ok := pathUUID(w, r, loggerVar, "userId")
+	if !ok {
+		return
+	}
+	pvzID, ok := pathUUID(w, r, loggerVar, "pvzId")
+	if !ok {
+		return
+	}
+
+	if err := h.uc.Unassign(r.Context(), userID, pvzID); err != nil {
+		statusCode := errStatus(err)
+		logger.LogHandlerError(loggerVar, fmt.Errorf("error on a level below (usecase): %w", err), statusCode)
+		send_err.SendError(w, err.Error(), statusCode)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+	logger.LogHandlerInfo(loggerVar, "Successful", http.StatusNoContent)
+}
+
+// pathUUID разбирает переменную пути name и сам отвечает 400, если это не UUID
+func pathUUID(w http.ResponseWriter, r *http.Request, loggerVar *slog.Logger, name string) (uuid.UUID, bool) {
+	id, err := uuid.FromString(mux.Vars(r)[name])
+	if err != nil {
+		logger.LogHandlerError(loggerVar, err, http.StatusBadRequest)
+		send_err.SendError(w, fmt.Sprintf("wrong UUID format for %s query parameter", name), http.StatusBadRequest)
+		return uuid.Nil, false
+	}
+	return id, true
+}
+
+func sendJSON(w http.ResponseWriter, loggerVar *slog.Logger, body any, statusCode int) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	if err := json.NewEncoder(w).Encode(body); err != nil {
+		logger.LogHandlerError(loggerVar, fmt.Errorf("error while forming JSON: %w", err), http.StatusInternalServerError)
+		send_err.SendError(w, "error while forming JSON", http.StatusInternalServerError)
+		return
+	}
+	logger.LogHandlerInfo(loggerVar, "Successful", statusCode)
+}
+
+func errStatus(err error) int {
+	switch {
+	case errors.Is(err, assignment.ErrPvzNotFound), errors.Is(err, assignment.ErrUserNotFound),
+		errors.Is(err, assignment.ErrAssignmentNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, assignment.ErrNotEmployee):
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,33 @@
+package assignment
+
+import (
+	"context"
+	"errors"
+
+	"github.com/K1tten2005/avito_pvz/internal/models"
+	"github.com/satori/uuid"
+)
+
+var (
+	ErrPvzNotFound        = errors.New("pvz not found")
+	ErrUserNotFound       = errors.New("user not found")
+	ErrNotEmployee        = errors.New("only employees can be assigned to a pvz")
+	ErrAssignmentNotFound = errors.New("assignment not found")
+)
+
+type AssignmentRepo interface {
+	SelectUser(ctx context.Context, userID uuid.UUID) (models.User, error)
+	SelectAssignments(ctx context.Context, userID uuid.UUID) ([]models.PvzAssignment, error)
+	// InsertAssignment закрепляет сотрудника за ПВЗ; повторный вызов возвращает уже существующую запись
+	InsertAssignment(ctx context.Context, userID, pvzID uuid.UUID) (models.PvzAssignment, error)
+	DeleteAssignment(ctx context.Context, userID, pvzID uuid.UUID) error
+	IsAssigned(ctx context.Context, userID, pvzID uuid.UUID) (bool, error)
+}
+
+type AssignmentUsecase interface {
+	GetAssignments(ctx context.Context, userID uuid.UUID) ([]models.PvzAssignment, error)
+	Assign(ctx context.Context, userID, pvzID uuid.UUID) (models.PvzAssignment, error)
+	Unassign(ctx context.Context, userID, pvzID uuid.UUID) error
+	// IsAssigned используется ACL для проверки прав сотрудника на конкретный ПВЗ
+	IsAssigned(ctx context.Context, userID, pvzID uuid.UUID) (bool, error)
+}
@@ -0,0 +1,193 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/pkg/assignment/interfaces.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	models "github.com/K1tten2005/avito_pvz/internal/models"
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/satori/uuid"
+)
+
+// MockAssignmentRepo is a mock of AssignmentRepo interface.
+type MockAssignmentRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockAssignmentRepoMockRecorder
+}
+
+// MockAssignmentRepoMockRecorder is the mock recorder for MockAssignmentRepo.
+type MockAssignmentRepoMockRecorder struct {
+	mock *MockAssignmentRepo
+}
+
+// NewMockAssignmentRepo creates a new mock instance.
+func NewMockAssignmentRepo(ctrl *gomock.Controller) *MockAssignmentRepo {
+	mock := &MockAssignmentRepo{ctrl: ctrl}
+	mock.recorder = &MockAssignmentRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAssignmentRepo) EXPECT() *MockAssignmentRepoMockRecorder {
+	return m.recorder
+}
+
+// DeleteAssignment mocks base method.
+func (m *MockAssignmentRepo) DeleteAssignment(ctx context.Context, userID, pvzID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAssignment", ctx, userID, pvzID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAssignment indicates an expected call of DeleteAssignment.
+func (mr *MockAssignmentRepoMockRecorder) DeleteAssignment(ctx, userID, pvzID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAssignment", reflect.TypeOf((*MockAssignmentRepo)(nil).DeleteAssignment), ctx, userID, pvzID)
+}
+
+// InsertAssignment mocks base method.
+func (m *MockAssignmentRepo) InsertAssignment(ctx context.Context, userID, pvzID uuid.UUID) (models.PvzAssignment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertAssignment", ctx, userID, pvzID)
+	ret0, _ := ret[0].(models.PvzAssignment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertAssignment indicates an expected call of InsertAssignment.
+func (mr *MockAssignmentRepoMockRecorder) InsertAssignment(ctx, userID, pvzID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertAssignment", reflect.TypeOf((*MockAssignmentRepo)(nil).InsertAssignment), ctx, userID, pvzID)
+}
+
+// IsAssigned mocks base method.
+func (m *MockAssignmentRepo) IsAssigned(ctx context.Context, userID, pvzID uuid.UUID) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsAssigned", ctx, userID, pvzID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsAssigned indicates an expected call of IsAssigned.
+func (mr *MockAssignmentRepoMockRecorder) IsAssigned(ctx, userID, pvzID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsAssigned", reflect.TypeOf((*MockAssignmentRepo)(nil).IsAssigned), ctx, userID, pvzID)
+}
+
+// SelectAssignments mocks base method.
+func (m *MockAssignmentRepo) SelectAssignments(ctx context.Context, userID uuid.UUID) ([]models.PvzAssignment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectAssignments", ctx, userID)
+	ret0, _ := ret[0].([]models.PvzAssignment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectAssignments indicates an expected call of SelectAssignments.
+func (mr *MockAssignmentRepoMockRecorder) SelectAssignments(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectAssignments", reflect.TypeOf((*MockAssignmentRepo)(nil).SelectAssignments), ctx, userID)
+}
+
+// SelectUser mocks base method.
+func (m *MockAssignmentRepo) SelectUser(ctx context.Context, userID uuid.UUID) (models.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectUser", ctx, userID)
+	ret0, _ := ret[0].(models.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectUser indicates an expected call of SelectUser.
+func (mr *MockAssignmentRepoMockRecorder) SelectUser(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectUser", reflect.TypeOf((*MockAssignmentRepo)(nil).SelectUser), ctx, userID)
+}
+
+// MockAssignmentUsecase is a mock of AssignmentUsecase interface.
+type MockAssignmentUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockAssignmentUsecaseMockRecorder
+}
+
+// MockAssignmentUsecaseMockRecorder is the mock recorder for MockAssignmentUsecase.
+type MockAssignmentUsecaseMockRecorder struct {
+	mock *MockAssignmentUsecase
+}
+
+// NewMockAssignmentUsecase creates a new mock instance.
+func NewMockAssignmentUsecase(ctrl *gomock.Controller) *MockAssignmentUsecase {
+	mock := &MockAssignmentUsecase{ctrl: ctrl}
+	mock.recorder = &MockAssignmentUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAssignmentUsecase) EXPECT() *MockAssignmentUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Assign mocks base method.
+func (m *MockAssignmentUsecase) Assign(ctx context.Context, userID, pvzID uuid.UUID) (models.PvzAssignment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Assign", ctx, userID, pvzID)
+	ret0, _ := ret[0].(models.PvzAssignment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Assign indicates an expected call of Assign.
+func (mr *MockAssignmentUsecaseMockRecorder) Assign(ctx, userID, pvzID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Assign", reflect.TypeOf((*MockAssignmentUsecase)(nil).Assign), ctx, userID, pvzID)
+}
+
+// GetAssignments mocks base method.
+func (m *MockAssignmentUsecase) GetAssignments(ctx context.Context, userID uuid.UUID) ([]models.PvzAssignment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAssignments", ctx, userID)
+	ret0, _ := ret[0].([]models.PvzAssignment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAssignments indicates an expected call of GetAssignments.
+func (mr *MockAssignmentUsecaseMockRecorder) GetAssignments(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAssignments", reflect.TypeOf((*MockAssignmentUsecase)(nil).GetAssignments), ctx, userID)
+}
+
+// IsAssigned mocks base method.
+func (m *MockAssignmentUsecase) IsAssigned(ctx context.Context, userID, pvzID uuid.UUID) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsAssigned", ctx, userID, pvzID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsAssigned indicates an expected call of IsAssigned.
+func (mr *MockAssignmentUsecaseMockRecorder) IsAssigned(ctx, userID, pvzID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsAssigned", reflect.TypeOf((*MockAssignmentUsecase)(nil).IsAssigned), ctx, userID, pvzID)
+}
+
+// Unassign mocks base method.
+func (m *MockAssignmentUsecase) Unassign(ctx context.Context, userID, pvzID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Unassign", ctx, userID, pvzID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Unassign indicates an expected call of Unassign.
+func (mr *MockAssignmentUsecaseMockRecorder) Unassign(ctx, userID, pvzID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unassign", reflect.TypeOf((*MockAssignmentUsecase)(nil).Unassign), ctx, userID, pvzID)
+}
@@ -0,0 +1,136 @@
+package repo
+
+import (
+	"context"
+	_ "embed"
+	"errors"
+	"log/slog"
+
+	"github.com/K1tten2005/avito_pvz/internal/models"
+	"github.com/K1tten2005/avito_pvz/internal/pkg/assignment"
+	"github.com/K1tten2005/avito_pvz/internal/pkg/utils/logger"
+	"github.com/K1tten2005/avito_pvz/internal/pkg/utils/pgerr"
+	"github.com/jackc/pgtype/pgxtype"
+	"github.com/jackc/pgx/v4"
+	"github.com/satori/uuid"
+)
+
+//go:embed sql/selectUser.sql
+var selectUser string
+
+//go:embed sql/selectAssignments.sql
+var selectAssignments string
+
+//go:embed sql/insertAssignment.sql
+var insertAssignment string
+
+//go:embed sql/deleteAssignment.sql
+var deleteAssignment string
+
+//go:embed sql/isAssigned.sql
+var isAssigned string
+
+type AssignmentRepo struct {
+	db pgxtype.Querier
+}
+
+func CreateAssignmentRepo(db pgxtype.Querier) *AssignmentRepo {
+	return &AssignmentRepo{
+		db: db,
+	}
+}
+
+func (repo *AssignmentRepo) SelectUser(ctx context.Context, userID uuid.UUID) (models.User, error) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	var user models.User
+	err := repo.db.QueryRow(ctx, selectUser, userID).Scan(&user.Id, &user.Email, &user.Role)
+	if errors.Is(err, pgx.ErrNoRows) {
+		loggerVar.Error(assignment.ErrUserNotFound.Error())
+		return models.User{}, assignment.ErrUserNotFound
+	}
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return models.User{}, err
+	}
+
+	loggerVar.Info("Successful")
+	return user, nil
+}
+
+func (repo *AssignmentRepo) SelectAssignments(ctx context.Context, userID uuid.UUID) ([]models.PvzAssignment, error) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	rows, err := repo.db.Query(ctx, selectAssignments, userID)
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return nil, err
+	}
+	defer rows.Close()
+
+	result := []models.PvzAssignment{}
+	for rows.Next() {
+		var item models.PvzAssignment
+		if err := rows.Scan(&item.UserId, &item.PvzId, &item.AssignedAt); err != nil {
+			loggerVar.Error(err.Error())
+			return nil, err
+		}
+		result = append(result, item)
+	}
+	if err := rows.Err(); err != nil {
+		loggerVar.Error(err.Error())
+		return nil, err
+	}
+
+	loggerVar.Info("Successful")
+	return result, nil
+}
+
+func (repo *AssignmentRepo) InsertAssignment(ctx context.Context, userID, pvzID uuid.UUID) (models.PvzAssignment, error) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	result := models.PvzAssignment{UserId: userID, PvzId: pvzID}
+	err := repo.db.QueryRow(ctx, insertAssignment, userID, pvzID).Scan(&result.AssignedAt)
+	// Пользователь проверен до вставки, поэтому внешний ключ может нарушить только ПВЗ
+	if pgerr.IsForeignKeyViolation(err) {
+		loggerVar.Error(assignment.ErrPvzNotFound.Error())
+		return models.PvzAssignment{}, assignment.ErrPvzNotFound
+	}
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return models.PvzAssignment{}, err
+	}
+
+	loggerVar.Info("Successful")
+	return result, nil
+}
+
+func (repo *AssignmentRepo) DeleteAssignment(ctx context.Context, userID, pvzID uuid.UUID) error {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	tag, err := repo.db.Exec(ctx, deleteAssignment, userID, pvzID)
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		loggerVar.Error(assignment.ErrAssignmentNotFound.Error())
+		return assignment.ErrAssignmentNotFound
+	}
+
+	loggerVar.Info("Successful")
+	return nil
+}
+
+func (repo *AssignmentRepo) IsAssigned(ctx context.Context, userID, pvzID uuid.UUID) (bool, error) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	var assigned bool
+	if err := repo.db.QueryRow(ctx, isAssigned, userID, pvzID).Scan(&assigned); err != nil {
+		loggerVar.Error(err.Error())
+		return false, err
+	}
+
+	loggerVar.Info("Successful")
+	return assigned, nil
+}
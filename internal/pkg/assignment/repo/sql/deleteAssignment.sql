@@ -0,0 +1 @@
+DELETE FROM pvz_assignment WHERE user_id = $1 AND pvz_id = $2
@@ -0,0 +1,10 @@
+-- Вторая часть UNION видит снимок до вставки, поэтому строка возвращается ровно одна
+WITH inserted AS (
+    INSERT INTO pvz_assignment (user_id, pvz_id)
+    VALUES ($1, $2)
+    ON CONFLICT (user_id, pvz_id) DO NOTHING
+    RETURNING assigned_at
+)
+SELECT assigned_at FROM inserted
+UNION ALL
+SELECT assigned_at FROM pvz_assignment WHERE user_id = $1 AND pvz_id = $2
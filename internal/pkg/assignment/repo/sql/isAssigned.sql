@@ -0,0 +1 @@
+SELECT EXISTS (SELECT 1 FROM pvz_assignment WHERE user_id = $1 AND pvz_id = $2)
@@ -0,0 +1,4 @@
+SELECT user_id, pvz_id, assigned_at
+FROM pvz_assignment
+WHERE user_id = $1
+ORDER BY assigned_at, pvz_id
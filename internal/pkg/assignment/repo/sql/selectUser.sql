@@ -0,0 +1 @@
+SELECT id, email, role FROM users WHERE id = $1
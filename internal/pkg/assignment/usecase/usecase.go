@@ -0,0 +1,86 @@
+package usecase
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/K1tten2005/avito_pvz/internal/models"
+	"github.com/K1tten2005/avito_pvz/internal/pkg/assignment"
+	"github.com/K1tten2005/avito_pvz/internal/pkg/utils/logger"
+	"github.com/satori/uuid"
+)
+
+type AssignmentUsecase struct {
+	repo assignment.AssignmentRepo
+}
+
+func CreateAssignmentUsecase(repo assignment.AssignmentRepo) *AssignmentUsecase {
+	return &AssignmentUsecase{repo: repo}
+}
+
+func (uc *AssignmentUsecase) GetAssignments(ctx context.Context, userID uuid.UUID) ([]models.PvzAssignment, error) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	if _, err := uc.repo.SelectUser(ctx, userID); err != nil {
+		loggerVar.Error(err.Error())
+		return nil, err
+	}
+
+	result, err := uc.repo.SelectAssignments(ctx, userID)
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return nil, err
+	}
+
+	loggerVar.Info("Success")
+	return result, nil
+}
+
+func (uc *AssignmentUsecase) Assign(ctx context.Context, userID, pvzID uuid.UUID) (models.PvzAssignment, error) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	user, err := uc.repo.SelectUser(ctx, userID)
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return models.PvzAssignment{}, err
+	}
+	// Модератор и так работает со всеми ПВЗ
+	if user.Role != models.RoleEmployee {
+		loggerVar.Error(assignment.ErrNotEmployee.Error())
+		return models.PvzAssignment{}, assignment.ErrNotEmployee
+	}
+
+	result, err := uc.repo.InsertAssignment(ctx, userID, pvzID)
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return models.PvzAssignment{}, err
+	}
+
+	loggerVar.Info("Success")
+	return result, nil
+}
+
+func (uc *AssignmentUsecase) Unassign(ctx context.Context, userID, pvzID uuid.UUID) error {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	if err := uc.repo.DeleteAssignment(ctx, userID, pvzID); err != nil {
+		loggerVar.Error(err.Error())
+		return err
+	}
+
+	loggerVar.Info("Success")
+	return nil
+}
+
+func (uc *AssignmentUsecase) IsAssigned(ctx context.Context, userID, pvzID uuid.UUID) (bool, error) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	assigned, err := uc.repo.IsAssigned(ctx, userID, pvzID)
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return false, err
+	}
+
+	loggerVar.Info("Success")
+	return assigned, nil
+}
@@ -0,0 +1,86 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/K1tten2005/avito_pvz/internal/models"
+	"github.com/K1tten2005/avito_pvz/internal/pkg/assignment"
+	"github.com/K1tten2005/avito_pvz/internal/pkg/assignment/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/satori/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAssignmentUsecase_Assign(t *testing.T) {
+	userID, pvzID := uuid.NewV4(), uuid.NewV4()
+	assigned := models.PvzAssignment{UserId: userID, PvzId: pvzID, AssignedAt: time.Date(2025, 3, 10, 12, 0, 0, 0, time.UTC)}
+
+	tests := []struct {
+		name         string
+		mockBehavior func(repo *mocks.MockAssignmentRepo)
+		expectedErr  error
+	}{
+		{
+			name: "user not found",
+			mockBehavior: func(repo *mocks.MockAssignmentRepo) {
+				repo.EXPECT().SelectUser(gomock.Any(), userID).Return(models.User{}, assignment.ErrUserNotFound)
+			},
+			expectedErr: assignment.ErrUserNotFound,
+		},
+		{
+			name: "moderator cannot be assigned",
+			mockBehavior: func(repo *mocks.MockAssignmentRepo) {
+				repo.EXPECT().SelectUser(gomock.Any(), userID).Return(models.User{Id: userID, Role: models.RoleModerator}, nil)
+			},
+			expectedErr: assignment.ErrNotEmployee,
+		},
+		{
+			name: "pvz not found",
+			mockBehavior: func(repo *mocks.MockAssignmentRepo) {
+				repo.EXPECT().SelectUser(gomock.Any(), userID).Return(models.User{Id: userID, Role: models.RoleEmployee}, nil)
+				repo.EXPECT().InsertAssignment(gomock.Any(), userID, pvzID).Return(models.PvzAssignment{}, assignment.ErrPvzNotFound)
+			},
+			expectedErr: assignment.ErrPvzNotFound,
+		},
+		{
+			name: "success",
+			mockBehavior: func(repo *mocks.MockAssignmentRepo) {
+				repo.EXPECT().SelectUser(gomock.Any(), userID).Return(models.User{Id: userID, Role: models.RoleEmployee}, nil)
+				repo.EXPECT().InsertAssignment(gomock.Any(), userID, pvzID).Return(assigned, nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repo := mocks.NewMockAssignmentRepo(ctrl)
+			tt.mockBehavior(repo)
+
+			result, err := CreateAssignmentUsecase(repo).Assign(context.Background(), userID, pvzID)
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, assigned, result)
+		})
+	}
+}
+
+func TestAssignmentUsecase_GetAssignments(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userID := uuid.NewV4()
+	repo := mocks.NewMockAssignmentRepo(ctrl)
+	repo.EXPECT().SelectUser(gomock.Any(), userID).Return(models.User{}, assignment.ErrUserNotFound)
+
+	_, err := CreateAssignmentUsecase(repo).GetAssignments(context.Background(), userID)
+	assert.ErrorIs(t, err, assignment.ErrUserNotFound)
+}
@@ -25,11 +25,8 @@ func CreateAuthUsecase(repo auth.AuthRepo) *AuthUsecase {
 func (uc *AuthUsecase) DummyLogin(ctx context.Context, data models.DummyLoginReq) (string, error) {
 	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
 
-	dummyUser := models.User{
-		Id:    uuid.NewV4(),
-		Email: "dummy@user.com",
-		Role:  data.Role,
-	}
+	// тестовый пользователь не хранится в users: токен без sub не закрепить за ПВЗ
+	dummyUser := models.User{Role: data.Role}
 
 	token, err := jwtUtils.GenerateToken(dummyUser)
 	if err != nil {
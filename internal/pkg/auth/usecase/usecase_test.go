@@ -35,6 +35,9 @@ func TestAuthUsecase_DummyLogin(t *testing.T) {
 				})
 				assert.NoError(t, err)
 				assert.Equal(t, "employee", claims["role"])
+				// тестовый пользователь не существует в users и не может быть закреплён за ПВЗ
+				assert.NotContains(t, claims, "sub")
+				assert.NotContains(t, claims, "email")
 			},
 			expectedErr: nil,
 		},
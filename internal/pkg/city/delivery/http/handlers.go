@@ -0,0 +1,142 @@
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	"github.com/K1tten2005/avito_pvz/internal/models"
+	"github.com/K1tten2005/avito_pvz/internal/pkg/city"
+	"github.com/K1tten2005/avito_pvz/internal/pkg/utils/logger"
+	"github.com/K1tten2005/avito_pvz/internal/pkg/utils/send_err"
+	"github.com/gorilla/mux"
+	"github.com/mailru/easyjson"
+	"github.com/satori/uuid"
+)
+
+type CityHandler struct {
+	uc city.CityUsecase
+}
+
+func CreateCityHandler(uc city.CityUsecase) *CityHandler {
+	return &CityHandler{uc: uc}
+}
+
+func (h *CityHandler) GetCities(w http.ResponseWriter, r *http.Request) {
+	loggerVar := logger.GetLoggerFromContext(r.Context()).With(slog.String("func", logger.GetFuncName()))
+
+	cities, err := h.uc.GetCities(r.Context())
+	if err != nil {
+		logger.LogHandlerError(loggerVar, fmt.Errorf("error on a level below (usecase): %w", err), http.StatusInternalServerError)
+		send_err.SendError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(cities); err != nil {
+		logger.LogHandlerError(loggerVar, fmt.Errorf("error while forming JSON: %w", err), http.StatusInternalServerError)
+		send_err.SendError(w, "error while forming JSON", http.StatusInternalServerError)
+		return
+	}
+	logger.LogHandlerInfo(loggerVar, "Successful", http.StatusOK)
+}
+
+func (h *CityHandler) CreateCity(w http.ResponseWriter, r *http.Request) {
+	loggerVar := logger.GetLoggerFromContext(r.Context()).With(slog.String("func", logger.GetFuncName()))
+
+	var req models.CityReq
+	if err := easyjson.UnmarshalFromReader(r.Body, &req); err != nil {
+		logger.LogHandlerError(loggerVar, fmt.Errorf("error while parsing JSON: %w", err), http.StatusBadRequest)
+		send_err.SendError(w, "error while parsing JSON", http.StatusBadRequest)
+		return
+	}
+	req.Sanitize()
+
+	created, err := h.uc.CreateCity(r.Context(), req)
+	if err != nil {
+		statusCode := errStatus(err)
+		logger.LogHandlerError(loggerVar, fmt.Errorf("error on a level below (usecase): %w", err), statusCode)
+		send_err.SendError(w, err.Error(), statusCode)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(w).Encode(created); err != nil {
+		logger.LogHandlerError(loggerVar, fmt.Errorf("error while forming JSON: %w", err), http.StatusInternalServerError)
+		send_err.SendError(w, "error while forming JSON", http.StatusInternalServerError)
+		return
+	}
+	logger.LogHandlerInfo(loggerVar, "Successful", http.StatusCreated)
+}
+
+func (h *CityHandler) UpdateCity(w http.ResponseWriter, r *http.Request) {
+	loggerVar := logger.GetLoggerFromContext(r.Context()).With(slog.String("func", logger.GetFuncName()))
+
+	id, err := uuid.FromString(mux.Vars(r)["id"])
+	if err != nil {
+		logger.LogHandlerError(loggerVar, err, http.StatusBadRequest)
+		send_err.SendError(w, "wrong UUID format for id query parameter", http.StatusBadRequest)
+		return
+	}
+
+	var req models.CityReq
+	if err := easyjson.UnmarshalFromReader(r.Body, &req); err != nil {
+		logger.LogHandlerError(loggerVar, fmt.Errorf("error while parsing JSON: %w", err), http.StatusBadRequest)
+		send_err.SendError(w, "error while parsing JSON", http.StatusBadRequest)
+		return
+	}
+	req.Sanitize()
+
+	updated, err := h.uc.UpdateCity(r.Context(), id, req)
+	if err != nil {
+		statusCode := errStatus(err)
+		logger.LogHandlerError(loggerVar, fmt.Errorf("error on a level below (usecase): %w", err), statusCode)
+		send_err.SendError(w, err.Error(), statusCode)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(updated); err != nil {
+		logger.LogHandlerError(loggerVar, fmt.Errorf("error while forming JSON: %w", err), http.StatusInternalServerError)
+		send_err.SendError(w, "error while forming JSON", http.StatusInternalServerError)
+		return
+	}
+	logger.LogHandlerInfo(loggerVar, "Successful", http.StatusOK)
+}
+
+func (h *CityHandler) DeleteCity(w http.ResponseWriter, r *http.Request) {
+	loggerVar := logger.GetLoggerFromContext(r.Context()).With(slog.String("func", logger.GetFuncName()))
+
+	id, err := uuid.FromString(mux.Vars(r)["id"])
+	if err != nil {
+		logger.LogHandlerError(loggerVar, err, http.StatusBadRequest)
+		send_err.SendError(w, "wrong UUID format for id query parameter", http.StatusBadRequest)
+		return
+	}
+
+	if err := h.uc.DeleteCity(r.Context(), id); err != nil {
+		statusCode := errStatus(err)
+		logger.LogHandlerError(loggerVar, fmt.Errorf("error on a level below (usecase): %w", err), statusCode)
+		send_err.SendError(w, err.Error(), statusCode)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+	logger.LogHandlerInfo(loggerVar, "Successful", http.StatusNoContent)
+}
+
+func errStatus(err error) int {
+	switch {
+	case errors.Is(err, city.ErrCityNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, city.ErrInvalidCityName):
+		return http.StatusBadRequest
+	case errors.Is(err, city.ErrCityExists), errors.Is(err, city.ErrCityInUse):
+		return http.StatusConflict
+	default:
+		return http.StatusInternalServerError
+	}
+}
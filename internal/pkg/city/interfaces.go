@@ -0,0 +1,33 @@
+package city
+
+import (
+	"context"
+	"errors"
+
+	"github.com/K1tten2005/avito_pvz/internal/models"
+	"github.com/satori/uuid"
+)
+
+var (
+	ErrCityNotFound    = errors.New("city not found")
+	ErrCityExists      = errors.New("city or alias already exists")
+	ErrCityInUse       = errors.New("city is used by pvz")
+	ErrInvalidCityName = errors.New("invalid city name")
+)
+
+type CityRepo interface {
+	SelectCities(ctx context.Context) ([]models.City, error)
+	SelectCityByID(ctx context.Context, id uuid.UUID) (models.City, error)
+	InsertCity(ctx context.Context, city models.City) error
+	UpdateCity(ctx context.Context, city models.City) error
+	DeleteCity(ctx context.Context, id uuid.UUID) error
+	IsCityInUse(ctx context.Context, name string) (bool, error)
+}
+
+type CityUsecase interface {
+	GetCities(ctx context.Context) ([]models.City, error)
+	CreateCity(ctx context.Context, req models.CityReq) (models.City, error)
+	UpdateCity(ctx context.Context, id uuid.UUID, req models.CityReq) (models.City, error)
+	DeleteCity(ctx context.Context, id uuid.UUID) error
+	RefreshCache(ctx context.Context) error
+}
@@ -0,0 +1,220 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/pkg/city/interfaces.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	models "github.com/K1tten2005/avito_pvz/internal/models"
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/satori/uuid"
+)
+
+// MockCityRepo is a mock of CityRepo interface.
+type MockCityRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockCityRepoMockRecorder
+}
+
+// MockCityRepoMockRecorder is the mock recorder for MockCityRepo.
+type MockCityRepoMockRecorder struct {
+	mock *MockCityRepo
+}
+
+// NewMockCityRepo creates a new mock instance.
+func NewMockCityRepo(ctrl *gomock.Controller) *MockCityRepo {
+	mock := &MockCityRepo{ctrl: ctrl}
+	mock.recorder = &MockCityRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCityRepo) EXPECT() *MockCityRepoMockRecorder {
+	return m.recorder
+}
+
+// DeleteCity mocks base method.
+func (m *MockCityRepo) DeleteCity(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCity", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteCity indicates an expected call of DeleteCity.
+func (mr *MockCityRepoMockRecorder) DeleteCity(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCity", reflect.TypeOf((*MockCityRepo)(nil).DeleteCity), ctx, id)
+}
+
+// InsertCity mocks base method.
+func (m *MockCityRepo) InsertCity(ctx context.Context, city models.City) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertCity", ctx, city)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InsertCity indicates an expected call of InsertCity.
+func (mr *MockCityRepoMockRecorder) InsertCity(ctx, city interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertCity", reflect.TypeOf((*MockCityRepo)(nil).InsertCity), ctx, city)
+}
+
+// IsCityInUse mocks base method.
+func (m *MockCityRepo) IsCityInUse(ctx context.Context, name string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsCityInUse", ctx, name)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsCityInUse indicates an expected call of IsCityInUse.
+func (mr *MockCityRepoMockRecorder) IsCityInUse(ctx, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsCityInUse", reflect.TypeOf((*MockCityRepo)(nil).IsCityInUse), ctx, name)
+}
+
+// SelectCities mocks base method.
+func (m *MockCityRepo) SelectCities(ctx context.Context) ([]models.City, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectCities", ctx)
+	ret0, _ := ret[0].([]models.City)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectCities indicates an expected call of SelectCities.
+func (mr *MockCityRepoMockRecorder) SelectCities(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectCities", reflect.TypeOf((*MockCityRepo)(nil).SelectCities), ctx)
+}
+
+// SelectCityByID mocks base method.
+func (m *MockCityRepo) SelectCityByID(ctx context.Context, id uuid.UUID) (models.City, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectCityByID", ctx, id)
+	ret0, _ := ret[0].(models.City)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectCityByID indicates an expected call of SelectCityByID.
+func (mr *MockCityRepoMockRecorder) SelectCityByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectCityByID", reflect.TypeOf((*MockCityRepo)(nil).SelectCityByID), ctx, id)
+}
+
+// UpdateCity mocks base method.
+func (m *MockCityRepo) UpdateCity(ctx context.Context, city models.City) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCity", ctx, city)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateCity indicates an expected call of UpdateCity.
+func (mr *MockCityRepoMockRecorder) UpdateCity(ctx, city interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCity", reflect.TypeOf((*MockCityRepo)(nil).UpdateCity), ctx, city)
+}
+
+// MockCityUsecase is a mock of CityUsecase interface.
+type MockCityUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockCityUsecaseMockRecorder
+}
+
+// MockCityUsecaseMockRecorder is the mock recorder for MockCityUsecase.
+type MockCityUsecaseMockRecorder struct {
+	mock *MockCityUsecase
+}
+
+// NewMockCityUsecase creates a new mock instance.
+func NewMockCityUsecase(ctrl *gomock.Controller) *MockCityUsecase {
+	mock := &MockCityUsecase{ctrl: ctrl}
+	mock.recorder = &MockCityUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCityUsecase) EXPECT() *MockCityUsecaseMockRecorder {
+	return m.recorder
+}
+
+// CreateCity mocks base method.
+func (m *MockCityUsecase) CreateCity(ctx context.Context, req models.CityReq) (models.City, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCity", ctx, req)
+	ret0, _ := ret[0].(models.City)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCity indicates an expected call of CreateCity.
+func (mr *MockCityUsecaseMockRecorder) CreateCity(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCity", reflect.TypeOf((*MockCityUsecase)(nil).CreateCity), ctx, req)
+}
+
+// DeleteCity mocks base method.
+func (m *MockCityUsecase) DeleteCity(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCity", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteCity indicates an expected call of DeleteCity.
+func (mr *MockCityUsecaseMockRecorder) DeleteCity(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCity", reflect.TypeOf((*MockCityUsecase)(nil).DeleteCity), ctx, id)
+}
+
+// GetCities mocks base method.
+func (m *MockCityUsecase) GetCities(ctx context.Context) ([]models.City, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCities", ctx)
+	ret0, _ := ret[0].([]models.City)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCities indicates an expected call of GetCities.
+func (mr *MockCityUsecaseMockRecorder) GetCities(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCities", reflect.TypeOf((*MockCityUsecase)(nil).GetCities), ctx)
+}
+
+// RefreshCache mocks base method.
+func (m *MockCityUsecase) RefreshCache(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RefreshCache", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RefreshCache indicates an expected call of RefreshCache.
+func (mr *MockCityUsecaseMockRecorder) RefreshCache(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshCache", reflect.TypeOf((*MockCityUsecase)(nil).RefreshCache), ctx)
+}
+
+// UpdateCity mocks base method.
+func (m *MockCityUsecase) UpdateCity(ctx context.Context, id uuid.UUID, req models.CityReq) (models.City, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCity", ctx, id, req)
+	ret0, _ := ret[0].(models.City)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateCity indicates an expected call of UpdateCity.
+func (mr *MockCityUsecaseMockRecorder) UpdateCity(ctx, id, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCity", reflect.TypeOf((*MockCityUsecase)(nil).UpdateCity), ctx, id, req)
+}
@@ -0,0 +1,158 @@
+package repo
+
+import (
+	"context"
+	_ "embed"
+	"errors"
+	"log/slog"
+
+	"github.com/K1tten2005/avito_pvz/internal/models"
+	"github.com/K1tten2005/avito_pvz/internal/pkg/city"
+	"github.com/K1tten2005/avito_pvz/internal/pkg/utils/logger"
+	"github.com/K1tten2005/avito_pvz/internal/pkg/utils/pgerr"
+	"github.com/jackc/pgtype/pgxtype"
+	"github.com/jackc/pgx/v4"
+	"github.com/satori/uuid"
+)
+
+//go:embed sql/selectCities.sql
+var selectCities string
+
+//go:embed sql/selectCityById.sql
+var selectCityById string
+
+//go:embed sql/insertCity.sql
+var insertCity string
+
+//go:embed sql/updateCity.sql
+var updateCity string
+
+//go:embed sql/deleteCity.sql
+var deleteCity string
+
+//go:embed sql/isCityInUse.sql
+var isCityInUse string
+
+type CityRepo struct {
+	db pgxtype.Querier
+}
+
+func CreateCityRepo(db pgxtype.Querier) *CityRepo {
+	return &CityRepo{
+		db: db,
+	}
+}
+
+func (repo *CityRepo) SelectCities(ctx context.Context) ([]models.City, error) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	rows, err := repo.db.Query(ctx, selectCities)
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return nil, err
+	}
+	defer rows.Close()
+
+	result := []models.City{}
+	for rows.Next() {
+		var c models.City
+		if err := rows.Scan(&c.Id, &c.Name, &c.Aliases, &c.Enabled); err != nil {
+			loggerVar.Error(err.Error())
+			return nil, err
+		}
+		result = append(result, c)
+	}
+
+	if err := rows.Err(); err != nil {
+		loggerVar.Error(err.Error())
+		return nil, err
+	}
+
+	loggerVar.Info("Successful")
+	return result, nil
+}
+
+func (repo *CityRepo) SelectCityByID(ctx context.Context, id uuid.UUID) (models.City, error) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	var c models.City
+	err := repo.db.QueryRow(ctx, selectCityById, id).Scan(&c.Id, &c.Name, &c.Aliases, &c.Enabled)
+	if errors.Is(err, pgx.ErrNoRows) {
+		loggerVar.Error(city.ErrCityNotFound.Error())
+		return models.City{}, city.ErrCityNotFound
+	}
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return models.City{}, err
+	}
+
+	loggerVar.Info("Successful")
+	return c, nil
+}
+
+func (repo *CityRepo) InsertCity(ctx context.Context, c models.City) error {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	_, err := repo.db.Exec(ctx, insertCity, c.Id, c.Name, c.Aliases, c.Enabled)
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return mapUniqueViolation(err)
+	}
+
+	loggerVar.Info("Successful")
+	return nil
+}
+
+func (repo *CityRepo) UpdateCity(ctx context.Context, c models.City) error {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	cmd, err := repo.db.Exec(ctx, updateCity, c.Id, c.Name, c.Aliases, c.Enabled)
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return mapUniqueViolation(err)
+	}
+	if cmd.RowsAffected() == 0 {
+		loggerVar.Error(city.ErrCityNotFound.Error())
+		return city.ErrCityNotFound
+	}
+
+	loggerVar.Info("Successful")
+	return nil
+}
+
+func (repo *CityRepo) DeleteCity(ctx context.Context, id uuid.UUID) error {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	cmd, err := repo.db.Exec(ctx, deleteCity, id)
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return err
+	}
+	if cmd.RowsAffected() == 0 {
+		loggerVar.Error(city.ErrCityNotFound.Error())
+		return city.ErrCityNotFound
+	}
+
+	loggerVar.Info("Successful")
+	return nil
+}
+
+func (repo *CityRepo) IsCityInUse(ctx context.Context, name string) (bool, error) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	var inUse bool
+	if err := repo.db.QueryRow(ctx, isCityInUse, name).Scan(&inUse); err != nil {
+		loggerVar.Error(err.Error())
+		return false, err
+	}
+
+	loggerVar.Info("Successful")
+	return inUse, nil
+}
+
+func mapUniqueViolation(err error) error {
+	if pgerr.IsUniqueViolation(err) {
+		return city.ErrCityExists
+	}
+	return err
+}
@@ -0,0 +1 @@
+DELETE FROM city WHERE id = $1
\ No newline at end of file
@@ -0,0 +1 @@
+INSERT INTO city (id, name, aliases, enabled) VALUES ($1, $2, $3, $4)
\ No newline at end of file
@@ -0,0 +1 @@
+SELECT EXISTS(SELECT 1 FROM pvz WHERE city = $1)
\ No newline at end of file
@@ -0,0 +1 @@
+SELECT id, name, aliases, enabled FROM city ORDER BY name
\ No newline at end of file
@@ -0,0 +1 @@
+SELECT id, name, aliases, enabled FROM city WHERE id = $1
\ No newline at end of file
@@ -0,0 +1 @@
+UPDATE city SET name = $2, aliases = $3, enabled = $4 WHERE id = $1
\ No newline at end of file
@@ -0,0 +1,221 @@
+package usecase
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/K1tten2005/avito_pvz/internal/models"
+	"github.com/K1tten2005/avito_pvz/internal/pkg/city"
+	"github.com/K1tten2005/avito_pvz/internal/pkg/utils/logger"
+	"github.com/K1tten2005/avito_pvz/internal/pkg/utils/validation"
+	"github.com/satori/uuid"
+)
+
+type CityUsecase struct {
+	repo city.CityRepo
+}
+
+func CreateCityUsecase(repo city.CityRepo) *CityUsecase {
+	return &CityUsecase{repo: repo}
+}
+
+func (uc *CityUsecase) GetCities(ctx context.Context) ([]models.City, error) {
+	return uc.repo.SelectCities(ctx)
+}
+
+func (uc *CityUsecase) CreateCity(ctx context.Context, req models.CityReq) (models.City, error) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	if req.Name == nil || strings.TrimSpace(*req.Name) == "" {
+		loggerVar.Error(city.ErrInvalidCityName.Error())
+		return models.City{}, city.ErrInvalidCityName
+	}
+
+	newCity := models.City{
+		Id:      uuid.NewV4(),
+		Name:    strings.TrimSpace(*req.Name),
+		Aliases: cleanAliases(req.Aliases),
+		Enabled: true,
+	}
+	if req.Enabled != nil {
+		newCity.Enabled = *req.Enabled
+	}
+
+	if err := uc.checkNamesFree(ctx, newCity); err != nil {
+		loggerVar.Error(err.Error())
+		return models.City{}, err
+	}
+
+	if err := uc.repo.InsertCity(ctx, newCity); err != nil {
+		loggerVar.Error(err.Error())
+		return models.City{}, err
+	}
+
+	if err := uc.RefreshCache(ctx); err != nil {
+		loggerVar.Error(err.Error())
+	}
+
+	loggerVar.Info("Success")
+	return newCity, nil
+}
+
+func (uc *CityUsecase) UpdateCity(ctx context.Context, id uuid.UUID, req models.CityReq) (models.City, error) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	current, err := uc.repo.SelectCityByID(ctx, id)
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return models.City{}, err
+	}
+
+	updated := current
+	if req.Name != nil {
+		if strings.TrimSpace(*req.Name) == "" {
+			loggerVar.Error(city.ErrInvalidCityName.Error())
+			return models.City{}, city.ErrInvalidCityName
+		}
+		updated.Name = strings.TrimSpace(*req.Name)
+	}
+	if req.Aliases != nil {
+		updated.Aliases = cleanAliases(req.Aliases)
+	}
+	if req.Enabled != nil {
+		updated.Enabled = *req.Enabled
+	}
+
+	// ПВЗ хранят название города, поэтому переименовать используемый город нельзя
+	if updated.Name != current.Name {
+		inUse, err := uc.repo.IsCityInUse(ctx, current.Name)
+		if err != nil {
+			loggerVar.Error(err.Error())
+			return models.City{}, err
+		}
+		if inUse {
+			loggerVar.Error(city.ErrCityInUse.Error())
+			return models.City{}, city.ErrCityInUse
+		}
+	}
+
+	if err := uc.checkNamesFree(ctx, updated); err != nil {
+		loggerVar.Error(err.Error())
+		return models.City{}, err
+	}
+
+	if err := uc.repo.UpdateCity(ctx, updated); err != nil {
+		loggerVar.Error(err.Error())
+		return models.City{}, err
+	}
+
+	if err := uc.RefreshCache(ctx); err != nil {
+		loggerVar.Error(err.Error())
+	}
+
+	loggerVar.Info("Success")
+	return updated, nil
+}
+
+func (uc *CityUsecase) DeleteCity(ctx context.Context, id uuid.UUID) error {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	current, err := uc.repo.SelectCityByID(ctx, id)
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return err
+	}
+
+	inUse, err := uc.repo.IsCityInUse(ctx, current.Name)
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return err
+	}
+	if inUse {
+		loggerVar.Error(city.ErrCityInUse.Error())
+		return city.ErrCityInUse
+	}
+
+	if err := uc.repo.DeleteCity(ctx, id); err != nil {
+		loggerVar.Error(err.Error())
+		return err
+	}
+
+	if err := uc.RefreshCache(ctx); err != nil {
+		loggerVar.Error(err.Error())
+	}
+
+	loggerVar.Info("Success")
+	return nil
+}
+
+func (uc *CityUsecase) RefreshCache(ctx context.Context) error {
+	cities, err := uc.repo.SelectCities(ctx)
+	if err != nil {
+		return err
+	}
+	validation.SetCities(cities)
+	return nil
+}
+
+// RunCacheRefresher периодически перечитывает справочник, чтобы изменения,
+// сделанные через другие экземпляры сервиса, доходили до этого
+func (uc *CityUsecase) RunCacheRefresher(ctx context.Context, interval time.Duration) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := uc.RefreshCache(ctx); err != nil {
+				loggerVar.Error(err.Error())
+			}
+		}
+	}
+}
+
+// checkNamesFree проверяет, что название и синонимы города не заняты другими городами
+func (uc *CityUsecase) checkNamesFree(ctx context.Context, c models.City) error {
+	cities, err := uc.repo.SelectCities(ctx)
+	if err != nil {
+		return err
+	}
+
+	taken := make(map[string]struct{})
+	for _, other := range cities {
+		if other.Id == c.Id {
+			continue
+		}
+		taken[validation.CityKey(other.Name)] = struct{}{}
+		for _, alias := range other.Aliases {
+			taken[validation.CityKey(alias)] = struct{}{}
+		}
+	}
+
+	for _, name := range append([]string{c.Name}, c.Aliases...) {
+		if _, ok := taken[validation.CityKey(name)]; ok {
+			return city.ErrCityExists
+		}
+	}
+	return nil
+}
+
+func cleanAliases(aliases []string) []string {
+	result := make([]string, 0, len(aliases))
+	seen := make(map[string]struct{}, len(aliases))
+	for _, alias := range aliases {
+		alias = strings.TrimSpace(alias)
+		if alias == "" {
+			continue
+		}
+		if _, ok := seen[validation.CityKey(alias)]; ok {
+			continue
+		}
+		seen[validation.CityKey(alias)] = struct{}{}
+		result = append(result, alias)
+	}
+	return result
+}
@@ -0,0 +1,87 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/K1tten2005/avito_pvz/internal/models"
+	"github.com/K1tten2005/avito_pvz/internal/pkg/city"
+	"github.com/K1tten2005/avito_pvz/internal/pkg/city/mocks"
+	"github.com/K1tten2005/avito_pvz/internal/pkg/utils/validation"
+	"github.com/golang/mock/gomock"
+	"github.com/satori/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCityUsecase_CreateCity(t *testing.T) {
+	spb := models.City{Id: uuid.NewV4(), Name: "Санкт-Петербург", Aliases: []string{"Санкт Петербург"}, Enabled: true}
+	strPtr := func(s string) *string { return &s }
+
+	tests := []struct {
+		name         string
+		req          models.CityReq
+		mockBehavior func(repo *mocks.MockCityRepo)
+		expectedErr  error
+	}{
+		{
+			name:         "empty name",
+			req:          models.CityReq{Name: strPtr("  ")},
+			mockBehavior: func(repo *mocks.MockCityRepo) {},
+			expectedErr:  city.ErrInvalidCityName,
+		},
+		{
+			name: "alias taken by another city",
+			req:  models.CityReq{Name: strPtr("Питер"), Aliases: []string{"санкт  петербург"}},
+			mockBehavior: func(repo *mocks.MockCityRepo) {
+				repo.EXPECT().SelectCities(gomock.Any()).Return([]models.City{spb}, nil)
+			},
+			expectedErr: city.ErrCityExists,
+		},
+		{
+			name: "success",
+			req:  models.CityReq{Name: strPtr("Казань"), Aliases: []string{"Kazan", "Kazan", " "}},
+			mockBehavior: func(repo *mocks.MockCityRepo) {
+				repo.EXPECT().SelectCities(gomock.Any()).Return([]models.City{spb}, nil)
+				repo.EXPECT().InsertCity(gomock.Any(), gomock.Any()).Return(nil)
+				repo.EXPECT().SelectCities(gomock.Any()).Return([]models.City{
+					spb, {Name: "Казань", Aliases: []string{"Kazan"}, Enabled: true},
+				}, nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repo := mocks.NewMockCityRepo(ctrl)
+			tt.mockBehavior(repo)
+
+			created, err := CreateCityUsecase(repo).CreateCity(context.Background(), tt.req)
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, []string{"Kazan"}, created.Aliases)
+			assert.True(t, created.Enabled)
+			assert.True(t, validation.IsValidCity("kazan"))
+		})
+	}
+}
+
+func TestCityUsecase_DeleteCity_InUse(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	moscow := models.City{Id: uuid.NewV4(), Name: "Москва", Enabled: true}
+
+	repo := mocks.NewMockCityRepo(ctrl)
+	repo.EXPECT().SelectCityByID(gomock.Any(), moscow.Id).Return(moscow, nil)
+	repo.EXPECT().IsCityInUse(gomock.Any(), moscow.Name).Return(true, nil)
+
+	err := CreateCityUsecase(repo).DeleteCity(context.Background(), moscow.Id)
+	assert.ErrorIs(t, err, city.ErrCityInUse)
+}
@@ -0,0 +1,152 @@
+package broker
+
+import (
+	"context"
+	"log/slog"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/K1tten2005/avito_pvz/internal/pkg/utils/logger"
+	"github.com/jackc/pgx/v4"
+	"github.com/satori/uuid"
+)
+
+const (
+	// Channel — канал NOTIFY, в который пишет триггер outbox_notify
+	Channel = "pvz_events"
+
+	subscriberBuffer = 64
+	reconnectDelay   = time.Second
+)
+
+type subscriber struct {
+	ch     chan int64
+	closed bool
+}
+
+// PgBroker держит одно соединение с LISTEN на экземпляр API и раскладывает
+// уведомления по подписчикам нужного ПВЗ
+type PgBroker struct {
+	mu   sync.Mutex
+	subs map[uuid.UUID]map[*subscriber]struct{}
+}
+
+func CreatePgBroker() *PgBroker {
+	return &PgBroker{subs: map[uuid.UUID]map[*subscriber]struct{}{}}
+}
+
+func (b *PgBroker) Subscribe(pvzID uuid.UUID) (<-chan int64, func()) {
+	sub := &subscriber{ch: make(chan int64, subscriberBuffer)}
+
+	b.mu.Lock()
+	if b.subs[pvzID] == nil {
+		b.subs[pvzID] = map[*subscriber]struct{}{}
+	}
+	b.subs[pvzID][sub] = struct{}{}
+	b.mu.Unlock()
+
+	return sub.ch, func() {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		b.remove(pvzID, sub)
+	}
+}
+
+// remove вызывается под мьютексом
+func (b *PgBroker) remove(pvzID uuid.UUID, sub *subscriber) {
+	if !sub.closed {
+		sub.closed = true
+		close(sub.ch)
+	}
+	delete(b.subs[pvzID], sub)
+	if len(b.subs[pvzID]) == 0 {
+		delete(b.subs, pvzID)
+	}
+}
+
+// Dispatch отдаёт позицию события подписчикам ПВЗ. Медленный подписчик
+// отключается: он переподключится с Last-Event-ID и дочитает пропущенное из outbox.
+func (b *PgBroker) Dispatch(pvzID uuid.UUID, seq int64) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for sub := range b.subs[pvzID] {
+		select {
+		case sub.ch <- seq:
+		default:
+			b.remove(pvzID, sub)
+		}
+	}
+}
+
+// dropAll отключает всех подписчиков, когда уведомления могли потеряться
+func (b *PgBroker) dropAll() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for pvzID, subs := range b.subs {
+		for sub := range subs {
+			b.remove(pvzID, sub)
+		}
+	}
+}
+
+// Listen слушает канал, пока не отменён ctx, и переподключается при обрыве
+func (b *PgBroker) Listen(ctx context.Context, cfg *pgx.ConnConfig) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	for {
+		err := b.listen(ctx, cfg)
+		b.dropAll()
+		if ctx.Err() != nil {
+			return
+		}
+		loggerVar.Error(err.Error())
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(reconnectDelay):
+		}
+	}
+}
+
+func (b *PgBroker) listen(ctx context.Context, cfg *pgx.ConnConfig) error {
+	conn, err := pgx.ConnectConfig(ctx, cfg)
+	if err != nil {
+		return err
+	}
+	defer conn.Close(context.Background())
+
+	if _, err := conn.Exec(ctx, "LISTEN "+Channel); err != nil {
+		return err
+	}
+
+	for {
+		notification, err := conn.WaitForNotification(ctx)
+		if err != nil {
+			return err
+		}
+		if pvzID, seq, ok := parsePayload(notification.Payload); ok {
+			b.Dispatch(pvzID, seq)
+		}
+	}
+}
+
+func parsePayload(payload string) (uuid.UUID, int64, bool) {
+	rawID, rawSeq, ok := strings.Cut(payload, ":")
+	if !ok {
+		return uuid.UUID{}, 0, false
+	}
+	pvzID, err := uuid.FromString(rawID)
+	if err != nil {
+		return uuid.UUID{}, 0, false
+	}
+	seq, err := strconv.ParseInt(rawSeq, 10, 64)
+	if err != nil {
+		return uuid.UUID{}, 0, false
+	}
+	return pvzID, seq, true
+}
@@ -0,0 +1,59 @@
+package broker
+
+import (
+	"testing"
+
+	"github.com/satori/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPgBroker_Dispatch(t *testing.T) {
+	b := CreatePgBroker()
+	pvzID := uuid.NewV4()
+
+	ch, unsubscribe := b.Subscribe(pvzID)
+	other, unsubscribeOther := b.Subscribe(uuid.NewV4())
+	defer unsubscribeOther()
+
+	b.Dispatch(pvzID, 42)
+	assert.Equal(t, int64(42), <-ch)
+	assert.Empty(t, other)
+
+	unsubscribe()
+	_, ok := <-ch
+	assert.False(t, ok)
+	// повторная отписка безопасна
+	unsubscribe()
+}
+
+func TestPgBroker_DropsSlowSubscriber(t *testing.T) {
+	b := CreatePgBroker()
+	pvzID := uuid.NewV4()
+
+	ch, unsubscribe := b.Subscribe(pvzID)
+	defer unsubscribe()
+
+	for seq := int64(1); seq <= subscriberBuffer+1; seq++ {
+		b.Dispatch(pvzID, seq)
+	}
+
+	received := 0
+	for range ch {
+		received++
+	}
+	assert.Equal(t, subscriberBuffer, received)
+}
+
+func TestParsePayload(t *testing.T) {
+	pvzID := uuid.NewV4()
+
+	gotID, seq, ok := parsePayload(pvzID.String() + ":17")
+	assert.True(t, ok)
+	assert.Equal(t, pvzID, gotID)
+	assert.Equal(t, int64(17), seq)
+
+	for _, payload := range []string{"", "17", pvzID.String() + ":x", "bad:17"} {
+		_, _, ok := parsePayload(payload)
+		assert.False(t, ok, payload)
+	}
+}
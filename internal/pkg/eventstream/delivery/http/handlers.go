@@ -0,0 +1,103 @@
+package http
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/K1tten2005/avito_pvz/internal/models"
+	"github.com/K1tten2005/avito_pvz/internal/pkg/eventstream"
+	"github.com/K1tten2005/avito_pvz/internal/pkg/utils/logger"
+	"github.com/K1tten2005/avito_pvz/internal/pkg/utils/send_err"
+	"github.com/gorilla/mux"
+	"github.com/mailru/easyjson"
+	"github.com/satori/uuid"
+)
+
+// retryMs — через сколько браузерный EventSource переподключится после обрыва
+const retryMs = 3000
+
+type EventStreamHandler struct {
+	uc eventstream.StreamUsecase
+}
+
+func CreateEventStreamHandler(uc eventstream.StreamUsecase) *EventStreamHandler {
+	return &EventStreamHandler{uc: uc}
+}
+
+func (h *EventStreamHandler) StreamPvzEvents(w http.ResponseWriter, r *http.Request) {
+	loggerVar := logger.GetLoggerFromContext(r.Context()).With(slog.String("func", logger.GetFuncName()))
+
+	pvzID, err := uuid.FromString(mux.Vars(r)["pvzId"])
+	if err != nil {
+		logger.LogHandlerError(loggerVar, err, http.StatusBadRequest)
+		send_err.SendError(w, "wrong UUID format for pvzId path parameter", http.StatusBadRequest)
+		return
+	}
+
+	sse := &sseWriter{w: w, rc: http.NewResponseController(w)}
+	err = h.uc.Stream(r.Context(), pvzID, r.Header.Get("Last-Event-ID"), sse)
+	// после Open статус уже отправлен: клиент сам переподключится с Last-Event-ID
+	if err != nil && !sse.opened {
+		statusCode := errStatus(err)
+		logger.LogHandlerError(loggerVar, fmt.Errorf("error on a level below (usecase): %w", err), statusCode)
+		send_err.SendError(w, err.Error(), statusCode)
+		return
+	}
+	logger.LogHandlerInfo(loggerVar, "Stream closed", http.StatusOK)
+}
+
+type sseWriter struct {
+	w      http.ResponseWriter
+	rc     *http.ResponseController
+	opened bool
+}
+
+func (s *sseWriter) Open() error {
+	// поток живёт дольше WriteTimeout сервера
+	if err := s.rc.SetWriteDeadline(time.Time{}); err != nil && !errors.Is(err, http.ErrNotSupported) {
+		return err
+	}
+
+	s.w.Header().Set("Content-Type", "text/event-stream")
+	s.w.Header().Set("Cache-Control", "no-cache")
+	s.w.Header().Set("X-Accel-Buffering", "no")
+	s.w.WriteHeader(http.StatusOK)
+	s.opened = true
+
+	if _, err := fmt.Fprintf(s.w, "retry: %d\n\n", retryMs); err != nil {
+		return err
+	}
+	return s.rc.Flush()
+}
+
+func (s *sseWriter) WriteEvent(event models.Event) error {
+	data, err := easyjson.Marshal(event)
+	if err != nil {
+		return err
+	}
+	if _, err := fmt.Fprintf(s.w, "id: %d\nevent: %s\ndata: %s\n\n", event.Seq, event.Type, data); err != nil {
+		return err
+	}
+	return s.rc.Flush()
+}
+
+func (s *sseWriter) Ping() error {
+	if _, err := fmt.Fprint(s.w, ": ping\n\n"); err != nil {
+		return err
+	}
+	return s.rc.Flush()
+}
+
+func errStatus(err error) int {
+	switch {
+	case errors.Is(err, eventstream.ErrPvzNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, eventstream.ErrInvalidLastEventID):
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,41 @@
+package eventstream
+
+import (
+	"context"
+	"errors"
+
+	"github.com/K1tten2005/avito_pvz/internal/models"
+	"github.com/satori/uuid"
+)
+
+var (
+	ErrPvzNotFound        = errors.New("pvz not found")
+	ErrInvalidLastEventID = errors.New("invalid Last-Event-ID")
+	ErrSubscriptionClosed = errors.New("subscription closed, reconnect with Last-Event-ID")
+)
+
+type EventRepo interface {
+	PvzExists(ctx context.Context, pvzID uuid.UUID) (bool, error)
+	// LastSeq возвращает позицию последнего события ПВЗ, 0 — если событий нет
+	LastSeq(ctx context.Context, pvzID uuid.UUID) (int64, error)
+	SelectEventsAfter(ctx context.Context, pvzID uuid.UUID, afterSeq int64, types []string, limit int) ([]models.Event, error)
+	SelectEventsBySeq(ctx context.Context, pvzID uuid.UUID, seqs []int64, types []string) ([]models.Event, error)
+}
+
+// Broker раздаёт позиции новых событий подписчикам ПВЗ.
+// Канал закрывается, если подписчик не успевает читать или пропало соединение с БД.
+type Broker interface {
+	Subscribe(pvzID uuid.UUID) (<-chan int64, func())
+}
+
+// EventWriter — транспорт потока; Open вызывается один раз, когда поток готов к отправке
+type EventWriter interface {
+	Open() error
+	WriteEvent(event models.Event) error
+	Ping() error
+}
+
+type StreamUsecase interface {
+	// Stream блокируется, пока не отменён ctx или не отвалился транспорт
+	Stream(ctx context.Context, pvzID uuid.UUID, lastEventID string, w EventWriter) error
+}
@@ -0,0 +1,238 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/pkg/eventstream/interfaces.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	models "github.com/K1tten2005/avito_pvz/internal/models"
+	eventstream "github.com/K1tten2005/avito_pvz/internal/pkg/eventstream"
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/satori/uuid"
+)
+
+// MockEventRepo is a mock of EventRepo interface.
+type MockEventRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockEventRepoMockRecorder
+}
+
+// MockEventRepoMockRecorder is the mock recorder for MockEventRepo.
+type MockEventRepoMockRecorder struct {
+	mock *MockEventRepo
+}
+
+// NewMockEventRepo creates a new mock instance.
+func NewMockEventRepo(ctrl *gomock.Controller) *MockEventRepo {
+	mock := &MockEventRepo{ctrl: ctrl}
+	mock.recorder = &MockEventRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEventRepo) EXPECT() *MockEventRepoMockRecorder {
+	return m.recorder
+}
+
+// LastSeq mocks base method.
+func (m *MockEventRepo) LastSeq(ctx context.Context, pvzID uuid.UUID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LastSeq", ctx, pvzID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LastSeq indicates an expected call of LastSeq.
+func (mr *MockEventRepoMockRecorder) LastSeq(ctx, pvzID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LastSeq", reflect.TypeOf((*MockEventRepo)(nil).LastSeq), ctx, pvzID)
+}
+
+// PvzExists mocks base method.
+func (m *MockEventRepo) PvzExists(ctx context.Context, pvzID uuid.UUID) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PvzExists", ctx, pvzID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PvzExists indicates an expected call of PvzExists.
+func (mr *MockEventRepoMockRecorder) PvzExists(ctx, pvzID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PvzExists", reflect.TypeOf((*MockEventRepo)(nil).PvzExists), ctx, pvzID)
+}
+
+// SelectEventsAfter mocks base method.
+func (m *MockEventRepo) SelectEventsAfter(ctx context.Context, pvzID uuid.UUID, afterSeq int64, types []string, limit int) ([]models.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectEventsAfter", ctx, pvzID, afterSeq, types, limit)
+	ret0, _ := ret[0].([]models.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectEventsAfter indicates an expected call of SelectEventsAfter.
+func (mr *MockEventRepoMockRecorder) SelectEventsAfter(ctx, pvzID, afterSeq, types, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectEventsAfter", reflect.TypeOf((*MockEventRepo)(nil).SelectEventsAfter), ctx, pvzID, afterSeq, types, limit)
+}
+
+// SelectEventsBySeq mocks base method.
+func (m *MockEventRepo) SelectEventsBySeq(ctx context.Context, pvzID uuid.UUID, seqs []int64, types []string) ([]models.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectEventsBySeq", ctx, pvzID, seqs, types)
+	ret0, _ := ret[0].([]models.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectEventsBySeq indicates an expected call of SelectEventsBySeq.
+func (mr *MockEventRepoMockRecorder) SelectEventsBySeq(ctx, pvzID, seqs, types interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectEventsBySeq", reflect.TypeOf((*MockEventRepo)(nil).SelectEventsBySeq), ctx, pvzID, seqs, types)
+}
+
+// MockBroker is a mock of Broker interface.
+type MockBroker struct {
+	ctrl     *gomock.Controller
+	recorder *MockBrokerMockRecorder
+}
+
+// MockBrokerMockRecorder is the mock recorder for MockBroker.
+type MockBrokerMockRecorder struct {
+	mock *MockBroker
+}
+
+// NewMockBroker creates a new mock instance.
+func NewMockBroker(ctrl *gomock.Controller) *MockBroker {
+	mock := &MockBroker{ctrl: ctrl}
+	mock.recorder = &MockBrokerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBroker) EXPECT() *MockBrokerMockRecorder {
+	return m.recorder
+}
+
+// Subscribe mocks base method.
+func (m *MockBroker) Subscribe(pvzID uuid.UUID) (<-chan int64, func()) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Subscribe", pvzID)
+	ret0, _ := ret[0].(<-chan int64)
+	ret1, _ := ret[1].(func())
+	return ret0, ret1
+}
+
+// Subscribe indicates an expected call of Subscribe.
+func (mr *MockBrokerMockRecorder) Subscribe(pvzID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subscribe", reflect.TypeOf((*MockBroker)(nil).Subscribe), pvzID)
+}
+
+// MockEventWriter is a mock of EventWriter interface.
+type MockEventWriter struct {
+	ctrl     *gomock.Controller
+	recorder *MockEventWriterMockRecorder
+}
+
+// MockEventWriterMockRecorder is the mock recorder for MockEventWriter.
+type MockEventWriterMockRecorder struct {
+	mock *MockEventWriter
+}
+
+// NewMockEventWriter creates a new mock instance.
+func NewMockEventWriter(ctrl *gomock.Controller) *MockEventWriter {
+	mock := &MockEventWriter{ctrl: ctrl}
+	mock.recorder = &MockEventWriterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEventWriter) EXPECT() *MockEventWriterMockRecorder {
+	return m.recorder
+}
+
+// Open mocks base method.
+func (m *MockEventWriter) Open() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Open")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Open indicates an expected call of Open.
+func (mr *MockEventWriterMockRecorder) Open() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Open", reflect.TypeOf((*MockEventWriter)(nil).Open))
+}
+
+// Ping mocks base method.
+func (m *MockEventWriter) Ping() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Ping")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Ping indicates an expected call of Ping.
+func (mr *MockEventWriterMockRecorder) Ping() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Ping", reflect.TypeOf((*MockEventWriter)(nil).Ping))
+}
+
+// WriteEvent mocks base method.
+func (m *MockEventWriter) WriteEvent(event models.Event) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WriteEvent", event)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WriteEvent indicates an expected call of WriteEvent.
+func (mr *MockEventWriterMockRecorder) WriteEvent(event interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteEvent", reflect.TypeOf((*MockEventWriter)(nil).WriteEvent), event)
+}
+
+// MockStreamUsecase is a mock of StreamUsecase interface.
+type MockStreamUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockStreamUsecaseMockRecorder
+}
+
+// MockStreamUsecaseMockRecorder is the mock recorder for MockStreamUsecase.
+type MockStreamUsecaseMockRecorder struct {
+	mock *MockStreamUsecase
+}
+
+// NewMockStreamUsecase creates a new mock instance.
+func NewMockStreamUsecase(ctrl *gomock.Controller) *MockStreamUsecase {
+	mock := &MockStreamUsecase{ctrl: ctrl}
+	mock.recorder = &MockStreamUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStreamUsecase) EXPECT() *MockStreamUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Stream mocks base method.
+func (m *MockStreamUsecase) Stream(ctx context.Context, pvzID uuid.UUID, lastEventID string, w eventstream.EventWriter) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Stream", ctx, pvzID, lastEventID, w)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Stream indicates an expected call of Stream.
+func (mr *MockStreamUsecaseMockRecorder) Stream(ctx, pvzID, lastEventID, w interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stream", reflect.TypeOf((*MockStreamUsecase)(nil).Stream), ctx, pvzID, lastEventID, w)
+}
@@ -0,0 +1,117 @@
+package repo
+
+import (
+	"context"
+	_ "embed"
+	"log/slog"
+
+	"github.com/K1tten2005/avito_pvz/internal/models"
+	"github.com/K1tten2005/avito_pvz/internal/pkg/utils/logger"
+	"github.com/jackc/pgtype/pgxtype"
+	"github.com/jackc/pgx/v4"
+	"github.com/satori/uuid"
+)
+
+//go:embed sql/selectPvzExists.sql
+var selectPvzExists string
+
+//go:embed sql/selectLastSeq.sql
+var selectLastSeq string
+
+//go:embed sql/selectEventsAfter.sql
+var selectEventsAfter string
+
+//go:embed sql/selectEventsBySeq.sql
+var selectEventsBySeq string
+
+type EventRepo struct {
+	db pgxtype.Querier
+}
+
+func CreateEventRepo(db pgxtype.Querier) *EventRepo {
+	return &EventRepo{
+		db: db,
+	}
+}
+
+func (repo *EventRepo) PvzExists(ctx context.Context, pvzID uuid.UUID) (bool, error) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	var exists bool
+	if err := repo.db.QueryRow(ctx, selectPvzExists, pvzID).Scan(&exists); err != nil {
+		loggerVar.Error(err.Error())
+		return false, err
+	}
+
+	loggerVar.Info("Successful")
+	return exists, nil
+}
+
+func (repo *EventRepo) LastSeq(ctx context.Context, pvzID uuid.UUID) (int64, error) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	var seq int64
+	if err := repo.db.QueryRow(ctx, selectLastSeq, pvzID).Scan(&seq); err != nil {
+		loggerVar.Error(err.Error())
+		return 0, err
+	}
+
+	loggerVar.Info("Successful")
+	return seq, nil
+}
+
+func (repo *EventRepo) SelectEventsAfter(ctx context.Context, pvzID uuid.UUID, afterSeq int64, types []string, limit int) ([]models.Event, error) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	rows, err := repo.db.Query(ctx, selectEventsAfter, pvzID, afterSeq, types, limit)
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return nil, err
+	}
+
+	events, err := scanEvents(rows)
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return nil, err
+	}
+
+	loggerVar.Info("Successful")
+	return events, nil
+}
+
+func (repo *EventRepo) SelectEventsBySeq(ctx context.Context, pvzID uuid.UUID, seqs []int64, types []string) ([]models.Event, error) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	rows, err := repo.db.Query(ctx, selectEventsBySeq, pvzID, seqs, types)
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return nil, err
+	}
+
+	events, err := scanEvents(rows)
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return nil, err
+	}
+
+	loggerVar.Info("Successful")
+	return events, nil
+}
+
+func scanEvents(rows pgx.Rows) ([]models.Event, error) {
+	defer rows.Close()
+
+	result := []models.Event{}
+	for rows.Next() {
+		var (
+			event   models.Event
+			payload []byte
+		)
+		if err := rows.Scan(&event.Seq, &event.Id, &event.Type, &event.AggregateId, &payload, &event.CreatedAt); err != nil {
+			return nil, err
+		}
+		event.Payload = payload
+		result = append(result, event)
+	}
+	return result, rows.Err()
+}
@@ -0,0 +1,5 @@
+SELECT id, event_id, event_type, aggregate_id, payload, created_at
+FROM outbox
+WHERE aggregate_id = $1 AND id > $2 AND event_type = ANY($3)
+ORDER BY id
+LIMIT $4
@@ -0,0 +1,4 @@
+SELECT id, event_id, event_type, aggregate_id, payload, created_at
+FROM outbox
+WHERE aggregate_id = $1 AND id = ANY($2) AND event_type = ANY($3)
+ORDER BY array_position($2, id)
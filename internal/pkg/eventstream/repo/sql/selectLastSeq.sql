@@ -0,0 +1,3 @@
+SELECT COALESCE(MAX(id), 0)
+FROM outbox
+WHERE aggregate_id = $1
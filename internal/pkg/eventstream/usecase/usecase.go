@@ -0,0 +1,140 @@
+package usecase
+
+import (
+	"context"
+	"log/slog"
+	"strconv"
+	"time"
+
+	"github.com/K1tten2005/avito_pvz/internal/models"
+	"github.com/K1tten2005/avito_pvz/internal/pkg/eventstream"
+	"github.com/K1tten2005/avito_pvz/internal/pkg/utils/logger"
+	"github.com/satori/uuid"
+)
+
+const replayBatchSize = 100
+
+type StreamUsecase struct {
+	repo      eventstream.EventRepo
+	broker    eventstream.Broker
+	heartbeat time.Duration
+}
+
+func CreateStreamUsecase(repo eventstream.EventRepo, broker eventstream.Broker, heartbeat time.Duration) *StreamUsecase {
+	return &StreamUsecase{repo: repo, broker: broker, heartbeat: heartbeat}
+}
+
+// Stream сначала дочитывает из outbox всё после Last-Event-ID, затем отдаёт новые события.
+// Подписка оформляется до чтения outbox, поэтому событие на стыке придёт ровно один раз.
+func (uc *StreamUsecase) Stream(ctx context.Context, pvzID uuid.UUID, lastEventID string, w eventstream.EventWriter) error {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	exists, err := uc.repo.PvzExists(ctx, pvzID)
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return err
+	}
+	if !exists {
+		loggerVar.Error(eventstream.ErrPvzNotFound.Error())
+		return eventstream.ErrPvzNotFound
+	}
+
+	var after int64
+	if lastEventID != "" {
+		after, err = strconv.ParseInt(lastEventID, 10, 64)
+		if err != nil || after < 0 {
+			loggerVar.Error(eventstream.ErrInvalidLastEventID.Error())
+			return eventstream.ErrInvalidLastEventID
+		}
+	}
+
+	notifications, unsubscribe := uc.broker.Subscribe(pvzID)
+	defer unsubscribe()
+
+	if lastEventID == "" {
+		if after, err = uc.repo.LastSeq(ctx, pvzID); err != nil {
+			loggerVar.Error(err.Error())
+			return err
+		}
+	}
+
+	if err := w.Open(); err != nil {
+		loggerVar.Error(err.Error())
+		return err
+	}
+
+	for {
+		events, err := uc.repo.SelectEventsAfter(ctx, pvzID, after, models.StreamEventTypes, replayBatchSize)
+		if err != nil {
+			loggerVar.Error(err.Error())
+			return err
+		}
+		for _, event := range events {
+			if err := w.WriteEvent(event); err != nil {
+				return err
+			}
+			after = event.Seq
+		}
+		if len(events) < replayBatchSize {
+			break
+		}
+	}
+
+	ticker := time.NewTicker(uc.heartbeat)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			if err := w.Ping(); err != nil {
+				return err
+			}
+		case seq, ok := <-notifications:
+			if !ok {
+				loggerVar.Error(eventstream.ErrSubscriptionClosed.Error())
+				return eventstream.ErrSubscriptionClosed
+			}
+
+			// уведомления приходят в порядке коммитов, а всё до after уже отдано при дочитывании
+			seqs := drain(seq, notifications)
+			fresh := seqs[:0]
+			for _, seq := range seqs {
+				if seq > after {
+					fresh = append(fresh, seq)
+				}
+			}
+			if len(fresh) == 0 {
+				continue
+			}
+
+			events, err := uc.repo.SelectEventsBySeq(ctx, pvzID, fresh, models.StreamEventTypes)
+			if err != nil {
+				loggerVar.Error(err.Error())
+				return err
+			}
+			for _, event := range events {
+				if err := w.WriteEvent(event); err != nil {
+					return err
+				}
+			}
+		}
+	}
+}
+
+// drain забирает накопившиеся уведомления, чтобы прочитать их из outbox одним запросом
+func drain(first int64, notifications <-chan int64) []int64 {
+	seqs := []int64{first}
+	for {
+		select {
+		case seq, ok := <-notifications:
+			if !ok {
+				return seqs
+			}
+			seqs = append(seqs, seq)
+		default:
+			return seqs
+		}
+	}
+}
@@ -0,0 +1,131 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/K1tten2005/avito_pvz/internal/models"
+	"github.com/K1tten2005/avito_pvz/internal/pkg/eventstream"
+	"github.com/K1tten2005/avito_pvz/internal/pkg/eventstream/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/satori/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type recordingWriter struct {
+	opened bool
+	seqs   []int64
+	// stopAfter отменяет поток, когда получено столько событий
+	stopAfter int
+	cancel    context.CancelFunc
+}
+
+func (w *recordingWriter) Open() error {
+	w.opened = true
+	return nil
+}
+
+func (w *recordingWriter) WriteEvent(event models.Event) error {
+	w.seqs = append(w.seqs, event.Seq)
+	if len(w.seqs) == w.stopAfter {
+		w.cancel()
+	}
+	return nil
+}
+
+func (w *recordingWriter) Ping() error {
+	return nil
+}
+
+func TestStreamUsecase_Stream_ReplaysThenFollows(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	pvzID := uuid.NewV4()
+	notifications := make(chan int64, 4)
+	// 11 уже отдано при дочитывании, 12 — новое
+	notifications <- 11
+	notifications <- 12
+
+	repo := mocks.NewMockEventRepo(ctrl)
+	broker := mocks.NewMockBroker(ctrl)
+	repo.EXPECT().PvzExists(gomock.Any(), pvzID).Return(true, nil)
+	broker.EXPECT().Subscribe(pvzID).Return(notifications, func() {})
+	repo.EXPECT().SelectEventsAfter(gomock.Any(), pvzID, int64(7), models.StreamEventTypes, replayBatchSize).
+		Return([]models.Event{{Seq: 9}, {Seq: 11}}, nil)
+	repo.EXPECT().SelectEventsBySeq(gomock.Any(), pvzID, []int64{12}, models.StreamEventTypes).
+		Return([]models.Event{{Seq: 12}}, nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	w := &recordingWriter{stopAfter: 3, cancel: cancel}
+
+	err := CreateStreamUsecase(repo, broker, time.Minute).Stream(ctx, pvzID, "7", w)
+	require.NoError(t, err)
+	assert.True(t, w.opened)
+	assert.Equal(t, []int64{9, 11, 12}, w.seqs)
+}
+
+func TestStreamUsecase_Stream_StartsFromLastSeq(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	pvzID := uuid.NewV4()
+	notifications := make(chan int64)
+	close(notifications)
+
+	repo := mocks.NewMockEventRepo(ctrl)
+	broker := mocks.NewMockBroker(ctrl)
+	repo.EXPECT().PvzExists(gomock.Any(), pvzID).Return(true, nil)
+	broker.EXPECT().Subscribe(pvzID).Return(notifications, func() {})
+	repo.EXPECT().LastSeq(gomock.Any(), pvzID).Return(int64(40), nil)
+	repo.EXPECT().SelectEventsAfter(gomock.Any(), pvzID, int64(40), models.StreamEventTypes, replayBatchSize).
+		Return([]models.Event{}, nil)
+
+	err := CreateStreamUsecase(repo, broker, time.Minute).Stream(context.Background(), pvzID, "", &recordingWriter{})
+	assert.ErrorIs(t, err, eventstream.ErrSubscriptionClosed)
+}
+
+func TestStreamUsecase_Stream_Errors(t *testing.T) {
+	pvzID := uuid.NewV4()
+
+	tests := []struct {
+		name         string
+		lastEventID  string
+		mockBehavior func(repo *mocks.MockEventRepo)
+		expectedErr  error
+	}{
+		{
+			name: "pvz not found",
+			mockBehavior: func(repo *mocks.MockEventRepo) {
+				repo.EXPECT().PvzExists(gomock.Any(), pvzID).Return(false, nil)
+			},
+			expectedErr: eventstream.ErrPvzNotFound,
+		},
+		{
+			name:        "invalid Last-Event-ID",
+			lastEventID: "abc",
+			mockBehavior: func(repo *mocks.MockEventRepo) {
+				repo.EXPECT().PvzExists(gomock.Any(), pvzID).Return(true, nil)
+			},
+			expectedErr: eventstream.ErrInvalidLastEventID,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repo := mocks.NewMockEventRepo(ctrl)
+			tt.mockBehavior(repo)
+
+			w := &recordingWriter{}
+			err := CreateStreamUsecase(repo, mocks.NewMockBroker(ctrl), time.Minute).Stream(context.Background(), pvzID, tt.lastEventID, w)
+			assert.ErrorIs(t, err, tt.expectedErr)
+			assert.False(t, w.opened)
+		})
+	}
+}
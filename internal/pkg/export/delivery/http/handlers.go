@@ -0,0 +1,192 @@
+package http
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/K1tten2005/avito_pvz/internal/models"
+	"github.com/K1tten2005/avito_pvz/internal/pkg/export"
+	"github.com/K1tten2005/avito_pvz/internal/pkg/utils/logger"
+	"github.com/K1tten2005/avito_pvz/internal/pkg/utils/send_err"
+	"github.com/K1tten2005/avito_pvz/internal/pkg/utils/xlsx"
+	"github.com/satori/uuid"
+)
+
+const (
+	csvContentType  = "text/csv; charset=utf-8"
+	xlsxContentType = "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+
+	// большая выгрузка не укладывается в общий WriteTimeout сервера
+	exportWriteTimeout = 5 * time.Minute
+)
+
+var exportHeader = []string{
+	"pvz_id", "city",
+	"reception_id", "reception_time", "reception_status", "reception_closed_at",
+	"product_id", "product_time", "product_type", "barcode", "sku", "order_number", "weight_grams",
+}
+
+type ExportHandler struct {
+	uc export.ExportUsecase
+}
+
+func CreateExportHandler(uc export.ExportUsecase) *ExportHandler {
+	return &ExportHandler{uc: uc}
+}
+
+// ExportCSV отдаёт выгрузку одним CSV; он же обслуживает GET /pvz с Accept: text/csv
+func (h *ExportHandler) ExportCSV(w http.ResponseWriter, r *http.Request) {
+	loggerVar := logger.GetLoggerFromContext(r.Context()).With(slog.String("func", logger.GetFuncName()))
+
+	filter, ok := parseFilter(w, r)
+	if !ok {
+		return
+	}
+
+	// заголовки уходят вместе с первой строкой, чтобы ошибку валидации можно было вернуть статусом
+	csvWriter := csv.NewWriter(w)
+	started := false
+	start := func() error {
+		started = true
+		w.Header().Set("Content-Type", csvContentType)
+		w.Header().Set("Content-Disposition", `attachment; filename="receptions.csv"`)
+		return csvWriter.Write(exportHeader)
+	}
+
+	err := h.uc.ExportRows(r.Context(), filter, func(row models.ExportRow) error {
+		if !started {
+			if err := start(); err != nil {
+				return err
+			}
+		}
+		return csvWriter.Write(rowCells(row))
+	})
+	if err == nil && !started {
+		err = start()
+	}
+	if err != nil {
+		h.fail(w, loggerVar, err, started)
+		return
+	}
+
+	csvWriter.Flush()
+	if err := csvWriter.Error(); err != nil {
+		logger.LogHandlerError(loggerVar, fmt.Errorf("error while writing CSV: %w", err), http.StatusInternalServerError)
+		return
+	}
+	logger.LogHandlerInfo(loggerVar, "Successful", http.StatusOK)
+}
+
+// ExportXLSX отдаёт книгу Excel с отдельным листом на каждый ПВЗ
+func (h *ExportHandler) ExportXLSX(w http.ResponseWriter, r *http.Request) {
+	loggerVar := logger.GetLoggerFromContext(r.Context()).With(slog.String("func", logger.GetFuncName()))
+
+	filter, ok := parseFilter(w, r)
+	if !ok {
+		return
+	}
+
+	book := xlsx.CreateWriter(w)
+	started := false
+	var currentPvz uuid.UUID
+
+	err := h.uc.ExportRows(r.Context(), filter, func(row models.ExportRow) error {
+		if !started {
+			started = true
+			w.Header().Set("Content-Type", xlsxContentType)
+			w.Header().Set("Content-Disposition", `attachment; filename="receptions.xlsx"`)
+		}
+		if row.PvzId != currentPvz {
+			currentPvz = row.PvzId
+			if err := book.AddSheet(fmt.Sprintf("%s %.8s", row.City, row.PvzId.String())); err != nil {
+				return err
+			}
+			if err := book.WriteRow(exportHeader); err != nil {
+				return err
+			}
+		}
+		return book.WriteRow(rowCells(row))
+	})
+	if err != nil {
+		h.fail(w, loggerVar, err, started)
+		return
+	}
+
+	if !started {
+		w.Header().Set("Content-Type", xlsxContentType)
+		w.Header().Set("Content-Disposition", `attachment; filename="receptions.xlsx"`)
+	}
+	if err := book.Close(); err != nil {
+		logger.LogHandlerError(loggerVar, fmt.Errorf("error while writing XLSX: %w", err), http.StatusInternalServerError)
+		return
+	}
+	logger.LogHandlerInfo(loggerVar, "Successful", http.StatusOK)
+}
+
+// fail отвечает ошибкой, если тело ещё не начато; иначе остаётся только оборвать ответ
+func (h *ExportHandler) fail(w http.ResponseWriter, loggerVar *slog.Logger, err error, started bool) {
+	statusCode := errStatus(err)
+	logger.LogHandlerError(loggerVar, fmt.Errorf("error on a level below (usecase): %w", err), statusCode)
+	if !started {
+		send_err.SendError(w, err.Error(), statusCode)
+	}
+}
+
+func parseFilter(w http.ResponseWriter, r *http.Request) (models.ExportFilter, bool) {
+	filter := models.ExportFilter{City: r.URL.Query().Get("city")}
+	http.NewResponseController(w).SetWriteDeadline(time.Now().Add(exportWriteTimeout))
+
+	if startDateStr := r.URL.Query().Get("startDate"); startDateStr != "" {
+		t, err := time.Parse(time.RFC3339, startDateStr)
+		if err != nil {
+			send_err.SendError(w, "wrong startDate format", http.StatusBadRequest)
+			return models.ExportFilter{}, false
+		}
+		filter.StartDate = &t
+	}
+	if endDateStr := r.URL.Query().Get("endDate"); endDateStr != "" {
+		t, err := time.Parse(time.RFC3339, endDateStr)
+		if err != nil {
+			send_err.SendError(w, "wrong endDate format", http.StatusBadRequest)
+			return models.ExportFilter{}, false
+		}
+		filter.EndDate = &t
+	}
+	return filter, true
+}
+
+func rowCells(row models.ExportRow) []string {
+	cells := []string{
+		row.PvzId.String(), row.City,
+		row.ReceptionId.String(), row.ReceptionTime.UTC().Format(time.RFC3339), row.ReceptionStatus, formatTime(row.ReceptionClosedAt),
+		"", formatTime(row.ProductTime), row.ProductType, row.Barcode, row.Sku, row.OrderNumber, "",
+	}
+	if row.ProductId != nil {
+		cells[6] = row.ProductId.String()
+	}
+	if row.WeightGrams != nil {
+		cells[12] = strconv.Itoa(*row.WeightGrams)
+	}
+	return cells
+}
+
+func formatTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
+
+func errStatus(err error) int {
+	switch {
+	case errors.Is(err, export.ErrInvalidRange), errors.Is(err, export.ErrInvalidCity):
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,141 @@
+package http
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/csv"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/K1tten2005/avito_pvz/internal/models"
+	"github.com/K1tten2005/avito_pvz/internal/pkg/export"
+	"github.com/K1tten2005/avito_pvz/internal/pkg/export/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/satori/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func streamRows(rows ...models.ExportRow) func(ctx context.Context, filter models.ExportFilter, fn func(models.ExportRow) error) error {
+	return func(ctx context.Context, filter models.ExportFilter, fn func(models.ExportRow) error) error {
+		for _, row := range rows {
+			if err := fn(row); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+func TestExportCSV(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	productID := uuid.NewV4()
+	receptionTime := time.Date(2025, 3, 1, 9, 0, 0, 0, time.UTC)
+	rows := []models.ExportRow{
+		{PvzId: uuid.NewV4(), City: "Москва", ReceptionId: uuid.NewV4(), ReceptionTime: receptionTime, ReceptionStatus: models.StatusInProgress},
+		{PvzId: uuid.NewV4(), City: "Казань", ReceptionId: uuid.NewV4(), ReceptionTime: receptionTime, ReceptionStatus: models.StatusClose,
+			ProductId: &productID, ProductType: "обувь", Sku: "a,b"},
+	}
+
+	mockUsecase := mocks.NewMockExportUsecase(ctrl)
+	mockUsecase.EXPECT().ExportRows(gomock.Any(), models.ExportFilter{City: "Москва"}, gomock.Any()).DoAndReturn(streamRows(rows...))
+
+	req := httptest.NewRequest(http.MethodGet, "/exports/receptions.csv?city=Москва", nil)
+	w := httptest.NewRecorder()
+	CreateExportHandler(mockUsecase).ExportCSV(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, csvContentType, w.Header().Get("Content-Type"))
+
+	records, err := csv.NewReader(w.Body).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, records, 3)
+	assert.Equal(t, exportHeader, records[0])
+	assert.Equal(t, "2025-03-01T09:00:00Z", records[1][3])
+	assert.Empty(t, records[1][6])
+	assert.Equal(t, productID.String(), records[2][6])
+	assert.Equal(t, "a,b", records[2][10])
+}
+
+func TestExportCSV_Errors(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUsecase := mocks.NewMockExportUsecase(ctrl)
+
+	tests := []struct {
+		name           string
+		query          string
+		mockBehavior   func()
+		expectedStatus int
+	}{
+		{
+			name:           "wrong date format",
+			query:          "?startDate=yesterday",
+			mockBehavior:   func() {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:  "unknown city",
+			query: "?city=Тверь",
+			mockBehavior: func() {
+				mockUsecase.EXPECT().ExportRows(gomock.Any(), gomock.Any(), gomock.Any()).Return(export.ErrInvalidCity)
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockBehavior()
+
+			req := httptest.NewRequest(http.MethodGet, "/exports/receptions.csv"+tt.query, nil)
+			w := httptest.NewRecorder()
+			CreateExportHandler(mockUsecase).ExportCSV(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			assert.NotEqual(t, csvContentType, w.Header().Get("Content-Type"))
+		})
+	}
+}
+
+func TestExportXLSX_SheetPerPvz(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	first, second := uuid.NewV4(), uuid.NewV4()
+	rows := []models.ExportRow{
+		{PvzId: first, City: "Москва", ReceptionId: uuid.NewV4()},
+		{PvzId: first, City: "Москва", ReceptionId: uuid.NewV4()},
+		{PvzId: second, City: "Казань", ReceptionId: uuid.NewV4()},
+	}
+
+	mockUsecase := mocks.NewMockExportUsecase(ctrl)
+	mockUsecase.EXPECT().ExportRows(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(streamRows(rows...))
+
+	req := httptest.NewRequest(http.MethodGet, "/exports/receptions.xlsx", nil)
+	w := httptest.NewRecorder()
+	CreateExportHandler(mockUsecase).ExportXLSX(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, xlsxContentType, w.Header().Get("Content-Type"))
+
+	book, err := zip.NewReader(bytes.NewReader(w.Body.Bytes()), int64(w.Body.Len()))
+	require.NoError(t, err)
+
+	f, err := book.Open("xl/workbook.xml")
+	require.NoError(t, err)
+	workbook, err := io.ReadAll(f)
+	require.NoError(t, err)
+	assert.Contains(t, string(workbook), "Москва "+first.String()[:8])
+	assert.Contains(t, string(workbook), "Казань "+second.String()[:8])
+
+	_, err = book.Open("xl/worksheets/sheet3.xml")
+	assert.Error(t, err)
+}
@@ -0,0 +1,23 @@
+package export
+
+import (
+	"context"
+	"errors"
+
+	"github.com/K1tten2005/avito_pvz/internal/models"
+)
+
+var (
+	ErrInvalidRange = errors.New("startDate is after endDate")
+	ErrInvalidCity  = errors.New("invalid city")
+)
+
+type ExportRepo interface {
+	// StreamRows вызывает fn для каждой строки по мере чтения из БД, не накапливая выгрузку в памяти.
+	// Строки одного ПВЗ идут подряд.
+	StreamRows(ctx context.Context, filter models.ExportFilter, fn func(row models.ExportRow) error) error
+}
+
+type ExportUsecase interface {
+	ExportRows(ctx context.Context, filter models.ExportFilter, fn func(row models.ExportRow) error) error
+}
@@ -0,0 +1,87 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/pkg/export/interfaces.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	models "github.com/K1tten2005/avito_pvz/internal/models"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockExportRepo is a mock of ExportRepo interface.
+type MockExportRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockExportRepoMockRecorder
+}
+
+// MockExportRepoMockRecorder is the mock recorder for MockExportRepo.
+type MockExportRepoMockRecorder struct {
+	mock *MockExportRepo
+}
+
+// NewMockExportRepo creates a new mock instance.
+func NewMockExportRepo(ctrl *gomock.Controller) *MockExportRepo {
+	mock := &MockExportRepo{ctrl: ctrl}
+	mock.recorder = &MockExportRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockExportRepo) EXPECT() *MockExportRepoMockRecorder {
+	return m.recorder
+}
+
+// StreamRows mocks base method.
+func (m *MockExportRepo) StreamRows(ctx context.Context, filter models.ExportFilter, fn func(models.ExportRow) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StreamRows", ctx, filter, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StreamRows indicates an expected call of StreamRows.
+func (mr *MockExportRepoMockRecorder) StreamRows(ctx, filter, fn interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamRows", reflect.TypeOf((*MockExportRepo)(nil).StreamRows), ctx, filter, fn)
+}
+
+// MockExportUsecase is a mock of ExportUsecase interface.
+type MockExportUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockExportUsecaseMockRecorder
+}
+
+// MockExportUsecaseMockRecorder is the mock recorder for MockExportUsecase.
+type MockExportUsecaseMockRecorder struct {
+	mock *MockExportUsecase
+}
+
+// NewMockExportUsecase creates a new mock instance.
+func NewMockExportUsecase(ctrl *gomock.Controller) *MockExportUsecase {
+	mock := &MockExportUsecase{ctrl: ctrl}
+	mock.recorder = &MockExportUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockExportUsecase) EXPECT() *MockExportUsecaseMockRecorder {
+	return m.recorder
+}
+
+// ExportRows mocks base method.
+func (m *MockExportUsecase) ExportRows(ctx context.Context, filter models.ExportFilter, fn func(models.ExportRow) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportRows", ctx, filter, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExportRows indicates an expected call of ExportRows.
+func (mr *MockExportUsecaseMockRecorder) ExportRows(ctx, filter, fn interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportRows", reflect.TypeOf((*MockExportUsecase)(nil).ExportRows), ctx, filter, fn)
+}
@@ -0,0 +1,93 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	_ "embed"
+	"log/slog"
+
+	"github.com/K1tten2005/avito_pvz/internal/models"
+	"github.com/K1tten2005/avito_pvz/internal/pkg/utils/logger"
+	"github.com/jackc/pgtype/pgxtype"
+	"github.com/satori/uuid"
+)
+
+//go:embed sql/selectExportRows.sql
+var selectExportRows string
+
+type ExportRepo struct {
+	db pgxtype.Querier
+}
+
+func CreateExportRepo(db pgxtype.Querier) *ExportRepo {
+	return &ExportRepo{
+		db: db,
+	}
+}
+
+func (repo *ExportRepo) StreamRows(ctx context.Context, filter models.ExportFilter, fn func(row models.ExportRow) error) error {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	rows, err := repo.db.Query(ctx, selectExportRows, filter.StartDate, filter.EndDate, filter.City)
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return err
+	}
+	defer rows.Close()
+
+	count := 0
+	for rows.Next() {
+		var (
+			row         models.ExportRow
+			closedAt    sql.NullTime
+			productID   uuid.NullUUID
+			productTime sql.NullTime
+			productType sql.NullString
+			barcode     sql.NullString
+			sku         sql.NullString
+			orderNumber sql.NullString
+			weight      sql.NullInt32
+		)
+		err := rows.Scan(
+			&row.PvzId, &row.City,
+			&row.ReceptionId, &row.ReceptionTime, &row.ReceptionStatus, &closedAt,
+			&productID, &productTime, &productType,
+			&barcode, &sku, &orderNumber, &weight,
+		)
+		if err != nil {
+			loggerVar.Error(err.Error())
+			return err
+		}
+
+		if closedAt.Valid {
+			row.ReceptionClosedAt = &closedAt.Time
+		}
+		if productID.Valid {
+			row.ProductId = &productID.UUID
+		}
+		if productTime.Valid {
+			row.ProductTime = &productTime.Time
+		}
+		if weight.Valid {
+			grams := int(weight.Int32)
+			row.WeightGrams = &grams
+		}
+		row.ProductType = productType.String
+		row.Barcode = barcode.String
+		row.Sku = sku.String
+		row.OrderNumber = orderNumber.String
+
+		if err := fn(row); err != nil {
+			return err
+		}
+		count++
+	}
+
+	if err := rows.Err(); err != nil {
+		loggerVar.Error(err.Error())
+		return err
+	}
+
+	loggerVar.Info("Successful", slog.Int("rows", count))
+	return nil
+}
@@ -0,0 +1,13 @@
+SELECT
+    pvz.id, pvz.city,
+    reception.id, reception.reception_time, reception.status, reception.closed_at,
+    product.id, product.reception_time, product.category,
+    product.barcode, product.sku, product.order_number, product.weight_grams
+FROM reception
+JOIN pvz ON pvz.id = reception.pvz_id
+LEFT JOIN product
+    ON product.reception_id = reception.id AND product.deleted_at IS NULL
+WHERE ($1::timestamptz IS NULL OR reception.reception_time >= $1)
+    AND ($2::timestamptz IS NULL OR reception.reception_time <= $2)
+    AND ($3::text = '' OR pvz.city = $3)
+ORDER BY pvz.city, pvz.id, reception.reception_time, reception.id, product.reception_time, product.id
@@ -0,0 +1,45 @@
+package usecase
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/K1tten2005/avito_pvz/internal/models"
+	"github.com/K1tten2005/avito_pvz/internal/pkg/export"
+	"github.com/K1tten2005/avito_pvz/internal/pkg/utils/logger"
+	"github.com/K1tten2005/avito_pvz/internal/pkg/utils/validation"
+)
+
+type ExportUsecase struct {
+	repo export.ExportRepo
+}
+
+func CreateExportUsecase(repo export.ExportRepo) *ExportUsecase {
+	return &ExportUsecase{repo: repo}
+}
+
+func (uc *ExportUsecase) ExportRows(ctx context.Context, filter models.ExportFilter, fn func(row models.ExportRow) error) error {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	if filter.StartDate != nil && filter.EndDate != nil && filter.StartDate.After(*filter.EndDate) {
+		loggerVar.Error(export.ErrInvalidRange.Error())
+		return export.ErrInvalidRange
+	}
+
+	if filter.City != "" {
+		city, ok := validation.NormalizeCity(filter.City)
+		if !ok {
+			loggerVar.Error(export.ErrInvalidCity.Error())
+			return export.ErrInvalidCity
+		}
+		filter.City = city
+	}
+
+	if err := uc.repo.StreamRows(ctx, filter, fn); err != nil {
+		loggerVar.Error(err.Error())
+		return err
+	}
+
+	loggerVar.Info("Success")
+	return nil
+}
@@ -0,0 +1,22 @@
+package idempotency
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/K1tten2005/avito_pvz/internal/models"
+)
+
+var (
+	ErrKeyNotFound = errors.New("idempotency key not found")
+)
+
+type IdempotencyRepo interface {
+	// Reserve занимает ключ за первым запросом; false — ключ уже занят и не истёк
+	Reserve(ctx context.Context, scope, key string, requestHash []byte, expiresAt time.Time) (bool, error)
+	Get(ctx context.Context, scope, key string) (models.IdempotencyRecord, error)
+	Save(ctx context.Context, scope, key string, record models.IdempotencyRecord) error
+	Delete(ctx context.Context, scope, key string) error
+	DeleteExpired(ctx context.Context) (int64, error)
+}
@@ -0,0 +1,122 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	_ "embed"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/K1tten2005/avito_pvz/internal/models"
+	"github.com/K1tten2005/avito_pvz/internal/pkg/idempotency"
+	"github.com/K1tten2005/avito_pvz/internal/pkg/utils/logger"
+	"github.com/jackc/pgtype/pgxtype"
+	"github.com/jackc/pgx/v4"
+)
+
+//go:embed sql/reserveKey.sql
+var reserveKey string
+
+//go:embed sql/selectKey.sql
+var selectKey string
+
+//go:embed sql/saveResponse.sql
+var saveResponse string
+
+//go:embed sql/deleteKey.sql
+var deleteKey string
+
+//go:embed sql/deleteExpiredKeys.sql
+var deleteExpiredKeys string
+
+type IdempotencyRepo struct {
+	db pgxtype.Querier
+}
+
+func CreateIdempotencyRepo(db pgxtype.Querier) *IdempotencyRepo {
+	return &IdempotencyRepo{
+		db: db,
+	}
+}
+
+func (repo *IdempotencyRepo) Reserve(ctx context.Context, scope, key string, requestHash []byte, expiresAt time.Time) (bool, error) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	var reserved string
+	err := repo.db.QueryRow(ctx, reserveKey, scope, key, requestHash, expiresAt).Scan(&reserved)
+	if errors.Is(err, pgx.ErrNoRows) {
+		loggerVar.Info("Key is already taken")
+		return false, nil
+	}
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return false, err
+	}
+
+	loggerVar.Info("Successful")
+	return true, nil
+}
+
+func (repo *IdempotencyRepo) Get(ctx context.Context, scope, key string) (models.IdempotencyRecord, error) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	var (
+		record      models.IdempotencyRecord
+		statusCode  sql.NullInt32
+		contentType sql.NullString
+	)
+	err := repo.db.QueryRow(ctx, selectKey, scope, key).Scan(&record.RequestHash, &statusCode, &record.Body, &contentType)
+	if errors.Is(err, pgx.ErrNoRows) {
+		loggerVar.Error(idempotency.ErrKeyNotFound.Error())
+		return models.IdempotencyRecord{}, idempotency.ErrKeyNotFound
+	}
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return models.IdempotencyRecord{}, err
+	}
+	record.StatusCode = int(statusCode.Int32)
+	record.ContentType = contentType.String
+
+	loggerVar.Info("Successful")
+	return record, nil
+}
+
+func (repo *IdempotencyRepo) Save(ctx context.Context, scope, key string, record models.IdempotencyRecord) error {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	_, err := repo.db.Exec(ctx, saveResponse, scope, key, record.StatusCode, record.Body, record.ContentType)
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return err
+	}
+
+	loggerVar.Info("Successful")
+	return nil
+}
+
+func (repo *IdempotencyRepo) Delete(ctx context.Context, scope, key string) error {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	_, err := repo.db.Exec(ctx, deleteKey, scope, key)
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return err
+	}
+
+	loggerVar.Info("Successful")
+	return nil
+}
+
+func (repo *IdempotencyRepo) DeleteExpired(ctx context.Context) (int64, error) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	tag, err := repo.db.Exec(ctx, deleteExpiredKeys)
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return 0, err
+	}
+
+	loggerVar.Info("Successful")
+	return tag.RowsAffected(), nil
+}
@@ -0,0 +1 @@
+DELETE FROM idempotency_key WHERE expires_at < now()
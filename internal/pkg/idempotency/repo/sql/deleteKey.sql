@@ -0,0 +1 @@
+DELETE FROM idempotency_key WHERE scope = $1 AND key = $2
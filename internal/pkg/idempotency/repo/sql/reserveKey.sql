@@ -0,0 +1,11 @@
+INSERT INTO idempotency_key (scope, key, request_hash, expires_at)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (scope, key) DO UPDATE
+SET request_hash = EXCLUDED.request_hash,
+    status_code = NULL,
+    response_body = NULL,
+    content_type = NULL,
+    created_at = now(),
+    expires_at = EXCLUDED.expires_at
+WHERE idempotency_key.expires_at < now()
+RETURNING key
@@ -0,0 +1,3 @@
+UPDATE idempotency_key
+SET status_code = $3, response_body = $4, content_type = $5
+WHERE scope = $1 AND key = $2
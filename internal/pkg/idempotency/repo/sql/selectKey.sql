@@ -0,0 +1,3 @@
+SELECT request_hash, status_code, response_body, content_type
+FROM idempotency_key
+WHERE scope = $1 AND key = $2 AND expires_at >= now()
@@ -0,0 +1,36 @@
+package metrics
+
+import (
+	"github.com/K1tten2005/avito_pvz/internal/models"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+type CapacityMetrics struct {
+	Utilization *prometheus.GaugeVec
+}
+
+func NewCapacityMetrics() (*CapacityMetrics, error) {
+	var metr CapacityMetrics
+	metr.Utilization = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "pvz_capacity_utilization_ratio",
+			Help: "Share of the tightest PVZ capacity limit taken by products on the shelf.",
+		},
+		[]string{"pvz_id", "city"},
+	)
+	if err := prometheus.Register(metr.Utilization); err != nil {
+		return nil, err
+	}
+	return &metr, nil
+}
+
+// SetUtilization заменяет значения целиком, чтобы закрытые ПВЗ и снятые лимиты пропадали из метрики
+func (m *CapacityMetrics) SetUtilization(pvzList []models.PVZ) {
+	m.Utilization.Reset()
+	for _, pvzItem := range pvzList {
+		if pvzItem.Utilization == nil || pvzItem.Utilization.Ratio == nil {
+			continue
+		}
+		m.Utilization.WithLabelValues(pvzItem.Id.String(), pvzItem.City).Set(*pvzItem.Utilization.Ratio)
+	}
+}
@@ -0,0 +1,27 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+
+	"github.com/K1tten2005/avito_pvz/internal/models"
+)
+
+var (
+	ErrSinkRejected = errors.New("sink rejected events")
+)
+
+type OutboxRepo interface {
+	WithinTx(ctx context.Context, fn func(ctx context.Context) error) error
+	// AddEvent пишет событие в транзакции из ctx, если она открыта
+	AddEvent(ctx context.Context, event models.Event) error
+	// FetchPending блокирует неопубликованные события до конца транзакции
+	FetchPending(ctx context.Context, limit int) ([]models.Event, error)
+	MarkPublished(ctx context.Context, seqs []int64) error
+	MarkFailed(ctx context.Context, seqs []int64, reason string) error
+}
+
+// Sink — получатель событий. Доставка «хотя бы один раз»: дубликаты отсекаются по Event.Id
+type Sink interface {
+	Publish(ctx context.Context, events []models.Event) error
+}
@@ -0,0 +1,113 @@
+package repo
+
+import (
+	"context"
+	_ "embed"
+	"log/slog"
+
+	"github.com/K1tten2005/avito_pvz/internal/models"
+	"github.com/K1tten2005/avito_pvz/internal/pkg/utils/logger"
+	"github.com/K1tten2005/avito_pvz/internal/pkg/utils/pgtx"
+)
+
+//go:embed sql/insertEvent.sql
+var insertEvent string
+
+//go:embed sql/selectPendingEvents.sql
+var selectPendingEvents string
+
+//go:embed sql/markPublished.sql
+var markPublished string
+
+//go:embed sql/markFailed.sql
+var markFailed string
+
+type OutboxRepo struct {
+	db pgtx.Pool
+}
+
+func CreateOutboxRepo(db pgtx.Pool) *OutboxRepo {
+	return &OutboxRepo{
+		db: db,
+	}
+}
+
+func (repo *OutboxRepo) conn(ctx context.Context) pgtx.Conn {
+	return pgtx.FromContext(ctx, repo.db)
+}
+
+func (repo *OutboxRepo) WithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return pgtx.RunInTx(ctx, repo.db, fn)
+}
+
+func (repo *OutboxRepo) AddEvent(ctx context.Context, event models.Event) error {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	_, err := repo.conn(ctx).Exec(ctx, insertEvent, event.Id, event.Type, event.AggregateId, []byte(event.Payload), event.CreatedAt)
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return err
+	}
+
+	loggerVar.Info("Successful")
+	return nil
+}
+
+func (repo *OutboxRepo) FetchPending(ctx context.Context, limit int) ([]models.Event, error) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	rows, err := repo.conn(ctx).Query(ctx, selectPendingEvents, limit)
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return nil, err
+	}
+	defer rows.Close()
+
+	result := []models.Event{}
+	for rows.Next() {
+		var (
+			event   models.Event
+			payload []byte
+		)
+		if err := rows.Scan(&event.Seq, &event.Id, &event.Type, &event.AggregateId, &payload, &event.CreatedAt); err != nil {
+			loggerVar.Error(err.Error())
+			return nil, err
+		}
+		event.Payload = payload
+		result = append(result, event)
+	}
+
+	if err := rows.Err(); err != nil {
+		loggerVar.Error(err.Error())
+		return nil, err
+	}
+
+	loggerVar.Info("Successful")
+	return result, nil
+}
+
+func (repo *OutboxRepo) MarkPublished(ctx context.Context, seqs []int64) error {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	_, err := repo.conn(ctx).Exec(ctx, markPublished, seqs)
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return err
+	}
+
+	loggerVar.Info("Successful")
+	return nil
+}
+
+func (repo *OutboxRepo) MarkFailed(ctx context.Context, seqs []int64, reason string) error {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	_, err := repo.conn(ctx).Exec(ctx, markFailed, seqs, reason)
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return err
+	}
+
+	loggerVar.Info("Successful")
+	return nil
+}
@@ -0,0 +1,2 @@
+INSERT INTO outbox (event_id, event_type, aggregate_id, payload, created_at)
+VALUES ($1, $2, $3, $4, $5)
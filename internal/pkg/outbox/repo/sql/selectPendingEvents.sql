@@ -0,0 +1,6 @@
+SELECT id, event_id, event_type, aggregate_id, payload, created_at
+FROM outbox
+WHERE published_at IS NULL
+ORDER BY id
+LIMIT $1
+FOR UPDATE SKIP LOCKED
@@ -0,0 +1,43 @@
+package sink
+
+import (
+	"context"
+	"os"
+	"sync"
+
+	"github.com/K1tten2005/avito_pvz/internal/models"
+)
+
+// FileSink дописывает события в NDJSON-файл
+type FileSink struct {
+	mu   sync.Mutex
+	file *os.File
+}
+
+func CreateFileSink(path string) (*FileSink, error) {
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0644)
+	if err != nil {
+		return nil, err
+	}
+	return &FileSink{file: file}, nil
+}
+
+func (s *FileSink) Publish(ctx context.Context, events []models.Event) error {
+	data, err := encodeNDJSON(events)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, err := s.file.Write(data); err != nil {
+		return err
+	}
+	// события отмечаются опубликованными сразу после возврата, поэтому сбрасываем на диск
+	return s.file.Sync()
+}
+
+func (s *FileSink) Close() error {
+	return s.file.Close()
+}
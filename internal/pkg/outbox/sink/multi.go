@@ -0,0 +1,27 @@
+package sink
+
+import (
+	"context"
+
+	"github.com/K1tten2005/avito_pvz/internal/models"
+	"github.com/K1tten2005/avito_pvz/internal/pkg/outbox"
+)
+
+// MultiSink публикует пачку во все получатели по очереди. Если один из них упал,
+// пачка повторится целиком, поэтому получатели должны переносить дубликаты.
+type MultiSink struct {
+	sinks []outbox.Sink
+}
+
+func CreateMultiSink(sinks ...outbox.Sink) *MultiSink {
+	return &MultiSink{sinks: sinks}
+}
+
+func (s *MultiSink) Publish(ctx context.Context, events []models.Event) error {
+	for _, sink := range s.sinks {
+		if err := sink.Publish(ctx, events); err != nil {
+			return err
+		}
+	}
+	return nil
+}
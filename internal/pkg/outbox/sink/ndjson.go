@@ -0,0 +1,22 @@
+package sink
+
+import (
+	"bytes"
+
+	"github.com/K1tten2005/avito_pvz/internal/models"
+	"github.com/mailru/easyjson"
+)
+
+// encodeNDJSON кодирует события по одному JSON-объекту на строку
+func encodeNDJSON(events []models.Event) ([]byte, error) {
+	var buf bytes.Buffer
+	for _, event := range events {
+		line, err := easyjson.Marshal(event)
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(line)
+		buf.WriteByte('\n')
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,92 @@
+package sink
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/K1tten2005/avito_pvz/internal/models"
+	"github.com/K1tten2005/avito_pvz/internal/pkg/outbox"
+	"github.com/satori/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testEvents() []models.Event {
+	return []models.Event{
+		{Seq: 1, Id: uuid.NewV4(), Type: models.EventReceptionOpened, Payload: json.RawMessage(`{"id":"r1"}`)},
+		{Seq: 2, Id: uuid.NewV4(), Type: models.EventProductAdded, Payload: json.RawMessage(`{"id":"p1"}`)},
+	}
+}
+
+func TestFileSink_Publish(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "events.ndjson")
+	sink, err := CreateFileSink(path)
+	require.NoError(t, err)
+	defer sink.Close()
+
+	events := testEvents()
+	require.NoError(t, sink.Publish(context.Background(), events))
+
+	file, err := os.Open(path)
+	require.NoError(t, err)
+	defer file.Close()
+
+	var lines []string
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		lines = append(lines, scanner.Text())
+	}
+	require.Len(t, lines, 2)
+	assert.Contains(t, lines[0], events[0].Id.String())
+	assert.NotContains(t, lines[0], `"seq"`)
+	assert.Contains(t, lines[1], models.EventProductAdded)
+}
+
+func TestHTTPSink_Publish(t *testing.T) {
+	var received string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "application/x-ndjson", r.Header.Get("Content-Type"))
+		body, _ := io.ReadAll(r.Body)
+		received = string(body)
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+
+	require.NoError(t, CreateHTTPSink(server.URL, time.Second).Publish(context.Background(), testEvents()))
+	assert.Equal(t, 2, strings.Count(received, "\n"))
+}
+
+func TestHTTPSink_PublishRejected(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	err := CreateHTTPSink(server.URL, time.Second).Publish(context.Background(), testEvents())
+	assert.ErrorIs(t, err, outbox.ErrSinkRejected)
+}
+
+type failingSink struct{}
+
+func (failingSink) Publish(ctx context.Context, events []models.Event) error {
+	return outbox.ErrSinkRejected
+}
+
+func TestMultiSink_Publish(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "events.ndjson")
+	fileSink, err := CreateFileSink(path)
+	require.NoError(t, err)
+	defer fileSink.Close()
+
+	require.NoError(t, CreateMultiSink(fileSink).Publish(context.Background(), testEvents()))
+	assert.ErrorIs(t, CreateMultiSink(fileSink, failingSink{}).Publish(context.Background(), testEvents()), outbox.ErrSinkRejected)
+}
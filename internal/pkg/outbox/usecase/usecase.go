@@ -0,0 +1,86 @@
+package usecase
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/K1tten2005/avito_pvz/internal/pkg/outbox"
+	"github.com/K1tten2005/avito_pvz/internal/pkg/utils/logger"
+)
+
+type RelayUsecase struct {
+	repo      outbox.OutboxRepo
+	sink      outbox.Sink
+	batchSize int
+}
+
+func CreateRelayUsecase(repo outbox.OutboxRepo, sink outbox.Sink, batchSize int) *RelayUsecase {
+	return &RelayUsecase{repo: repo, sink: sink, batchSize: batchSize}
+}
+
+// RelayPending публикует одну пачку событий и возвращает её размер.
+// Строки заблокированы на время публикации, так что несколько релеев не шлют одно и то же.
+func (uc *RelayUsecase) RelayPending(ctx context.Context) (int, error) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	var (
+		published  int
+		publishErr error
+	)
+	err := uc.repo.WithinTx(ctx, func(ctx context.Context) error {
+		events, err := uc.repo.FetchPending(ctx, uc.batchSize)
+		if err != nil {
+			return err
+		}
+		if len(events) == 0 {
+			return nil
+		}
+
+		seqs := make([]int64, 0, len(events))
+		for _, event := range events {
+			seqs = append(seqs, event.Seq)
+		}
+
+		if publishErr = uc.sink.Publish(ctx, events); publishErr != nil {
+			// фиксируем попытку, события останутся в очереди до следующего тика
+			return uc.repo.MarkFailed(ctx, seqs, publishErr.Error())
+		}
+
+		published = len(events)
+		return uc.repo.MarkPublished(ctx, seqs)
+	})
+	if err == nil {
+		err = publishErr
+	}
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return 0, err
+	}
+	if published == 0 {
+		return 0, nil
+	}
+
+	loggerVar.Info("Success", slog.Int("published", published))
+	return published, nil
+}
+
+// RunRelay на каждом тике выгребает outbox, пока в нём есть полные пачки
+func (uc *RelayUsecase) RunRelay(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			for {
+				published, err := uc.RelayPending(ctx)
+				if err != nil || published < uc.batchSize {
+					break
+				}
+			}
+		}
+	}
+}
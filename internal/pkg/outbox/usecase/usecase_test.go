@@ -0,0 +1,76 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/K1tten2005/avito_pvz/internal/models"
+	"github.com/K1tten2005/avito_pvz/internal/pkg/outbox/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/satori/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRelayUsecase_RelayPending(t *testing.T) {
+	events := []models.Event{
+		{Seq: 1, Id: uuid.NewV4(), Type: models.EventReceptionOpened},
+		{Seq: 2, Id: uuid.NewV4(), Type: models.EventProductAdded},
+	}
+	sinkErr := errors.New("connection refused")
+
+	tests := []struct {
+		name              string
+		mockBehavior      func(repo *mocks.MockOutboxRepo, sink *mocks.MockSink)
+		expectedPublished int
+		expectedErr       error
+	}{
+		{
+			name: "empty outbox",
+			mockBehavior: func(repo *mocks.MockOutboxRepo, sink *mocks.MockSink) {
+				repo.EXPECT().FetchPending(gomock.Any(), 10).Return([]models.Event{}, nil)
+			},
+		},
+		{
+			name: "published",
+			mockBehavior: func(repo *mocks.MockOutboxRepo, sink *mocks.MockSink) {
+				repo.EXPECT().FetchPending(gomock.Any(), 10).Return(events, nil)
+				sink.EXPECT().Publish(gomock.Any(), events).Return(nil)
+				repo.EXPECT().MarkPublished(gomock.Any(), []int64{1, 2}).Return(nil)
+			},
+			expectedPublished: 2,
+		},
+		{
+			name: "sink failure keeps events pending",
+			mockBehavior: func(repo *mocks.MockOutboxRepo, sink *mocks.MockSink) {
+				repo.EXPECT().FetchPending(gomock.Any(), 10).Return(events, nil)
+				sink.EXPECT().Publish(gomock.Any(), events).Return(sinkErr)
+				repo.EXPECT().MarkFailed(gomock.Any(), []int64{1, 2}, sinkErr.Error()).Return(nil)
+			},
+			expectedErr: sinkErr,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repo := mocks.NewMockOutboxRepo(ctrl)
+			sink := mocks.NewMockSink(ctrl)
+			repo.EXPECT().WithinTx(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(ctx context.Context, fn func(ctx context.Context) error) error {
+					return fn(ctx)
+				})
+			tt.mockBehavior(repo, sink)
+
+			published, err := CreateRelayUsecase(repo, sink, 10).RelayPending(context.Background())
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedPublished, published)
+		})
+	}
+}
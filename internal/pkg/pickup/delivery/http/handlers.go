@@ -0,0 +1,213 @@
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/K1tten2005/avito_pvz/internal/models"
+	"github.com/K1tten2005/avito_pvz/internal/pkg/pickup"
+	"github.com/K1tten2005/avito_pvz/internal/pkg/utils/logger"
+	"github.com/K1tten2005/avito_pvz/internal/pkg/utils/send_err"
+	"github.com/gorilla/mux"
+	"github.com/mailru/easyjson"
+	"github.com/satori/uuid"
+)
+
+type PickupHandler struct {
+	uc pickup.PickupUsecase
+}
+
+func CreatePickupHandler(uc pickup.PickupUsecase) *PickupHandler {
+	return &PickupHandler{uc: uc}
+}
+
+func (h *PickupHandler) PreparePickup(w http.ResponseWriter, r *http.Request) {
+	loggerVar := logger.GetLoggerFromContext(r.Context()).With(slog.String("func", logger.GetFuncName()))
+
+	pvzID, err := uuid.FromString(mux.Vars(r)["pvzId"])
+	if err != nil {
+		logger.LogHandlerError(loggerVar, err, http.StatusBadRequest)
+		send_err.SendError(w, "wrong UUID format for pvzId query parameter", http.StatusBadRequest)
+		return
+	}
+
+	var req models.PreparePickupReq
+	if err := easyjson.UnmarshalFromReader(r.Body, &req); err != nil {
+		logger.LogHandlerError(loggerVar, fmt.Errorf("error while parsing JSON: %w", err), http.StatusBadRequest)
+		send_err.SendError(w, "error while parsing JSON", http.StatusBadRequest)
+		return
+	}
+	req.Sanitize()
+
+	result, err := h.uc.PreparePickup(r.Context(), pvzID, req)
+	if err != nil {
+		statusCode := errStatus(err)
+		logger.LogHandlerError(loggerVar, fmt.Errorf("error on a level below (usecase): %w", err), statusCode)
+		send_err.SendError(w, err.Error(), statusCode)
+		return
+	}
+
+	sendJSON(w, loggerVar, result, http.StatusOK)
+}
+
+func (h *PickupHandler) IssueProducts(w http.ResponseWriter, r *http.Request) {
+	loggerVar := logger.GetLoggerFromContext(r.Context()).With(slog.String("func", logger.GetFuncName()))
+
+	pvzID, err := uuid.FromString(mux.Vars(r)["pvzId"])
+	if err != nil {
+		logger.LogHandlerError(loggerVar, err, http.StatusBadRequest)
+		send_err.SendError(w, "wrong UUID format for pvzId query parameter", http.StatusBadRequest)
+		return
+	}
+
+	var req models.IssueReq
+	if err := easyjson.UnmarshalFromReader(r.Body, &req); err != nil {
+		logger.LogHandlerError(loggerVar, fmt.Errorf("error while parsing JSON: %w", err), http.StatusBadRequest)
+		send_err.SendError(w, "error while parsing JSON", http.StatusBadRequest)
+		return
+	}
+
+	issued, err := h.uc.IssueProducts(r.Context(), pvzID, req)
+	if err != nil {
+		statusCode := errStatus(err)
+		logger.LogHandlerError(loggerVar, fmt.Errorf("error on a level below (usecase): %w", err), statusCode)
+		send_err.SendError(w, err.Error(), statusCode)
+		return
+	}
+
+	sendJSON(w, loggerVar, issued, http.StatusOK)
+}
+
+func (h *PickupHandler) ReturnProducts(w http.ResponseWriter, r *http.Request) {
+	loggerVar := logger.GetLoggerFromContext(r.Context()).With(slog.String("func", logger.GetFuncName()))
+
+	pvzID, err := uuid.FromString(mux.Vars(r)["pvzId"])
+	if err != nil {
+		logger.LogHandlerError(loggerVar, err, http.StatusBadRequest)
+		send_err.SendError(w, "wrong UUID format for pvzId query parameter", http.StatusBadRequest)
+		return
+	}
+
+	var req models.ReturnReq
+	if err := easyjson.UnmarshalFromReader(r.Body, &req); err != nil {
+		logger.LogHandlerError(loggerVar, fmt.Errorf("error while parsing JSON: %w", err), http.StatusBadRequest)
+		send_err.SendError(w, "error while parsing JSON", http.StatusBadRequest)
+		return
+	}
+
+	returned, err := h.uc.ReturnProducts(r.Context(), pvzID, req)
+	if err != nil {
+		statusCode := errStatus(err)
+		logger.LogHandlerError(loggerVar, fmt.Errorf("error on a level below (usecase): %w", err), statusCode)
+		send_err.SendError(w, err.Error(), statusCode)
+		return
+	}
+
+	sendJSON(w, loggerVar, returned, http.StatusOK)
+}
+
+func (h *PickupHandler) GetProductHistory(w http.ResponseWriter, r *http.Request) {
+	loggerVar := logger.GetLoggerFromContext(r.Context()).With(slog.String("func", logger.GetFuncName()))
+
+	productID, err := uuid.FromString(mux.Vars(r)["productId"])
+	if err != nil {
+		logger.LogHandlerError(loggerVar, err, http.StatusBadRequest)
+		send_err.SendError(w, "wrong UUID format for productId query parameter", http.StatusBadRequest)
+		return
+	}
+
+	h.sendHistory(w, r, loggerVar, models.ProductHistoryFilter{ProductId: &productID})
+}
+
+func (h *PickupHandler) GetPvzHistory(w http.ResponseWriter, r *http.Request) {
+	loggerVar := logger.GetLoggerFromContext(r.Context()).With(slog.String("func", logger.GetFuncName()))
+
+	pvzID, err := uuid.FromString(mux.Vars(r)["pvzId"])
+	if err != nil {
+		logger.LogHandlerError(loggerVar, err, http.StatusBadRequest)
+		send_err.SendError(w, "wrong UUID format for pvzId query parameter", http.StatusBadRequest)
+		return
+	}
+
+	h.sendHistory(w, r, loggerVar, models.ProductHistoryFilter{PvzId: &pvzID})
+}
+
+// sendHistory дополняет фильтр параметрами status, startDate, endDate и limit
+func (h *PickupHandler) sendHistory(w http.ResponseWriter, r *http.Request, loggerVar *slog.Logger, filter models.ProductHistoryFilter) {
+	query := r.URL.Query()
+	filter.ToStatus = query.Get("status")
+
+	if startDateStr := query.Get("startDate"); startDateStr != "" {
+		t, err := time.Parse(time.RFC3339, startDateStr)
+		if err != nil {
+			logger.LogHandlerError(loggerVar, fmt.Errorf("wrong startDate format: %w", err), http.StatusBadRequest)
+			send_err.SendError(w, "wrong startDate format", http.StatusBadRequest)
+			return
+		}
+		filter.StartDate = &t
+	}
+	if endDateStr := query.Get("endDate"); endDateStr != "" {
+		t, err := time.Parse(time.RFC3339, endDateStr)
+		if err != nil {
+			logger.LogHandlerError(loggerVar, fmt.Errorf("wrong endDate format: %w", err), http.StatusBadRequest)
+			send_err.SendError(w, "wrong endDate format", http.StatusBadRequest)
+			return
+		}
+		filter.EndDate = &t
+	}
+
+	if limitStr := query.Get("limit"); limitStr != "" {
+		limit, err := strconv.Atoi(limitStr)
+		if err != nil || limit <= 0 {
+			logger.LogHandlerError(loggerVar, fmt.Errorf("invalid limit: %s", limitStr), http.StatusBadRequest)
+			send_err.SendError(w, "invalid limit", http.StatusBadRequest)
+			return
+		}
+		filter.Limit = limit
+	}
+
+	history, err := h.uc.GetStatusHistory(r.Context(), filter)
+	if err != nil {
+		statusCode := errStatus(err)
+		logger.LogHandlerError(loggerVar, fmt.Errorf("error on a level below (usecase): %w", err), statusCode)
+		send_err.SendError(w, err.Error(), statusCode)
+		return
+	}
+
+	sendJSON(w, loggerVar, history, http.StatusOK)
+}
+
+func sendJSON(w http.ResponseWriter, loggerVar *slog.Logger, body any, statusCode int) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	if err := json.NewEncoder(w).Encode(body); err != nil {
+		logger.LogHandlerError(loggerVar, fmt.Errorf("error while forming JSON: %w", err), http.StatusInternalServerError)
+		send_err.SendError(w, "error while forming JSON", http.StatusInternalServerError)
+		return
+	}
+	logger.LogHandlerInfo(loggerVar, "Successful", statusCode)
+}
+
+func errStatus(err error) int {
+	switch {
+	case errors.Is(err, pickup.ErrPvzNotFound), errors.Is(err, pickup.ErrProductNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, pickup.ErrInvalidPickupReq), errors.Is(err, pickup.ErrNoProducts),
+		errors.Is(err, pickup.ErrTooManyProducts), errors.Is(err, pickup.ErrInvalidReturnReason),
+		errors.Is(err, pickup.ErrInvalidStatus), errors.Is(err, pickup.ErrInvalidRange):
+		return http.StatusBadRequest
+	case errors.Is(err, pickup.ErrInvalidPickupCode):
+		return http.StatusForbidden
+	case errors.Is(err, pickup.ErrReceptionNotClosed), errors.Is(err, pickup.ErrInvalidStatusTransition):
+		return http.StatusConflict
+	case errors.Is(err, pickup.ErrPickupCodeUnavailable):
+		return http.StatusServiceUnavailable
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,46 @@
+package pickup
+
+import (
+	"context"
+	"errors"
+
+	"github.com/K1tten2005/avito_pvz/internal/models"
+	"github.com/satori/uuid"
+)
+
+var (
+	ErrPvzNotFound             = errors.New("pvz not found")
+	ErrProductNotFound         = errors.New("product not found")
+	ErrReceptionNotClosed      = errors.New("reception is not closed")
+	ErrInvalidStatusTransition = errors.New("product status does not allow this operation")
+	ErrInvalidPickupReq        = errors.New("either productIds or orderNumber is required")
+	ErrNoProducts              = errors.New("no products given")
+	ErrTooManyProducts         = errors.New("too many products")
+	ErrInvalidPickupCode       = errors.New("invalid pickup code")
+	ErrPickupCodeUnavailable   = errors.New("could not generate a unique pickup code")
+	ErrInvalidReturnReason     = errors.New("invalid return reason")
+	ErrInvalidStatus           = errors.New("invalid product status")
+	ErrInvalidRange            = errors.New("invalid date range")
+)
+
+type PickupRepo interface {
+	// WithinTx выполняет fn в одной транзакции: все вызовы репозитория с полученным ctx попадают в неё
+	WithinTx(ctx context.Context, fn func(ctx context.Context) error) error
+	// LockPvz блокирует строку ПВЗ до конца транзакции, сериализуя выдачу кодов и смену статусов
+	LockPvz(ctx context.Context, pvzID uuid.UUID) error
+	SelectProducts(ctx context.Context, pvzID uuid.UUID, ids []uuid.UUID) ([]models.ProductLocation, error)
+	SelectProductsByOrder(ctx context.Context, pvzID uuid.UUID, orderNumber string) ([]models.ProductLocation, error)
+	SelectReadyByCode(ctx context.Context, pvzID uuid.UUID, code string) ([]models.ProductLocation, error)
+	IsPickupCodeInUse(ctx context.Context, pvzID uuid.UUID, code string) (bool, error)
+	// SetProductStatus меняет статус товаров и пишет переходы в историю
+	SetProductStatus(ctx context.Context, pvzID uuid.UUID, ids []uuid.UUID, status, code, reason string) error
+	SelectStatusHistory(ctx context.Context, filter models.ProductHistoryFilter) ([]models.ProductStatusChange, error)
+}
+
+type PickupUsecase interface {
+	// PreparePickup переводит принятые товары в ready_for_pickup под общим кодом выдачи
+	PreparePickup(ctx context.Context, pvzID uuid.UUID, req models.PreparePickupReq) (models.Pickup, error)
+	IssueProducts(ctx context.Context, pvzID uuid.UUID, req models.IssueReq) ([]models.Product, error)
+	ReturnProducts(ctx context.Context, pvzID uuid.UUID, req models.ReturnReq) ([]models.Product, error)
+	GetStatusHistory(ctx context.Context, filter models.ProductHistoryFilter) ([]models.ProductStatusChange, error)
+}
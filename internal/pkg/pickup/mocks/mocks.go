@@ -0,0 +1,237 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/pkg/pickup/interfaces.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	models "github.com/K1tten2005/avito_pvz/internal/models"
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/satori/uuid"
+)
+
+// MockPickupRepo is a mock of PickupRepo interface.
+type MockPickupRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockPickupRepoMockRecorder
+}
+
+// MockPickupRepoMockRecorder is the mock recorder for MockPickupRepo.
+type MockPickupRepoMockRecorder struct {
+	mock *MockPickupRepo
+}
+
+// NewMockPickupRepo creates a new mock instance.
+func NewMockPickupRepo(ctrl *gomock.Controller) *MockPickupRepo {
+	mock := &MockPickupRepo{ctrl: ctrl}
+	mock.recorder = &MockPickupRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPickupRepo) EXPECT() *MockPickupRepoMockRecorder {
+	return m.recorder
+}
+
+// IsPickupCodeInUse mocks base method.
+func (m *MockPickupRepo) IsPickupCodeInUse(ctx context.Context, pvzID uuid.UUID, code string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsPickupCodeInUse", ctx, pvzID, code)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsPickupCodeInUse indicates an expected call of IsPickupCodeInUse.
+func (mr *MockPickupRepoMockRecorder) IsPickupCodeInUse(ctx, pvzID, code interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsPickupCodeInUse", reflect.TypeOf((*MockPickupRepo)(nil).IsPickupCodeInUse), ctx, pvzID, code)
+}
+
+// LockPvz mocks base method.
+func (m *MockPickupRepo) LockPvz(ctx context.Context, pvzID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockPvz", ctx, pvzID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LockPvz indicates an expected call of LockPvz.
+func (mr *MockPickupRepoMockRecorder) LockPvz(ctx, pvzID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockPvz", reflect.TypeOf((*MockPickupRepo)(nil).LockPvz), ctx, pvzID)
+}
+
+// SelectProducts mocks base method.
+func (m *MockPickupRepo) SelectProducts(ctx context.Context, pvzID uuid.UUID, ids []uuid.UUID) ([]models.ProductLocation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectProducts", ctx, pvzID, ids)
+	ret0, _ := ret[0].([]models.ProductLocation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectProducts indicates an expected call of SelectProducts.
+func (mr *MockPickupRepoMockRecorder) SelectProducts(ctx, pvzID, ids interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectProducts", reflect.TypeOf((*MockPickupRepo)(nil).SelectProducts), ctx, pvzID, ids)
+}
+
+// SelectProductsByOrder mocks base method.
+func (m *MockPickupRepo) SelectProductsByOrder(ctx context.Context, pvzID uuid.UUID, orderNumber string) ([]models.ProductLocation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectProductsByOrder", ctx, pvzID, orderNumber)
+	ret0, _ := ret[0].([]models.ProductLocation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectProductsByOrder indicates an expected call of SelectProductsByOrder.
+func (mr *MockPickupRepoMockRecorder) SelectProductsByOrder(ctx, pvzID, orderNumber interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectProductsByOrder", reflect.TypeOf((*MockPickupRepo)(nil).SelectProductsByOrder), ctx, pvzID, orderNumber)
+}
+
+// SelectReadyByCode mocks base method.
+func (m *MockPickupRepo) SelectReadyByCode(ctx context.Context, pvzID uuid.UUID, code string) ([]models.ProductLocation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectReadyByCode", ctx, pvzID, code)
+	ret0, _ := ret[0].([]models.ProductLocation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectReadyByCode indicates an expected call of SelectReadyByCode.
+func (mr *MockPickupRepoMockRecorder) SelectReadyByCode(ctx, pvzID, code interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectReadyByCode", reflect.TypeOf((*MockPickupRepo)(nil).SelectReadyByCode), ctx, pvzID, code)
+}
+
+// SelectStatusHistory mocks base method.
+func (m *MockPickupRepo) SelectStatusHistory(ctx context.Context, filter models.ProductHistoryFilter) ([]models.ProductStatusChange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectStatusHistory", ctx, filter)
+	ret0, _ := ret[0].([]models.ProductStatusChange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectStatusHistory indicates an expected call of SelectStatusHistory.
+func (mr *MockPickupRepoMockRecorder) SelectStatusHistory(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectStatusHistory", reflect.TypeOf((*MockPickupRepo)(nil).SelectStatusHistory), ctx, filter)
+}
+
+// SetProductStatus mocks base method.
+func (m *MockPickupRepo) SetProductStatus(ctx context.Context, pvzID uuid.UUID, ids []uuid.UUID, status, code, reason string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetProductStatus", ctx, pvzID, ids, status, code, reason)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetProductStatus indicates an expected call of SetProductStatus.
+func (mr *MockPickupRepoMockRecorder) SetProductStatus(ctx, pvzID, ids, status, code, reason interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetProductStatus", reflect.TypeOf((*MockPickupRepo)(nil).SetProductStatus), ctx, pvzID, ids, status, code, reason)
+}
+
+// WithinTx mocks base method.
+func (m *MockPickupRepo) WithinTx(ctx context.Context, fn func(context.Context) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WithinTx", ctx, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WithinTx indicates an expected call of WithinTx.
+func (mr *MockPickupRepoMockRecorder) WithinTx(ctx, fn interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithinTx", reflect.TypeOf((*MockPickupRepo)(nil).WithinTx), ctx, fn)
+}
+
+// MockPickupUsecase is a mock of PickupUsecase interface.
+type MockPickupUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockPickupUsecaseMockRecorder
+}
+
+// MockPickupUsecaseMockRecorder is the mock recorder for MockPickupUsecase.
+type MockPickupUsecaseMockRecorder struct {
+	mock *MockPickupUsecase
+}
+
+// NewMockPickupUsecase creates a new mock instance.
+func NewMockPickupUsecase(ctrl *gomock.Controller) *MockPickupUsecase {
+	mock := &MockPickupUsecase{ctrl: ctrl}
+	mock.recorder = &MockPickupUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPickupUsecase) EXPECT() *MockPickupUsecaseMockRecorder {
+	return m.recorder
+}
+
+// GetStatusHistory mocks base method.
+func (m *MockPickupUsecase) GetStatusHistory(ctx context.Context, filter models.ProductHistoryFilter) ([]models.ProductStatusChange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStatusHistory", ctx, filter)
+	ret0, _ := ret[0].([]models.ProductStatusChange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStatusHistory indicates an expected call of GetStatusHistory.
+func (mr *MockPickupUsecaseMockRecorder) GetStatusHistory(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStatusHistory", reflect.TypeOf((*MockPickupUsecase)(nil).GetStatusHistory), ctx, filter)
+}
+
+// IssueProducts mocks base method.
+func (m *MockPickupUsecase) IssueProducts(ctx context.Context, pvzID uuid.UUID, req models.IssueReq) ([]models.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IssueProducts", ctx, pvzID, req)
+	ret0, _ := ret[0].([]models.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IssueProducts indicates an expected call of IssueProducts.
+func (mr *MockPickupUsecaseMockRecorder) IssueProducts(ctx, pvzID, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IssueProducts", reflect.TypeOf((*MockPickupUsecase)(nil).IssueProducts), ctx, pvzID, req)
+}
+
+// PreparePickup mocks base method.
+func (m *MockPickupUsecase) PreparePickup(ctx context.Context, pvzID uuid.UUID, req models.PreparePickupReq) (models.Pickup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PreparePickup", ctx, pvzID, req)
+	ret0, _ := ret[0].(models.Pickup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PreparePickup indicates an expected call of PreparePickup.
+func (mr *MockPickupUsecaseMockRecorder) PreparePickup(ctx, pvzID, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PreparePickup", reflect.TypeOf((*MockPickupUsecase)(nil).PreparePickup), ctx, pvzID, req)
+}
+
+// ReturnProducts mocks base method.
+func (m *MockPickupUsecase) ReturnProducts(ctx context.Context, pvzID uuid.UUID, req models.ReturnReq) ([]models.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReturnProducts", ctx, pvzID, req)
+	ret0, _ := ret[0].([]models.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReturnProducts indicates an expected call of ReturnProducts.
+func (mr *MockPickupUsecaseMockRecorder) ReturnProducts(ctx, pvzID, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReturnProducts", reflect.TypeOf((*MockPickupUsecase)(nil).ReturnProducts), ctx, pvzID, req)
+}
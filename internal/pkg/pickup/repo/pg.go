@@ -0,0 +1,197 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	_ "embed"
+	"errors"
+	"log/slog"
+
+	"github.com/K1tten2005/avito_pvz/internal/models"
+	"github.com/K1tten2005/avito_pvz/internal/pkg/pickup"
+	"github.com/K1tten2005/avito_pvz/internal/pkg/utils/logger"
+	"github.com/K1tten2005/avito_pvz/internal/pkg/utils/pgtx"
+	"github.com/jackc/pgx/v4"
+	"github.com/satori/uuid"
+)
+
+//go:embed sql/lockPvz.sql
+var lockPvz string
+
+//go:embed sql/selectProducts.sql
+var selectProducts string
+
+//go:embed sql/selectProductsByOrder.sql
+var selectProductsByOrder string
+
+//go:embed sql/selectReadyByCode.sql
+var selectReadyByCode string
+
+//go:embed sql/isPickupCodeInUse.sql
+var isPickupCodeInUse string
+
+//go:embed sql/updateProductStatus.sql
+var updateProductStatus string
+
+//go:embed sql/selectStatusHistory.sql
+var selectStatusHistory string
+
+type PickupRepo struct {
+	db pgtx.Pool
+}
+
+func CreatePickupRepo(db pgtx.Pool) *PickupRepo {
+	return &PickupRepo{
+		db: db,
+	}
+}
+
+func (repo *PickupRepo) conn(ctx context.Context) pgtx.Conn {
+	return pgtx.FromContext(ctx, repo.db)
+}
+
+func (repo *PickupRepo) WithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return pgtx.RunInTx(ctx, repo.db, fn)
+}
+
+func (repo *PickupRepo) LockPvz(ctx context.Context, pvzID uuid.UUID) error {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	var lockedID uuid.UUID
+	err := repo.conn(ctx).QueryRow(ctx, lockPvz, pvzID).Scan(&lockedID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		loggerVar.Error(pickup.ErrPvzNotFound.Error())
+		return pickup.ErrPvzNotFound
+	}
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return err
+	}
+
+	loggerVar.Info("Successful")
+	return nil
+}
+
+func (repo *PickupRepo) SelectProducts(ctx context.Context, pvzID uuid.UUID, ids []uuid.UUID) ([]models.ProductLocation, error) {
+	idStrs := make([]string, 0, len(ids))
+	for _, id := range ids {
+		idStrs = append(idStrs, id.String())
+	}
+	return repo.selectLocations(ctx, selectProducts, pvzID, idStrs)
+}
+
+func (repo *PickupRepo) SelectProductsByOrder(ctx context.Context, pvzID uuid.UUID, orderNumber string) ([]models.ProductLocation, error) {
+	return repo.selectLocations(ctx, selectProductsByOrder, pvzID, orderNumber)
+}
+
+func (repo *PickupRepo) SelectReadyByCode(ctx context.Context, pvzID uuid.UUID, code string) ([]models.ProductLocation, error) {
+	return repo.selectLocations(ctx, selectReadyByCode, pvzID, code)
+}
+
+func (repo *PickupRepo) selectLocations(ctx context.Context, query string, args ...any) ([]models.ProductLocation, error) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	rows, err := repo.conn(ctx).Query(ctx, query, args...)
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return nil, err
+	}
+	defer rows.Close()
+
+	locations := []models.ProductLocation{}
+	for rows.Next() {
+		var (
+			location             models.ProductLocation
+			barcode, sku, number sql.NullString
+		)
+		err := rows.Scan(
+			&location.Product.Id, &location.Product.DateTime, &location.Product.Type, &location.Product.ReceptionId,
+			&barcode, &sku, &number, &location.Product.Status,
+			&location.PvzId, &location.City, &location.ReceptionStatus,
+		)
+		if err != nil {
+			loggerVar.Error(err.Error())
+			return nil, err
+		}
+		location.Product.Barcode = barcode.String
+		location.Product.Sku = sku.String
+		location.Product.OrderNumber = number.String
+		locations = append(locations, location)
+	}
+	if err := rows.Err(); err != nil {
+		loggerVar.Error(err.Error())
+		return nil, err
+	}
+
+	loggerVar.Info("Successful")
+	return locations, nil
+}
+
+func (repo *PickupRepo) IsPickupCodeInUse(ctx context.Context, pvzID uuid.UUID, code string) (bool, error) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	var inUse bool
+	if err := repo.conn(ctx).QueryRow(ctx, isPickupCodeInUse, pvzID, code).Scan(&inUse); err != nil {
+		loggerVar.Error(err.Error())
+		return false, err
+	}
+
+	loggerVar.Info("Successful")
+	return inUse, nil
+}
+
+func (repo *PickupRepo) SetProductStatus(ctx context.Context, pvzID uuid.UUID, ids []uuid.UUID, status, code, reason string) error {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	idStrs := make([]string, 0, len(ids))
+	for _, id := range ids {
+		idStrs = append(idStrs, id.String())
+	}
+
+	tag, err := repo.conn(ctx).Exec(ctx, updateProductStatus, idStrs, pvzID, status, code, reason)
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return err
+	}
+	if int(tag.RowsAffected()) != len(ids) {
+		loggerVar.Error(pickup.ErrProductNotFound.Error())
+		return pickup.ErrProductNotFound
+	}
+
+	loggerVar.Info("Successful")
+	return nil
+}
+
+func (repo *PickupRepo) SelectStatusHistory(ctx context.Context, filter models.ProductHistoryFilter) ([]models.ProductStatusChange, error) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	rows, err := repo.conn(ctx).Query(ctx, selectStatusHistory,
+		filter.ProductId, filter.PvzId, filter.ToStatus, filter.StartDate, filter.EndDate, filter.Limit,
+	)
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return nil, err
+	}
+	defer rows.Close()
+
+	history := []models.ProductStatusChange{}
+	for rows.Next() {
+		var change models.ProductStatusChange
+		err := rows.Scan(
+			&change.Id, &change.ProductId, &change.PvzId,
+			&change.FromStatus, &change.ToStatus, &change.Reason, &change.ChangedAt,
+		)
+		if err != nil {
+			loggerVar.Error(err.Error())
+			return nil, err
+		}
+		history = append(history, change)
+	}
+	if err := rows.Err(); err != nil {
+		loggerVar.Error(err.Error())
+		return nil, err
+	}
+
+	loggerVar.Info("Successful")
+	return history, nil
+}
@@ -0,0 +1,6 @@
+SELECT EXISTS (
+    SELECT 1
+    FROM product
+    JOIN reception ON reception.id = product.reception_id
+    WHERE reception.pvz_id = $1 AND product.pickup_code = $2
+)
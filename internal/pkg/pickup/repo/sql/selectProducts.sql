@@ -0,0 +1,10 @@
+SELECT
+    product.id, product.reception_time, product.category, product.reception_id,
+    product.barcode, product.sku, product.order_number, product.status,
+    reception.pvz_id, pvz.city, reception.status
+FROM product
+JOIN reception ON reception.id = product.reception_id
+JOIN pvz ON pvz.id = reception.pvz_id
+WHERE reception.pvz_id = $1 AND product.id = ANY($2::uuid[]) AND product.deleted_at IS NULL
+ORDER BY product.reception_time, product.id
+FOR UPDATE OF product
@@ -0,0 +1,10 @@
+SELECT
+    product.id, product.reception_time, product.category, product.reception_id,
+    product.barcode, product.sku, product.order_number, product.status,
+    reception.pvz_id, pvz.city, reception.status
+FROM product
+JOIN reception ON reception.id = product.reception_id
+JOIN pvz ON pvz.id = reception.pvz_id
+WHERE reception.pvz_id = $1 AND product.pickup_code = $2 AND product.status = 'ready_for_pickup'
+ORDER BY product.reception_time, product.id
+FOR UPDATE OF product
@@ -0,0 +1,9 @@
+SELECT id, product_id, pvz_id, from_status, to_status, coalesce(reason, ''), changed_at
+FROM product_status_history
+WHERE ($1::uuid IS NULL OR product_id = $1)
+    AND ($2::uuid IS NULL OR pvz_id = $2)
+    AND ($3::text = '' OR to_status::text = $3)
+    AND ($4::timestamptz IS NULL OR changed_at >= $4)
+    AND ($5::timestamptz IS NULL OR changed_at < $5)
+ORDER BY changed_at DESC, id DESC
+LIMIT $6
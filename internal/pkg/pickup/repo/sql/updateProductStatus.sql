@@ -0,0 +1,11 @@
+WITH changed AS (
+    SELECT id, status AS from_status FROM product WHERE id = ANY($1::uuid[])
+), updated AS (
+    UPDATE product
+    SET status = $3, pickup_code = NULLIF($4, ''), status_changed_at = now()
+    FROM changed
+    WHERE product.id = changed.id
+    RETURNING product.id, changed.from_status
+)
+INSERT INTO product_status_history (product_id, pvz_id, from_status, to_status, reason)
+SELECT id, $2, from_status, $3, NULLIF($5, '') FROM updated
@@ -0,0 +1,324 @@
+package usecase
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"math/big"
+	"slices"
+	"time"
+
+	"github.com/K1tten2005/avito_pvz/internal/models"
+	"github.com/K1tten2005/avito_pvz/internal/pkg/outbox"
+	"github.com/K1tten2005/avito_pvz/internal/pkg/pickup"
+	"github.com/K1tten2005/avito_pvz/internal/pkg/utils/logger"
+	"github.com/K1tten2005/avito_pvz/internal/pkg/utils/validation"
+	"github.com/satori/uuid"
+)
+
+const (
+	maxPickupProducts   = 100
+	pickupCodeAttempts  = 10
+	defaultHistoryLimit = 50
+	maxHistoryLimit     = 500
+)
+
+// statusTransitions — из какого статуса товар может перейти в какой
+var statusTransitions = map[string][]string{
+	models.ProductStatusReceived:       {models.ProductStatusReadyForPickup, models.ProductStatusReturned},
+	models.ProductStatusReadyForPickup: {models.ProductStatusIssued, models.ProductStatusReturned},
+}
+
+type PickupUsecase struct {
+	repo    pickup.PickupRepo
+	outbox  outbox.OutboxRepo
+	newCode func() (string, error)
+}
+
+func CreatePickupUsecase(repo pickup.PickupRepo, outboxRepo outbox.OutboxRepo) *PickupUsecase {
+	return &PickupUsecase{repo: repo, outbox: outboxRepo, newCode: randomPickupCode}
+}
+
+// randomPickupCode генерирует шестизначный код, который покупатель называет на выдаче
+func randomPickupCode() (string, error) {
+	n, err := rand.Int(rand.Reader, big.NewInt(1_000_000))
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%06d", n.Int64()), nil
+}
+
+// emit пишет доменное событие в outbox; вызывать внутри WithinTx вместе со сменой статуса
+func (uc *PickupUsecase) emit(ctx context.Context, eventType string, pvzID uuid.UUID, payload any) error {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	return uc.outbox.AddEvent(ctx, models.Event{
+		Id:          uuid.NewV4(),
+		Type:        eventType,
+		AggregateId: pvzID,
+		CreatedAt:   time.Now(),
+		Payload:     data,
+	})
+}
+
+func (uc *PickupUsecase) PreparePickup(ctx context.Context, pvzID uuid.UUID, req models.PreparePickupReq) (models.Pickup, error) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	if (len(req.ProductIds) == 0) == (req.OrderNumber == "") {
+		loggerVar.Error(pickup.ErrInvalidPickupReq.Error())
+		return models.Pickup{}, pickup.ErrInvalidPickupReq
+	}
+	if len(req.ProductIds) > maxPickupProducts {
+		loggerVar.Error(pickup.ErrTooManyProducts.Error())
+		return models.Pickup{}, pickup.ErrTooManyProducts
+	}
+
+	result := models.Pickup{PvzId: pvzID, OrderNumber: req.OrderNumber}
+	err := uc.repo.WithinTx(ctx, func(ctx context.Context) error {
+		if err := uc.repo.LockPvz(ctx, pvzID); err != nil {
+			return err
+		}
+
+		var (
+			locations []models.ProductLocation
+			err       error
+		)
+		if req.OrderNumber != "" {
+			// Заказ выдаётся целиком: берём все принятые и ещё не подготовленные его товары
+			locations, err = uc.repo.SelectProductsByOrder(ctx, pvzID, req.OrderNumber)
+			if err == nil && len(locations) == 0 {
+				err = pickup.ErrProductNotFound
+			}
+		} else {
+			locations, err = uc.selectForTransition(ctx, pvzID, req.ProductIds, models.ProductStatusReadyForPickup)
+		}
+		if err != nil {
+			return err
+		}
+
+		code, err := uc.uniqueCode(ctx, pvzID)
+		if err != nil {
+			return err
+		}
+
+		result.Code = code
+		result.Products = productsWithStatus(locations, models.ProductStatusReadyForPickup)
+		if err := uc.repo.SetProductStatus(ctx, pvzID, productIDs(result.Products), models.ProductStatusReadyForPickup, code, ""); err != nil {
+			return err
+		}
+		return uc.emit(ctx, models.EventPickupReady, pvzID, result)
+	})
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return models.Pickup{}, err
+	}
+
+	loggerVar.Info("Success")
+	return result, nil
+}
+
+// uniqueCode подбирает код, который не занят другой выдачей в этом ПВЗ.
+// Вызывать под LockPvz, иначе две выдачи могут получить один код.
+func (uc *PickupUsecase) uniqueCode(ctx context.Context, pvzID uuid.UUID) (string, error) {
+	for range pickupCodeAttempts {
+		code, err := uc.newCode()
+		if err != nil {
+			return "", err
+		}
+		inUse, err := uc.repo.IsPickupCodeInUse(ctx, pvzID, code)
+		if err != nil {
+			return "", err
+		}
+		if !inUse {
+			return code, nil
+		}
+	}
+	return "", pickup.ErrPickupCodeUnavailable
+}
+
+func (uc *PickupUsecase) IssueProducts(ctx context.Context, pvzID uuid.UUID, req models.IssueReq) ([]models.Product, error) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	if !validation.IsValidPickupCode(req.Code) {
+		loggerVar.Error(pickup.ErrInvalidPickupCode.Error())
+		return nil, pickup.ErrInvalidPickupCode
+	}
+	if len(req.ProductIds) > maxPickupProducts {
+		loggerVar.Error(pickup.ErrTooManyProducts.Error())
+		return nil, pickup.ErrTooManyProducts
+	}
+
+	var issued []models.Product
+	err := uc.repo.WithinTx(ctx, func(ctx context.Context) error {
+		if err := uc.repo.LockPvz(ctx, pvzID); err != nil {
+			return err
+		}
+
+		locations, err := uc.repo.SelectReadyByCode(ctx, pvzID, req.Code)
+		if err != nil {
+			return err
+		}
+		if len(locations) == 0 {
+			return pickup.ErrInvalidPickupCode
+		}
+
+		// Без списка товаров выдаём всё, что ждёт покупателя под этим кодом
+		if len(req.ProductIds) > 0 {
+			ids := uniqueIDs(req.ProductIds)
+			selected := make([]models.ProductLocation, 0, len(ids))
+			for _, id := range ids {
+				i := slices.IndexFunc(locations, func(location models.ProductLocation) bool {
+					return location.Product.Id == id
+				})
+				if i < 0 {
+					return pickup.ErrProductNotFound
+				}
+				selected = append(selected, locations[i])
+			}
+			locations = selected
+		}
+
+		issued = productsWithStatus(locations, models.ProductStatusIssued)
+		if err := uc.repo.SetProductStatus(ctx, pvzID, productIDs(issued), models.ProductStatusIssued, "", ""); err != nil {
+			return err
+		}
+		for _, product := range issued {
+			if err := uc.emit(ctx, models.EventProductIssued, pvzID, product); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return nil, err
+	}
+
+	loggerVar.Info("Success")
+	return issued, nil
+}
+
+func (uc *PickupUsecase) ReturnProducts(ctx context.Context, pvzID uuid.UUID, req models.ReturnReq) ([]models.Product, error) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	if !validation.IsValidReturnReason(req.Reason) {
+		loggerVar.Error(pickup.ErrInvalidReturnReason.Error())
+		return nil, pickup.ErrInvalidReturnReason
+	}
+	if len(req.ProductIds) == 0 {
+		loggerVar.Error(pickup.ErrNoProducts.Error())
+		return nil, pickup.ErrNoProducts
+	}
+	if len(req.ProductIds) > maxPickupProducts {
+		loggerVar.Error(pickup.ErrTooManyProducts.Error())
+		return nil, pickup.ErrTooManyProducts
+	}
+
+	var returned []models.Product
+	err := uc.repo.WithinTx(ctx, func(ctx context.Context) error {
+		if err := uc.repo.LockPvz(ctx, pvzID); err != nil {
+			return err
+		}
+
+		locations, err := uc.selectForTransition(ctx, pvzID, req.ProductIds, models.ProductStatusReturned)
+		if err != nil {
+			return err
+		}
+
+		returned = productsWithStatus(locations, models.ProductStatusReturned)
+		if err := uc.repo.SetProductStatus(ctx, pvzID, productIDs(returned), models.ProductStatusReturned, "", req.Reason); err != nil {
+			return err
+		}
+		for _, product := range returned {
+			if err := uc.emit(ctx, models.EventProductReturned, pvzID, product); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return nil, err
+	}
+
+	loggerVar.Info("Success")
+	return returned, nil
+}
+
+// selectForTransition блокирует товары ПВЗ и проверяет, что каждый из них можно перевести в status
+func (uc *PickupUsecase) selectForTransition(ctx context.Context, pvzID uuid.UUID, ids []uuid.UUID, status string) ([]models.ProductLocation, error) {
+	ids = uniqueIDs(ids)
+	locations, err := uc.repo.SelectProducts(ctx, pvzID, ids)
+	if err != nil {
+		return nil, err
+	}
+	if len(locations) != len(ids) {
+		return nil, pickup.ErrProductNotFound
+	}
+
+	for _, location := range locations {
+		if location.ReceptionStatus != models.StatusClose {
+			return nil, pickup.ErrReceptionNotClosed
+		}
+		if !slices.Contains(statusTransitions[location.Product.Status], status) {
+			return nil, pickup.ErrInvalidStatusTransition
+		}
+	}
+	return locations, nil
+}
+
+func (uc *PickupUsecase) GetStatusHistory(ctx context.Context, filter models.ProductHistoryFilter) ([]models.ProductStatusChange, error) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	if filter.ToStatus != "" && !validation.IsValidProductStatus(filter.ToStatus) {
+		loggerVar.Error(pickup.ErrInvalidStatus.Error())
+		return nil, pickup.ErrInvalidStatus
+	}
+	if filter.StartDate != nil && filter.EndDate != nil && !filter.StartDate.Before(*filter.EndDate) {
+		loggerVar.Error(pickup.ErrInvalidRange.Error())
+		return nil, pickup.ErrInvalidRange
+	}
+	if filter.Limit <= 0 {
+		filter.Limit = defaultHistoryLimit
+	}
+	filter.Limit = min(filter.Limit, maxHistoryLimit)
+
+	history, err := uc.repo.SelectStatusHistory(ctx, filter)
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return nil, err
+	}
+
+	loggerVar.Info("Success")
+	return history, nil
+}
+
+func uniqueIDs(ids []uuid.UUID) []uuid.UUID {
+	ids = slices.Clone(ids)
+	slices.SortFunc(ids, func(a, b uuid.UUID) int {
+		return slices.Compare(a.Bytes(), b.Bytes())
+	})
+	return slices.Compact(ids)
+}
+
+func productsWithStatus(locations []models.ProductLocation, status string) []models.Product {
+	products := make([]models.Product, 0, len(locations))
+	for _, location := range locations {
+		product := location.Product
+		product.Status = status
+		products = append(products, product)
+	}
+	return products
+}
+
+func productIDs(products []models.Product) []uuid.UUID {
+	ids := make([]uuid.UUID, 0, len(products))
+	for _, product := range products {
+		ids = append(ids, product.Id)
+	}
+	return ids
+}
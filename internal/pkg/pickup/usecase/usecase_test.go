@@ -0,0 +1,293 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/K1tten2005/avito_pvz/internal/models"
+	outboxMocks "github.com/K1tten2005/avito_pvz/internal/pkg/outbox/mocks"
+	"github.com/K1tten2005/avito_pvz/internal/pkg/pickup"
+	"github.com/K1tten2005/avito_pvz/internal/pkg/pickup/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/satori/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func location(status, receptionStatus string) models.ProductLocation {
+	return models.ProductLocation{
+		Product:         models.Product{Id: uuid.NewV4(), Type: "обувь", Status: status},
+		ReceptionStatus: receptionStatus,
+	}
+}
+
+func TestPickupUsecase_PreparePickup(t *testing.T) {
+	pvzID := uuid.NewV4()
+	received := location(models.ProductStatusReceived, models.StatusClose)
+
+	tests := []struct {
+		name         string
+		req          models.PreparePickupReq
+		mockBehavior func(repo *mocks.MockPickupRepo)
+		codes        []string
+		expectedErr  error
+		expectedCode string
+	}{
+		{
+			name:        "neither products nor order",
+			req:         models.PreparePickupReq{},
+			expectedErr: pickup.ErrInvalidPickupReq,
+		},
+		{
+			name: "reception still open",
+			req:  models.PreparePickupReq{ProductIds: []uuid.UUID{received.Product.Id}},
+			mockBehavior: func(repo *mocks.MockPickupRepo) {
+				runInTx(repo)
+				repo.EXPECT().LockPvz(gomock.Any(), pvzID).Return(nil)
+				repo.EXPECT().SelectProducts(gomock.Any(), pvzID, []uuid.UUID{received.Product.Id}).
+					Return([]models.ProductLocation{location(models.ProductStatusReceived, models.StatusInProgress)}, nil)
+			},
+			expectedErr: pickup.ErrReceptionNotClosed,
+		},
+		{
+			name: "already issued",
+			req:  models.PreparePickupReq{ProductIds: []uuid.UUID{received.Product.Id}},
+			mockBehavior: func(repo *mocks.MockPickupRepo) {
+				runInTx(repo)
+				repo.EXPECT().LockPvz(gomock.Any(), pvzID).Return(nil)
+				repo.EXPECT().SelectProducts(gomock.Any(), pvzID, gomock.Any()).
+					Return([]models.ProductLocation{location(models.ProductStatusIssued, models.StatusClose)}, nil)
+			},
+			expectedErr: pickup.ErrInvalidStatusTransition,
+		},
+		{
+			name: "product from another pvz",
+			req:  models.PreparePickupReq{ProductIds: []uuid.UUID{received.Product.Id, uuid.NewV4()}},
+			mockBehavior: func(repo *mocks.MockPickupRepo) {
+				runInTx(repo)
+				repo.EXPECT().LockPvz(gomock.Any(), pvzID).Return(nil)
+				repo.EXPECT().SelectProducts(gomock.Any(), pvzID, gomock.Len(2)).Return([]models.ProductLocation{received}, nil)
+			},
+			expectedErr: pickup.ErrProductNotFound,
+		},
+		{
+			name: "order has nothing to prepare",
+			req:  models.PreparePickupReq{OrderNumber: "A-1"},
+			mockBehavior: func(repo *mocks.MockPickupRepo) {
+				runInTx(repo)
+				repo.EXPECT().LockPvz(gomock.Any(), pvzID).Return(nil)
+				repo.EXPECT().SelectProductsByOrder(gomock.Any(), pvzID, "A-1").Return([]models.ProductLocation{}, nil)
+			},
+			expectedErr: pickup.ErrProductNotFound,
+		},
+		{
+			name: "order with busy code",
+			req:  models.PreparePickupReq{OrderNumber: "A-1"},
+			mockBehavior: func(repo *mocks.MockPickupRepo) {
+				runInTx(repo)
+				repo.EXPECT().LockPvz(gomock.Any(), pvzID).Return(nil)
+				repo.EXPECT().SelectProductsByOrder(gomock.Any(), pvzID, "A-1").Return([]models.ProductLocation{received}, nil)
+				repo.EXPECT().IsPickupCodeInUse(gomock.Any(), pvzID, "111111").Return(true, nil)
+				repo.EXPECT().IsPickupCodeInUse(gomock.Any(), pvzID, "222222").Return(false, nil)
+				repo.EXPECT().SetProductStatus(gomock.Any(), pvzID, []uuid.UUID{received.Product.Id}, models.ProductStatusReadyForPickup, "222222", "").Return(nil)
+			},
+			codes:        []string{"111111", "222222"},
+			expectedCode: "222222",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repo := mocks.NewMockPickupRepo(ctrl)
+			if tt.mockBehavior != nil {
+				tt.mockBehavior(repo)
+			}
+
+			uc := CreatePickupUsecase(repo, acceptEvents(ctrl))
+			uc.newCode = func() (string, error) {
+				code := tt.codes[0]
+				tt.codes = tt.codes[1:]
+				return code, nil
+			}
+
+			result, err := uc.PreparePickup(context.Background(), pvzID, tt.req)
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedCode, result.Code)
+			require.Len(t, result.Products, 1)
+			assert.Equal(t, models.ProductStatusReadyForPickup, result.Products[0].Status)
+		})
+	}
+}
+
+func TestPickupUsecase_IssueProducts(t *testing.T) {
+	pvzID := uuid.NewV4()
+	first := location(models.ProductStatusReadyForPickup, models.StatusClose)
+	second := location(models.ProductStatusReadyForPickup, models.StatusClose)
+
+	tests := []struct {
+		name         string
+		req          models.IssueReq
+		mockBehavior func(repo *mocks.MockPickupRepo)
+		expectedErr  error
+		expectedIDs  []uuid.UUID
+	}{
+		{
+			name:        "malformed code",
+			req:         models.IssueReq{Code: "12ab"},
+			expectedErr: pickup.ErrInvalidPickupCode,
+		},
+		{
+			name: "unknown code",
+			req:  models.IssueReq{Code: "123456"},
+			mockBehavior: func(repo *mocks.MockPickupRepo) {
+				runInTx(repo)
+				repo.EXPECT().LockPvz(gomock.Any(), pvzID).Return(nil)
+				repo.EXPECT().SelectReadyByCode(gomock.Any(), pvzID, "123456").Return([]models.ProductLocation{}, nil)
+			},
+			expectedErr: pickup.ErrInvalidPickupCode,
+		},
+		{
+			name: "product not under this code",
+			req:  models.IssueReq{Code: "123456", ProductIds: []uuid.UUID{uuid.NewV4()}},
+			mockBehavior: func(repo *mocks.MockPickupRepo) {
+				runInTx(repo)
+				repo.EXPECT().LockPvz(gomock.Any(), pvzID).Return(nil)
+				repo.EXPECT().SelectReadyByCode(gomock.Any(), pvzID, "123456").Return([]models.ProductLocation{first, second}, nil)
+			},
+			expectedErr: pickup.ErrProductNotFound,
+		},
+		{
+			name: "issue everything under code",
+			req:  models.IssueReq{Code: "123456"},
+			mockBehavior: func(repo *mocks.MockPickupRepo) {
+				runInTx(repo)
+				repo.EXPECT().LockPvz(gomock.Any(), pvzID).Return(nil)
+				repo.EXPECT().SelectReadyByCode(gomock.Any(), pvzID, "123456").Return([]models.ProductLocation{first, second}, nil)
+				repo.EXPECT().SetProductStatus(gomock.Any(), pvzID, []uuid.UUID{first.Product.Id, second.Product.Id}, models.ProductStatusIssued, "", "").Return(nil)
+			},
+			expectedIDs: []uuid.UUID{first.Product.Id, second.Product.Id},
+		},
+		{
+			name: "issue part of the order",
+			req:  models.IssueReq{Code: "123456", ProductIds: []uuid.UUID{second.Product.Id, second.Product.Id}},
+			mockBehavior: func(repo *mocks.MockPickupRepo) {
+				runInTx(repo)
+				repo.EXPECT().LockPvz(gomock.Any(), pvzID).Return(nil)
+				repo.EXPECT().SelectReadyByCode(gomock.Any(), pvzID, "123456").Return([]models.ProductLocation{first, second}, nil)
+				repo.EXPECT().SetProductStatus(gomock.Any(), pvzID, []uuid.UUID{second.Product.Id}, models.ProductStatusIssued, "", "").Return(nil)
+			},
+			expectedIDs: []uuid.UUID{second.Product.Id},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repo := mocks.NewMockPickupRepo(ctrl)
+			if tt.mockBehavior != nil {
+				tt.mockBehavior(repo)
+			}
+
+			issued, err := CreatePickupUsecase(repo, acceptEvents(ctrl)).IssueProducts(context.Background(), pvzID, tt.req)
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedIDs, productIDs(issued))
+			for _, product := range issued {
+				assert.Equal(t, models.ProductStatusIssued, product.Status)
+			}
+		})
+	}
+}
+
+func TestPickupUsecase_ReturnProducts(t *testing.T) {
+	pvzID := uuid.NewV4()
+
+	t.Run("invalid reason", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		_, err := CreatePickupUsecase(mocks.NewMockPickupRepo(ctrl), acceptEvents(ctrl)).ReturnProducts(context.Background(), pvzID, models.ReturnReq{
+			ProductIds: []uuid.UUID{uuid.NewV4()},
+			Reason:     "lost",
+		})
+		assert.ErrorIs(t, err, pickup.ErrInvalidReturnReason)
+	})
+
+	t.Run("issued product can not be returned", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		issued := location(models.ProductStatusIssued, models.StatusClose)
+		repo := mocks.NewMockPickupRepo(ctrl)
+		runInTx(repo)
+		repo.EXPECT().LockPvz(gomock.Any(), pvzID).Return(nil)
+		repo.EXPECT().SelectProducts(gomock.Any(), pvzID, []uuid.UUID{issued.Product.Id}).Return([]models.ProductLocation{issued}, nil)
+
+		_, err := CreatePickupUsecase(repo, acceptEvents(ctrl)).ReturnProducts(context.Background(), pvzID, models.ReturnReq{
+			ProductIds: []uuid.UUID{issued.Product.Id},
+			Reason:     models.ReturnReasonRefused,
+		})
+		assert.ErrorIs(t, err, pickup.ErrInvalidStatusTransition)
+	})
+
+	t.Run("success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ready := location(models.ProductStatusReadyForPickup, models.StatusClose)
+		repo := mocks.NewMockPickupRepo(ctrl)
+		runInTx(repo)
+		repo.EXPECT().LockPvz(gomock.Any(), pvzID).Return(nil)
+		repo.EXPECT().SelectProducts(gomock.Any(), pvzID, []uuid.UUID{ready.Product.Id}).Return([]models.ProductLocation{ready}, nil)
+		repo.EXPECT().SetProductStatus(gomock.Any(), pvzID, []uuid.UUID{ready.Product.Id}, models.ProductStatusReturned, "", models.ReturnReasonNotCollected).Return(nil)
+
+		returned, err := CreatePickupUsecase(repo, acceptEvents(ctrl)).ReturnProducts(context.Background(), pvzID, models.ReturnReq{
+			ProductIds: []uuid.UUID{ready.Product.Id},
+			Reason:     models.ReturnReasonNotCollected,
+		})
+		require.NoError(t, err)
+		require.Len(t, returned, 1)
+		assert.Equal(t, models.ProductStatusReturned, returned[0].Status)
+	})
+}
+
+func TestPickupUsecase_GetStatusHistory(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mocks.NewMockPickupRepo(ctrl)
+	uc := CreatePickupUsecase(repo, acceptEvents(ctrl))
+
+	_, err := uc.GetStatusHistory(context.Background(), models.ProductHistoryFilter{ToStatus: "lost"})
+	assert.ErrorIs(t, err, pickup.ErrInvalidStatus)
+
+	repo.EXPECT().SelectStatusHistory(gomock.Any(), models.ProductHistoryFilter{ToStatus: models.ProductStatusIssued, Limit: maxHistoryLimit}).
+		Return([]models.ProductStatusChange{}, nil)
+	_, err = uc.GetStatusHistory(context.Background(), models.ProductHistoryFilter{ToStatus: models.ProductStatusIssued, Limit: 10000})
+	require.NoError(t, err)
+}
+
+func runInTx(repo *mocks.MockPickupRepo) {
+	repo.EXPECT().WithinTx(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, fn func(ctx context.Context) error) error {
+			return fn(ctx)
+		})
+}
+
+func acceptEvents(ctrl *gomock.Controller) *outboxMocks.MockOutboxRepo {
+	events := outboxMocks.NewMockOutboxRepo(ctrl)
+	events.EXPECT().AddEvent(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	return events
+}
@@ -0,0 +1,113 @@
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	"github.com/K1tten2005/avito_pvz/internal/models"
+	"github.com/K1tten2005/avito_pvz/internal/pkg/product_type"
+	"github.com/K1tten2005/avito_pvz/internal/pkg/utils/logger"
+	"github.com/K1tten2005/avito_pvz/internal/pkg/utils/send_err"
+	"github.com/gorilla/mux"
+	"github.com/mailru/easyjson"
+)
+
+type ProductTypeHandler struct {
+	uc product_type.ProductTypeUsecase
+}
+
+func CreateProductTypeHandler(uc product_type.ProductTypeUsecase) *ProductTypeHandler {
+	return &ProductTypeHandler{uc: uc}
+}
+
+func (h *ProductTypeHandler) GetProductTypes(w http.ResponseWriter, r *http.Request) {
+	loggerVar := logger.GetLoggerFromContext(r.Context()).With(slog.String("func", logger.GetFuncName()))
+
+	productTypes, err := h.uc.GetProductTypes(r.Context())
+	if err != nil {
+		logger.LogHandlerError(loggerVar, fmt.Errorf("error on a level below (usecase): %w", err), http.StatusInternalServerError)
+		send_err.SendError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(productTypes); err != nil {
+		logger.LogHandlerError(loggerVar, fmt.Errorf("error while forming JSON: %w", err), http.StatusInternalServerError)
+		send_err.SendError(w, "error while forming JSON", http.StatusInternalServerError)
+		return
+	}
+	logger.LogHandlerInfo(loggerVar, "Successful", http.StatusOK)
+}
+
+func (h *ProductTypeHandler) CreateProductType(w http.ResponseWriter, r *http.Request) {
+	loggerVar := logger.GetLoggerFromContext(r.Context()).With(slog.String("func", logger.GetFuncName()))
+
+	var req models.ProductTypeReq
+	if err := easyjson.UnmarshalFromReader(r.Body, &req); err != nil {
+		logger.LogHandlerError(loggerVar, fmt.Errorf("error while parsing JSON: %w", err), http.StatusBadRequest)
+		send_err.SendError(w, "error while parsing JSON", http.StatusBadRequest)
+		return
+	}
+	req.Sanitize()
+
+	productType, err := h.uc.CreateProductType(r.Context(), req)
+	if err != nil {
+		statusCode := errStatus(err)
+		logger.LogHandlerError(loggerVar, fmt.Errorf("error on a level below (usecase): %w", err), statusCode)
+		send_err.SendError(w, err.Error(), statusCode)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(w).Encode(productType); err != nil {
+		logger.LogHandlerError(loggerVar, fmt.Errorf("error while forming JSON: %w", err), http.StatusInternalServerError)
+		send_err.SendError(w, "error while forming JSON", http.StatusInternalServerError)
+		return
+	}
+	logger.LogHandlerInfo(loggerVar, "Successful", http.StatusCreated)
+}
+
+func (h *ProductTypeHandler) UpdateProductType(w http.ResponseWriter, r *http.Request) {
+	loggerVar := logger.GetLoggerFromContext(r.Context()).With(slog.String("func", logger.GetFuncName()))
+
+	var req models.ProductTypeReq
+	if err := easyjson.UnmarshalFromReader(r.Body, &req); err != nil {
+		logger.LogHandlerError(loggerVar, fmt.Errorf("error while parsing JSON: %w", err), http.StatusBadRequest)
+		send_err.SendError(w, "error while parsing JSON", http.StatusBadRequest)
+		return
+	}
+	req.Sanitize()
+
+	productType, err := h.uc.UpdateProductType(r.Context(), mux.Vars(r)["code"], req)
+	if err != nil {
+		statusCode := errStatus(err)
+		logger.LogHandlerError(loggerVar, fmt.Errorf("error on a level below (usecase): %w", err), statusCode)
+		send_err.SendError(w, err.Error(), statusCode)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(productType); err != nil {
+		logger.LogHandlerError(loggerVar, fmt.Errorf("error while forming JSON: %w", err), http.StatusInternalServerError)
+		send_err.SendError(w, "error while forming JSON", http.StatusInternalServerError)
+		return
+	}
+	logger.LogHandlerInfo(loggerVar, "Successful", http.StatusOK)
+}
+
+func errStatus(err error) int {
+	switch {
+	case errors.Is(err, product_type.ErrProductTypeNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, product_type.ErrInvalidProductType):
+		return http.StatusBadRequest
+	case errors.Is(err, product_type.ErrProductTypeExists):
+		return http.StatusConflict
+	default:
+		return http.StatusInternalServerError
+	}
+}
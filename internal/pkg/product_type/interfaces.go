@@ -0,0 +1,28 @@
+package product_type
+
+import (
+	"context"
+	"errors"
+
+	"github.com/K1tten2005/avito_pvz/internal/models"
+)
+
+var (
+	ErrProductTypeNotFound = errors.New("product type not found")
+	ErrProductTypeExists   = errors.New("product type already exists")
+	ErrInvalidProductType  = errors.New("invalid product type code or names")
+)
+
+type ProductTypeRepo interface {
+	SelectProductTypes(ctx context.Context) ([]models.ProductType, error)
+	SelectProductTypeByCode(ctx context.Context, code string) (models.ProductType, error)
+	InsertProductType(ctx context.Context, productType models.ProductType) error
+	UpdateProductType(ctx context.Context, productType models.ProductType) error
+}
+
+type ProductTypeUsecase interface {
+	GetProductTypes(ctx context.Context) ([]models.ProductType, error)
+	CreateProductType(ctx context.Context, req models.ProductTypeReq) (models.ProductType, error)
+	UpdateProductType(ctx context.Context, code string, req models.ProductTypeReq) (models.ProductType, error)
+	RefreshCache(ctx context.Context) error
+}
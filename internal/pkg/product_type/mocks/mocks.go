@@ -0,0 +1,176 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/pkg/product_type/interfaces.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	models "github.com/K1tten2005/avito_pvz/internal/models"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockProductTypeRepo is a mock of ProductTypeRepo interface.
+type MockProductTypeRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockProductTypeRepoMockRecorder
+}
+
+// MockProductTypeRepoMockRecorder is the mock recorder for MockProductTypeRepo.
+type MockProductTypeRepoMockRecorder struct {
+	mock *MockProductTypeRepo
+}
+
+// NewMockProductTypeRepo creates a new mock instance.
+func NewMockProductTypeRepo(ctrl *gomock.Controller) *MockProductTypeRepo {
+	mock := &MockProductTypeRepo{ctrl: ctrl}
+	mock.recorder = &MockProductTypeRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockProductTypeRepo) EXPECT() *MockProductTypeRepoMockRecorder {
+	return m.recorder
+}
+
+// InsertProductType mocks base method.
+func (m *MockProductTypeRepo) InsertProductType(ctx context.Context, productType models.ProductType) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertProductType", ctx, productType)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InsertProductType indicates an expected call of InsertProductType.
+func (mr *MockProductTypeRepoMockRecorder) InsertProductType(ctx, productType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertProductType", reflect.TypeOf((*MockProductTypeRepo)(nil).InsertProductType), ctx, productType)
+}
+
+// SelectProductTypeByCode mocks base method.
+func (m *MockProductTypeRepo) SelectProductTypeByCode(ctx context.Context, code string) (models.ProductType, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectProductTypeByCode", ctx, code)
+	ret0, _ := ret[0].(models.ProductType)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectProductTypeByCode indicates an expected call of SelectProductTypeByCode.
+func (mr *MockProductTypeRepoMockRecorder) SelectProductTypeByCode(ctx, code interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectProductTypeByCode", reflect.TypeOf((*MockProductTypeRepo)(nil).SelectProductTypeByCode), ctx, code)
+}
+
+// SelectProductTypes mocks base method.
+func (m *MockProductTypeRepo) SelectProductTypes(ctx context.Context) ([]models.ProductType, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectProductTypes", ctx)
+	ret0, _ := ret[0].([]models.ProductType)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectProductTypes indicates an expected call of SelectProductTypes.
+func (mr *MockProductTypeRepoMockRecorder) SelectProductTypes(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectProductTypes", reflect.TypeOf((*MockProductTypeRepo)(nil).SelectProductTypes), ctx)
+}
+
+// UpdateProductType mocks base method.
+func (m *MockProductTypeRepo) UpdateProductType(ctx context.Context, productType models.ProductType) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateProductType", ctx, productType)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateProductType indicates an expected call of UpdateProductType.
+func (mr *MockProductTypeRepoMockRecorder) UpdateProductType(ctx, productType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProductType", reflect.TypeOf((*MockProductTypeRepo)(nil).UpdateProductType), ctx, productType)
+}
+
+// MockProductTypeUsecase is a mock of ProductTypeUsecase interface.
+type MockProductTypeUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockProductTypeUsecaseMockRecorder
+}
+
+// MockProductTypeUsecaseMockRecorder is the mock recorder for MockProductTypeUsecase.
+type MockProductTypeUsecaseMockRecorder struct {
+	mock *MockProductTypeUsecase
+}
+
+// NewMockProductTypeUsecase creates a new mock instance.
+func NewMockProductTypeUsecase(ctrl *gomock.Controller) *MockProductTypeUsecase {
+	mock := &MockProductTypeUsecase{ctrl: ctrl}
+	mock.recorder = &MockProductTypeUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockProductTypeUsecase) EXPECT() *MockProductTypeUsecaseMockRecorder {
+	return m.recorder
+}
+
+// CreateProductType mocks base method.
+func (m *MockProductTypeUsecase) CreateProductType(ctx context.Context, req models.ProductTypeReq) (models.ProductType, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateProductType", ctx, req)
+	ret0, _ := ret[0].(models.ProductType)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateProductType indicates an expected call of CreateProductType.
+func (mr *MockProductTypeUsecaseMockRecorder) CreateProductType(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateProductType", reflect.TypeOf((*MockProductTypeUsecase)(nil).CreateProductType), ctx, req)
+}
+
+// GetProductTypes mocks base method.
+func (m *MockProductTypeUsecase) GetProductTypes(ctx context.Context) ([]models.ProductType, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProductTypes", ctx)
+	ret0, _ := ret[0].([]models.ProductType)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProductTypes indicates an expected call of GetProductTypes.
+func (mr *MockProductTypeUsecaseMockRecorder) GetProductTypes(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProductTypes", reflect.TypeOf((*MockProductTypeUsecase)(nil).GetProductTypes), ctx)
+}
+
+// RefreshCache mocks base method.
+func (m *MockProductTypeUsecase) RefreshCache(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RefreshCache", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RefreshCache indicates an expected call of RefreshCache.
+func (mr *MockProductTypeUsecaseMockRecorder) RefreshCache(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshCache", reflect.TypeOf((*MockProductTypeUsecase)(nil).RefreshCache), ctx)
+}
+
+// UpdateProductType mocks base method.
+func (m *MockProductTypeUsecase) UpdateProductType(ctx context.Context, code string, req models.ProductTypeReq) (models.ProductType, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateProductType", ctx, code, req)
+	ret0, _ := ret[0].(models.ProductType)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateProductType indicates an expected call of UpdateProductType.
+func (mr *MockProductTypeUsecaseMockRecorder) UpdateProductType(ctx, code, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProductType", reflect.TypeOf((*MockProductTypeUsecase)(nil).UpdateProductType), ctx, code, req)
+}
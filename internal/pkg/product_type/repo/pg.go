@@ -0,0 +1,119 @@
+package repo
+
+import (
+	"context"
+	_ "embed"
+	"errors"
+	"log/slog"
+
+	"github.com/K1tten2005/avito_pvz/internal/models"
+	"github.com/K1tten2005/avito_pvz/internal/pkg/product_type"
+	"github.com/K1tten2005/avito_pvz/internal/pkg/utils/logger"
+	"github.com/K1tten2005/avito_pvz/internal/pkg/utils/pgerr"
+	"github.com/jackc/pgtype/pgxtype"
+	"github.com/jackc/pgx/v4"
+)
+
+//go:embed sql/selectProductTypes.sql
+var selectProductTypes string
+
+//go:embed sql/selectProductTypeByCode.sql
+var selectProductTypeByCode string
+
+//go:embed sql/insertProductType.sql
+var insertProductType string
+
+//go:embed sql/updateProductType.sql
+var updateProductType string
+
+type ProductTypeRepo struct {
+	db pgxtype.Querier
+}
+
+func CreateProductTypeRepo(db pgxtype.Querier) *ProductTypeRepo {
+	return &ProductTypeRepo{
+		db: db,
+	}
+}
+
+func (repo *ProductTypeRepo) SelectProductTypes(ctx context.Context) ([]models.ProductType, error) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	rows, err := repo.db.Query(ctx, selectProductTypes)
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return nil, err
+	}
+	defer rows.Close()
+
+	result := []models.ProductType{}
+	for rows.Next() {
+		var productType models.ProductType
+		if err := rows.Scan(&productType.Code, &productType.Names, &productType.Active); err != nil {
+			loggerVar.Error(err.Error())
+			return nil, err
+		}
+		result = append(result, productType)
+	}
+
+	if err := rows.Err(); err != nil {
+		loggerVar.Error(err.Error())
+		return nil, err
+	}
+
+	loggerVar.Info("Successful")
+	return result, nil
+}
+
+func (repo *ProductTypeRepo) SelectProductTypeByCode(ctx context.Context, code string) (models.ProductType, error) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	var productType models.ProductType
+	err := repo.db.QueryRow(ctx, selectProductTypeByCode, code).
+		Scan(&productType.Code, &productType.Names, &productType.Active)
+	if errors.Is(err, pgx.ErrNoRows) {
+		loggerVar.Error(product_type.ErrProductTypeNotFound.Error())
+		return models.ProductType{}, product_type.ErrProductTypeNotFound
+	}
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return models.ProductType{}, err
+	}
+
+	loggerVar.Info("Successful")
+	return productType, nil
+}
+
+func (repo *ProductTypeRepo) InsertProductType(ctx context.Context, productType models.ProductType) error {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	_, err := repo.db.Exec(ctx, insertProductType, productType.Code, productType.Names, productType.Active)
+	if pgerr.IsUniqueViolation(err) {
+		loggerVar.Error(product_type.ErrProductTypeExists.Error())
+		return product_type.ErrProductTypeExists
+	}
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return err
+	}
+
+	loggerVar.Info("Successful")
+	return nil
+}
+
+func (repo *ProductTypeRepo) UpdateProductType(ctx context.Context, productType models.ProductType) error {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	cmd, err := repo.db.Exec(ctx, updateProductType, productType.Code, productType.Names, productType.Active)
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return err
+	}
+	if cmd.RowsAffected() == 0 {
+		loggerVar.Error(product_type.ErrProductTypeNotFound.Error())
+		return product_type.ErrProductTypeNotFound
+	}
+
+	loggerVar.Info("Successful")
+	return nil
+}
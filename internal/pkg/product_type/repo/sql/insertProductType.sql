@@ -0,0 +1 @@
+INSERT INTO product_type (code, names, active) VALUES ($1, $2, $3)
\ No newline at end of file
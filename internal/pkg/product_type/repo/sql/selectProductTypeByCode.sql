@@ -0,0 +1 @@
+SELECT code, names, active FROM product_type WHERE code = $1
\ No newline at end of file
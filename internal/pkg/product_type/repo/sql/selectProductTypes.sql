@@ -0,0 +1 @@
+SELECT code, names, active FROM product_type ORDER BY code
\ No newline at end of file
@@ -0,0 +1 @@
+UPDATE product_type SET names = $2, active = $3 WHERE code = $1
\ No newline at end of file
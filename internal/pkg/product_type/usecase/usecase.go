@@ -0,0 +1,140 @@
+package usecase
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/K1tten2005/avito_pvz/internal/models"
+	"github.com/K1tten2005/avito_pvz/internal/pkg/product_type"
+	"github.com/K1tten2005/avito_pvz/internal/pkg/utils/logger"
+	"github.com/K1tten2005/avito_pvz/internal/pkg/utils/validation"
+)
+
+const maxCodeLength = 64
+
+type ProductTypeUsecase struct {
+	repo product_type.ProductTypeRepo
+}
+
+func CreateProductTypeUsecase(repo product_type.ProductTypeRepo) *ProductTypeUsecase {
+	return &ProductTypeUsecase{repo: repo}
+}
+
+func (uc *ProductTypeUsecase) GetProductTypes(ctx context.Context) ([]models.ProductType, error) {
+	return uc.repo.SelectProductTypes(ctx)
+}
+
+func (uc *ProductTypeUsecase) CreateProductType(ctx context.Context, req models.ProductTypeReq) (models.ProductType, error) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	if req.Code == nil || !isValidCode(*req.Code) || !isValidNames(req.Names) {
+		loggerVar.Error(product_type.ErrInvalidProductType.Error())
+		return models.ProductType{}, product_type.ErrInvalidProductType
+	}
+
+	productType := models.ProductType{
+		Code:   *req.Code,
+		Names:  req.Names,
+		Active: true,
+	}
+	if req.Active != nil {
+		productType.Active = *req.Active
+	}
+
+	if err := uc.repo.InsertProductType(ctx, productType); err != nil {
+		loggerVar.Error(err.Error())
+		return models.ProductType{}, err
+	}
+
+	if err := uc.RefreshCache(ctx); err != nil {
+		loggerVar.Error(err.Error())
+	}
+
+	loggerVar.Info("Success")
+	return productType, nil
+}
+
+func (uc *ProductTypeUsecase) UpdateProductType(ctx context.Context, code string, req models.ProductTypeReq) (models.ProductType, error) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	// Код — внешний ключ для товаров, поэтому он не меняется
+	if req.Code != nil && *req.Code != code {
+		loggerVar.Error(product_type.ErrInvalidProductType.Error())
+		return models.ProductType{}, product_type.ErrInvalidProductType
+	}
+
+	productType, err := uc.repo.SelectProductTypeByCode(ctx, code)
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return models.ProductType{}, err
+	}
+
+	if req.Names != nil {
+		if !isValidNames(req.Names) {
+			loggerVar.Error(product_type.ErrInvalidProductType.Error())
+			return models.ProductType{}, product_type.ErrInvalidProductType
+		}
+		productType.Names = req.Names
+	}
+	if req.Active != nil {
+		productType.Active = *req.Active
+	}
+
+	if err := uc.repo.UpdateProductType(ctx, productType); err != nil {
+		loggerVar.Error(err.Error())
+		return models.ProductType{}, err
+	}
+
+	if err := uc.RefreshCache(ctx); err != nil {
+		loggerVar.Error(err.Error())
+	}
+
+	loggerVar.Info("Success")
+	return productType, nil
+}
+
+func (uc *ProductTypeUsecase) RefreshCache(ctx context.Context) error {
+	productTypes, err := uc.repo.SelectProductTypes(ctx)
+	if err != nil {
+		return err
+	}
+	validation.SetProductTypes(productTypes)
+	return nil
+}
+
+func (uc *ProductTypeUsecase) RunCacheRefresher(ctx context.Context, interval time.Duration) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := uc.RefreshCache(ctx); err != nil {
+				loggerVar.Error(err.Error())
+			}
+		}
+	}
+}
+
+func isValidCode(code string) bool {
+	return strings.TrimSpace(code) == code && code != "" && utf8.RuneCountInString(code) <= maxCodeLength
+}
+
+func isValidNames(names map[string]string) bool {
+	if len(names) == 0 {
+		return false
+	}
+	for lang, name := range names {
+		if lang == "" || strings.TrimSpace(name) == "" {
+			return false
+		}
+	}
+	return true
+}
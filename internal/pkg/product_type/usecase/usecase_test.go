@@ -0,0 +1,74 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/K1tten2005/avito_pvz/internal/models"
+	"github.com/K1tten2005/avito_pvz/internal/pkg/product_type"
+	"github.com/K1tten2005/avito_pvz/internal/pkg/product_type/mocks"
+	"github.com/K1tten2005/avito_pvz/internal/pkg/utils/validation"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProductTypeUsecase_CreateProductType(t *testing.T) {
+	strPtr := func(s string) *string { return &s }
+	boolPtr := func(b bool) *bool { return &b }
+
+	tests := []struct {
+		name         string
+		req          models.ProductTypeReq
+		mockBehavior func(repo *mocks.MockProductTypeRepo)
+		expectedErr  error
+	}{
+		{
+			name:         "no names",
+			req:          models.ProductTypeReq{Code: strPtr("мебель")},
+			mockBehavior: func(repo *mocks.MockProductTypeRepo) {},
+			expectedErr:  product_type.ErrInvalidProductType,
+		},
+		{
+			name:         "code with spaces",
+			req:          models.ProductTypeReq{Code: strPtr(" мебель"), Names: map[string]string{"ru": "Мебель"}},
+			mockBehavior: func(repo *mocks.MockProductTypeRepo) {},
+			expectedErr:  product_type.ErrInvalidProductType,
+		},
+		{
+			name: "already exists",
+			req:  models.ProductTypeReq{Code: strPtr("обувь"), Names: map[string]string{"ru": "Обувь"}},
+			mockBehavior: func(repo *mocks.MockProductTypeRepo) {
+				repo.EXPECT().InsertProductType(gomock.Any(), gomock.Any()).Return(product_type.ErrProductTypeExists)
+			},
+			expectedErr: product_type.ErrProductTypeExists,
+		},
+		{
+			name: "inactive type is not valid for intake",
+			req:  models.ProductTypeReq{Code: strPtr("мебель"), Names: map[string]string{"ru": "Мебель"}, Active: boolPtr(false)},
+			mockBehavior: func(repo *mocks.MockProductTypeRepo) {
+				created := models.ProductType{Code: "мебель", Names: map[string]string{"ru": "Мебель"}, Active: false}
+				repo.EXPECT().InsertProductType(gomock.Any(), created).Return(nil)
+				repo.EXPECT().SelectProductTypes(gomock.Any()).Return([]models.ProductType{created}, nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repo := mocks.NewMockProductTypeRepo(ctrl)
+			tt.mockBehavior(repo)
+
+			_, err := CreateProductTypeUsecase(repo).CreateProductType(context.Background(), tt.req)
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.False(t, validation.IsValidProductType("мебель"))
+		})
+	}
+}
@@ -0,0 +1,298 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/K1tten2005/avito_pvz/internal/models"
+	"github.com/K1tten2005/avito_pvz/internal/pkg/pvz"
+	"github.com/K1tten2005/avito_pvz/internal/pkg/pvz/delivery/grpc/gen"
+	"github.com/K1tten2005/avito_pvz/internal/pkg/schedule"
+	"github.com/K1tten2005/avito_pvz/internal/pkg/utils/logger"
+	"github.com/K1tten2005/avito_pvz/internal/pkg/utils/pagination"
+	"github.com/satori/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	defaultPvzLimit = 10
+	maxPvzLimit     = 30
+)
+
+type PvzServer struct {
+	gen.UnimplementedPvzServiceServer
+	uc pvz.PvzUsecase
+}
+
+func CreatePvzServer(uc pvz.PvzUsecase) *PvzServer {
+	return &PvzServer{uc: uc}
+}
+
+func (s *PvzServer) GetPvzList(ctx context.Context, req *gen.GetPvzListRequest) (*gen.GetPvzListResponse, error) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	filter := models.PvzFilter{
+		Page:            int(req.GetPage()),
+		Limit:           int(req.GetLimit()),
+		IncludeArchived: req.GetIncludeArchived(),
+	}
+	if filter.Page == 0 {
+		filter.Page = 1
+	}
+	if filter.Limit == 0 {
+		filter.Limit = defaultPvzLimit
+	}
+	if filter.Page < 1 || filter.Limit < 1 || filter.Limit > maxPvzLimit {
+		loggerVar.Error("wrong page or limit value")
+		return nil, status.Error(codes.InvalidArgument, "wrong page or limit value")
+	}
+
+	if req.GetCursor() != "" {
+		cursor, err := pagination.DecodeCursor(req.GetCursor())
+		if err != nil {
+			loggerVar.Error(err.Error())
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		filter.Cursor = &cursor
+	}
+	if req.StartDate != nil {
+		startDate := req.GetStartDate().AsTime()
+		filter.StartDate = &startDate
+	}
+	if req.EndDate != nil {
+		endDate := req.GetEndDate().AsTime()
+		filter.EndDate = &endDate
+	}
+
+	page, err := s.uc.GetPvz(ctx, filter)
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return nil, toStatus(err)
+	}
+
+	resp := &gen.GetPvzListResponse{
+		Items:      make([]*gen.PVZ, 0, len(page.Items)),
+		NextCursor: page.NextCursor,
+		Total:      int32(page.Total),
+	}
+	for _, item := range page.Items {
+		resp.Items = append(resp.Items, pvzToProto(item))
+	}
+
+	loggerVar.Info("Successful")
+	return resp, nil
+}
+
+func (s *PvzServer) CreateReception(ctx context.Context, req *gen.CreateReceptionRequest) (*gen.Reception, error) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	pvzID, err := parsePvzID(req.GetPvzId())
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return nil, err
+	}
+
+	reception, err := s.uc.CreateReception(ctx, pvzID)
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return nil, toStatus(err)
+	}
+
+	loggerVar.Info("Successful")
+	return receptionToProto(reception), nil
+}
+
+func (s *PvzServer) AddProduct(ctx context.Context, req *gen.AddProductRequest) (*gen.Product, error) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	pvzID, err := parsePvzID(req.GetPvzId())
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return nil, err
+	}
+
+	addReq := models.AddProductReq{
+		Type:          req.GetType(),
+		PvzId:         pvzID,
+		Barcode:       req.GetBarcode(),
+		BarcodeFormat: req.GetBarcodeFormat(),
+		Sku:           req.GetSku(),
+		OrderNumber:   req.GetOrderNumber(),
+	}
+	if req.BarcodeChecksum != nil {
+		checksum := int(req.GetBarcodeChecksum())
+		addReq.BarcodeChecksum = &checksum
+	}
+	if req.WeightGrams != nil {
+		weight := int(req.GetWeightGrams())
+		addReq.WeightGrams = &weight
+	}
+	if dims := req.GetDimensions(); dims != nil {
+		addReq.Dimensions = &models.Dimensions{
+			LengthMm: int(dims.GetLengthMm()),
+			WidthMm:  int(dims.GetWidthMm()),
+			HeightMm: int(dims.GetHeightMm()),
+		}
+	}
+	addReq.Sanitize()
+
+	product, err := s.uc.AddProduct(ctx, addReq)
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return nil, toStatus(err)
+	}
+
+	loggerVar.Info("Successful")
+	return productToProto(*product), nil
+}
+
+func (s *PvzServer) DeleteLastProduct(ctx context.Context, req *gen.DeleteLastProductRequest) (*emptypb.Empty, error) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	pvzID, err := parsePvzID(req.GetPvzId())
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return nil, err
+	}
+
+	if err := s.uc.DeleteProduct(ctx, pvzID); err != nil {
+		loggerVar.Error(err.Error())
+		return nil, toStatus(err)
+	}
+
+	loggerVar.Info("Successful")
+	return &emptypb.Empty{}, nil
+}
+
+func (s *PvzServer) CloseLastReception(ctx context.Context, req *gen.CloseLastReceptionRequest) (*gen.Reception, error) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	pvzID, err := parsePvzID(req.GetPvzId())
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return nil, err
+	}
+
+	reception, err := s.uc.CloseReception(ctx, pvzID)
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return nil, toStatus(err)
+	}
+
+	loggerVar.Info("Successful")
+	return receptionToProto(reception), nil
+}
+
+func parsePvzID(pvzIDStr string) (uuid.UUID, error) {
+	pvzID, err := uuid.FromString(pvzIDStr)
+	if err != nil || pvzID == uuid.Nil {
+		return uuid.Nil, status.Error(codes.InvalidArgument, "wrong UUID format for pvz_id")
+	}
+	return pvzID, nil
+}
+
+func toStatus(err error) error {
+	switch {
+	case errors.Is(err, pvz.ErrPvzNotFound),
+		errors.Is(err, pvz.ErrReceptionNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, pvz.ErrPvzNotActive),
+		errors.Is(err, pvz.ErrActiveReceptionExists),
+		errors.Is(err, pvz.ErrNoActiveReception),
+		errors.Is(err, pvz.ErrNoProductsInReception),
+		errors.Is(err, pvz.ErrPvzFull),
+		errors.Is(err, schedule.ErrOutsideWorkingHours):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, pagination.ErrInvalidCursor),
+		errors.Is(err, pvz.ErrInvalidProductType),
+		errors.Is(err, pvz.ErrInvalidCity),
+		errors.Is(err, pvz.ErrInvalidBarcode),
+		errors.Is(err, pvz.ErrInvalidMeasurements),
+		errors.Is(err, pvz.ErrInvalidCoordinates):
+		return status.Error(codes.InvalidArgument, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
+
+func pvzToProto(p models.PVZ) *gen.PVZ {
+	result := &gen.PVZ{
+		Id:               p.Id.String(),
+		RegistrationDate: timeToProto(p.RegistrationDate),
+		City:             p.City,
+		Address:          p.Address,
+		Latitude:         p.Latitude,
+		Longitude:        p.Longitude,
+		Status:           p.Status,
+		Version:          int32(p.Version),
+		Metadata:         p.Metadata,
+		Receptions:       make([]*gen.Reception, 0, len(p.Receptions)),
+	}
+	for _, reception := range p.Receptions {
+		result.Receptions = append(result.Receptions, receptionToProto(reception))
+	}
+	return result
+}
+
+func receptionToProto(r models.Reception) *gen.Reception {
+	result := &gen.Reception{
+		Id:        r.Id.String(),
+		DateTime:  timeToProto(r.DateTime),
+		PvzId:     r.PvzId.String(),
+		Status:    r.Status,
+		Products:  make([]*gen.Product, 0, len(r.Products)),
+		CreatedBy: uuidToProto(r.CreatedBy),
+		ClosedBy:  uuidToProto(r.ClosedBy),
+	}
+	for _, product := range r.Products {
+		result.Products = append(result.Products, productToProto(product))
+	}
+	return result
+}
+
+func productToProto(p models.Product) *gen.Product {
+	result := &gen.Product{
+		Id:            p.Id.String(),
+		DateTime:      timeToProto(p.DateTime),
+		Type:          p.Type,
+		ReceptionId:   p.ReceptionId.String(),
+		Barcode:       p.Barcode,
+		BarcodeFormat: p.BarcodeFormat,
+		Sku:           p.Sku,
+		OrderNumber:   p.OrderNumber,
+		Warnings:      p.Warnings,
+		CreatedBy:     uuidToProto(p.CreatedBy),
+	}
+	if p.WeightGrams != nil {
+		weight := int32(*p.WeightGrams)
+		result.WeightGrams = &weight
+	}
+	if p.Dimensions != nil {
+		result.Dimensions = &gen.Dimensions{
+			LengthMm: int32(p.Dimensions.LengthMm),
+			WidthMm:  int32(p.Dimensions.WidthMm),
+			HeightMm: int32(p.Dimensions.HeightMm),
+		}
+	}
+	return result
+}
+
+func timeToProto(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
+
+func uuidToProto(id *uuid.UUID) *string {
+	if id == nil {
+		return nil
+	}
+	str := id.String()
+	return &str
+}
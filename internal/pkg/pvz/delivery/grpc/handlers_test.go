@@ -0,0 +1,82 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/K1tten2005/avito_pvz/internal/models"
+	"github.com/K1tten2005/avito_pvz/internal/pkg/pvz"
+	"github.com/K1tten2005/avito_pvz/internal/pkg/pvz/delivery/grpc/gen"
+	"github.com/K1tten2005/avito_pvz/internal/pkg/pvz/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/satori/uuid"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestPvzServer_CreateReception(t *testing.T) {
+	pvzID := uuid.NewV4()
+	reception := models.Reception{Id: uuid.NewV4(), PvzId: pvzID, Status: models.StatusInProgress}
+
+	tests := []struct {
+		name         string
+		pvzID        string
+		mockBehavior func(uc *mocks.MockPvzUsecase)
+		expectedCode codes.Code
+	}{
+		{
+			name:         "invalid pvz id",
+			pvzID:        "not-a-uuid",
+			mockBehavior: func(uc *mocks.MockPvzUsecase) {},
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:  "active reception exists",
+			pvzID: pvzID.String(),
+			mockBehavior: func(uc *mocks.MockPvzUsecase) {
+				uc.EXPECT().CreateReception(gomock.Any(), pvzID).Return(models.Reception{}, pvz.ErrActiveReceptionExists)
+			},
+			expectedCode: codes.FailedPrecondition,
+		},
+		{
+			name:  "success",
+			pvzID: pvzID.String(),
+			mockBehavior: func(uc *mocks.MockPvzUsecase) {
+				uc.EXPECT().CreateReception(gomock.Any(), pvzID).Return(reception, nil)
+			},
+			expectedCode: codes.OK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			uc := mocks.NewMockPvzUsecase(ctrl)
+			tt.mockBehavior(uc)
+
+			resp, err := CreatePvzServer(uc).CreateReception(context.Background(), &gen.CreateReceptionRequest{PvzId: tt.pvzID})
+			assert.Equal(t, tt.expectedCode, status.Code(err))
+			if tt.expectedCode == codes.OK {
+				assert.Equal(t, reception.Id.String(), resp.GetId())
+				assert.Equal(t, models.StatusInProgress, resp.GetStatus())
+			}
+		})
+	}
+}
+
+func TestPvzServer_AddProduct_InvalidType(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uc := mocks.NewMockPvzUsecase(ctrl)
+	uc.EXPECT().AddProduct(gomock.Any(), gomock.Any()).Return(nil, pvz.ErrInvalidProductType)
+
+	_, err := CreatePvzServer(uc).AddProduct(context.Background(), &gen.AddProductRequest{
+		PvzId: uuid.NewV4().String(),
+		Type:  "мебель",
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
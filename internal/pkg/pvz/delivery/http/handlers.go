@@ -1,19 +1,27 @@
 package http
 
 import (
+	"bytes"
+	"encoding/csv"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"log/slog"
 	"net/http"
 	"os"
+	"slices"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/K1tten2005/avito_pvz/internal/models"
 	"github.com/K1tten2005/avito_pvz/internal/pkg/metrics"
 	"github.com/K1tten2005/avito_pvz/internal/pkg/pvz"
+	"github.com/K1tten2005/avito_pvz/internal/pkg/schedule"
+	"github.com/K1tten2005/avito_pvz/internal/pkg/utils/actpdf"
 	"github.com/K1tten2005/avito_pvz/internal/pkg/utils/logger"
+	"github.com/K1tten2005/avito_pvz/internal/pkg/utils/pagination"
 	"github.com/K1tten2005/avito_pvz/internal/pkg/utils/send_err"
 	"github.com/K1tten2005/avito_pvz/internal/pkg/utils/validation"
 	"github.com/gorilla/mux"
@@ -21,6 +29,15 @@ import (
 	"github.com/satori/uuid"
 )
 
+const (
+	defaultPvzLimit = 10
+	maxPvzLimit     = 30
+
+	maxImportBodySize = 5 << 20
+)
+
+var pvzImportColumns = []string{"id", "city", "registration_date", "address", "latitude", "longitude"}
+
 type PvzHandler struct {
 	uc     pvz.PvzUsecase
 	secret string
@@ -58,16 +75,20 @@ func (h *PvzHandler) CreatePvz(w http.ResponseWriter, r *http.Request) {
 	if !validation.IsValidCity(req.City) {
 		logger.LogHandlerError(loggerVar, errors.New("invalid city"), http.StatusBadRequest)
 		send_err.SendError(w, "invalid city", http.StatusBadRequest)
+		return
 	}
 
-	err := h.uc.CreatePvz(r.Context(), req)
+	pvzItem, err := h.uc.CreatePvz(r.Context(), req)
 	if err != nil {
-		logger.LogHandlerError(loggerVar, fmt.Errorf("error on a level below (usecase): %w", err), http.StatusInternalServerError)
-		send_err.SendError(w, err.Error(), http.StatusInternalServerError)
+		statusCode := errStatus(err)
+		logger.LogHandlerError(loggerVar, fmt.Errorf("error on a level below (usecase): %w", err), statusCode)
+		send_err.SendError(w, err.Error(), statusCode)
+		return
 	}
 
+	w.Header().Set("ETag", pvzETag(pvzItem.Version))
 	w.Header().Set("Content-Type", "application/json")
-	if err := json.NewEncoder(w).Encode(req); err != nil {
+	if err := json.NewEncoder(w).Encode(pvzItem); err != nil {
 		logger.LogHandlerError(loggerVar, fmt.Errorf("error while forming JSON: %w", err), http.StatusInternalServerError)
 		send_err.SendError(w, "error while forming JSON", http.StatusInternalServerError)
 	}
@@ -80,6 +101,128 @@ func (h *PvzHandler) CreatePvz(w http.ResponseWriter, r *http.Request) {
 	logger.LogHandlerInfo(loggerVar, "Successful", http.StatusOK)
 }
 
+// ImportPvz создаёт ПВЗ из CSV-файла: все строки или ни одной.
+// С dryRun=true только проверяет файл и возвращает отчёт.
+func (h *PvzHandler) ImportPvz(w http.ResponseWriter, r *http.Request) {
+	loggerVar := logger.GetLoggerFromContext(r.Context()).With(slog.String("func", logger.GetFuncName()))
+
+	dryRun := false
+	if dryRunStr := r.URL.Query().Get("dryRun"); dryRunStr != "" {
+		var err error
+		if dryRun, err = strconv.ParseBool(dryRunStr); err != nil {
+			logger.LogHandlerError(loggerVar, fmt.Errorf("invalid dryRun: %w", err), http.StatusBadRequest)
+			send_err.SendError(w, "invalid dryRun", http.StatusBadRequest)
+			return
+		}
+	}
+
+	rows, err := parsePvzCSV(http.MaxBytesReader(w, r.Body, maxImportBodySize))
+	if err != nil {
+		logger.LogHandlerError(loggerVar, fmt.Errorf("error while parsing CSV: %w", err), http.StatusBadRequest)
+		send_err.SendError(w, fmt.Sprintf("error while parsing CSV: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	statusCode := http.StatusCreated
+	if dryRun {
+		statusCode = http.StatusOK
+	}
+	report, err := h.uc.ImportPvz(r.Context(), rows, dryRun)
+	switch {
+	case errors.Is(err, pvz.ErrInvalidImport):
+		// отдаём отчёт по каждой строке, чтобы было видно, что исправить в файле
+		statusCode = http.StatusBadRequest
+		logger.LogHandlerError(loggerVar, fmt.Errorf("error on a level below (usecase): %w", err), statusCode)
+	case err != nil:
+		statusCode = errStatus(err)
+		logger.LogHandlerError(loggerVar, fmt.Errorf("error on a level below (usecase): %w", err), statusCode)
+		send_err.SendError(w, err.Error(), statusCode)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	if err := json.NewEncoder(w).Encode(report); err != nil {
+		logger.LogHandlerError(loggerVar, fmt.Errorf("error while forming JSON: %w", err), http.StatusInternalServerError)
+		send_err.SendError(w, "error while forming JSON", http.StatusInternalServerError)
+		return
+	}
+	if statusCode != http.StatusCreated {
+		return
+	}
+
+	if h.mt != nil {
+		for range report.Imported {
+			h.mt.IncreasePvzTotal()
+		}
+	} else {
+		logger.LogHandlerError(loggerVar, errors.New("metrics collector is nil"), http.StatusInternalServerError)
+	}
+	logger.LogHandlerInfo(loggerVar, "Successful", http.StatusCreated)
+}
+
+// parsePvzCSV читает CSV с заголовком. Обязательна колонка city,
+// колонки id, registration_date и address можно не указывать.
+func parsePvzCSV(body io.Reader) ([]models.PvzImportRow, error) {
+	reader := csv.NewReader(body)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, errors.New("empty file")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	columns := map[string]int{}
+	for i, name := range header {
+		// Excel сохраняет UTF-8 с BOM в начале файла
+		name = strings.ToLower(strings.TrimSpace(strings.TrimPrefix(name, "\ufeff")))
+		if !slices.Contains(pvzImportColumns, name) {
+			return nil, fmt.Errorf("unknown column %q", name)
+		}
+		if _, ok := columns[name]; ok {
+			return nil, fmt.Errorf("duplicate column %q", name)
+		}
+		columns[name] = i
+	}
+	if _, ok := columns["city"]; !ok {
+		return nil, errors.New("column city is required")
+	}
+
+	field := func(record []string, name string) string {
+		if i, ok := columns[name]; ok {
+			return record[i]
+		}
+		return ""
+	}
+
+	rows := []models.PvzImportRow{}
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		line, _ := reader.FieldPos(0)
+		row := models.PvzImportRow{
+			Line:             line,
+			Id:               field(record, "id"),
+			City:             field(record, "city"),
+			RegistrationDate: field(record, "registration_date"),
+			Address:          field(record, "address"),
+			Latitude:         field(record, "latitude"),
+			Longitude:        field(record, "longitude"),
+		}
+		row.Sanitize()
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
 func (h *PvzHandler) GetPvz(w http.ResponseWriter, r *http.Request) {
 	loggerVar := logger.GetLoggerFromContext(r.Context()).With(slog.String("func", logger.GetFuncName()))
 
@@ -87,19 +230,39 @@ func (h *PvzHandler) GetPvz(w http.ResponseWriter, r *http.Request) {
 	endDateStr := r.URL.Query().Get("endDate")
 	pageStr := r.URL.Query().Get("page")
 	limitStr := r.URL.Query().Get("limit")
+	cursorStr := r.URL.Query().Get("cursor")
 
-	page := 1
-	limit := 10
+	filter := models.PvzFilter{
+		Page:            1,
+		Limit:           defaultPvzLimit,
+		IncludeArchived: r.URL.Query().Get("includeArchived") == "true",
+	}
 
 	if p, err := strconv.Atoi(pageStr); err == nil {
-		page = p
+		filter.Page = p
 	}
 	if l, err := strconv.Atoi(limitStr); err == nil {
-		limit = l
+		filter.Limit = l
 	}
 
-	var startDate, endDate *time.Time
-	var err error
+	if filter.Page < 1 {
+		send_err.SendError(w, "wrong page value", http.StatusBadRequest)
+		return
+	}
+	if filter.Limit < 1 || filter.Limit > maxPvzLimit {
+		send_err.SendError(w, "wrong limit value", http.StatusBadRequest)
+		return
+	}
+
+	if cursorStr != "" {
+		cursor, err := pagination.DecodeCursor(cursorStr)
+		if err != nil {
+			logger.LogHandlerError(loggerVar, err, http.StatusBadRequest)
+			send_err.SendError(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		filter.Cursor = &cursor
+	}
 
 	if startDateStr != "" {
 		t, err := time.Parse(time.RFC3339, startDateStr)
@@ -107,7 +270,7 @@ func (h *PvzHandler) GetPvz(w http.ResponseWriter, r *http.Request) {
 			send_err.SendError(w, "wrong startDate format", http.StatusBadRequest)
 			return
 		}
-		startDate = &t
+		filter.StartDate = &t
 	}
 	if endDateStr != "" {
 		t, err := time.Parse(time.RFC3339, endDateStr)
@@ -115,17 +278,18 @@ func (h *PvzHandler) GetPvz(w http.ResponseWriter, r *http.Request) {
 			send_err.SendError(w, "wrong endDate format", http.StatusBadRequest)
 			return
 		}
-		endDate = &t
+		filter.EndDate = &t
 	}
 
-	pvz, err := h.uc.GetPvz(r.Context(), startDate, endDate, page, limit)
+	page, err := h.uc.GetPvz(r.Context(), filter)
 	if err != nil {
 		logger.LogHandlerError(loggerVar, fmt.Errorf("error on a level below (usecase): %w", err), http.StatusInternalServerError)
 		send_err.SendError(w, err.Error(), http.StatusInternalServerError)
+		return
 	}
 
 	w.Header().Set("Content-Type", "application/json")
-	if err := json.NewEncoder(w).Encode(pvz); err != nil {
+	if err := json.NewEncoder(w).Encode(page); err != nil {
 		logger.LogHandlerError(loggerVar, fmt.Errorf("error while forming JSON: %w", err), http.StatusInternalServerError)
 		send_err.SendError(w, "error while forming JSON", http.StatusInternalServerError)
 	}
@@ -151,8 +315,9 @@ func (h *PvzHandler) CreateReception(w http.ResponseWriter, r *http.Request) {
 
 	reception, err := h.uc.CreateReception(r.Context(), req.PvzId)
 	if err != nil {
-		logger.LogHandlerError(loggerVar, fmt.Errorf("error on a level below (usecase): %w", err), http.StatusBadRequest)
-		send_err.SendError(w, err.Error(), http.StatusBadRequest)
+		statusCode := errStatus(err)
+		logger.LogHandlerError(loggerVar, fmt.Errorf("error on a level below (usecase): %w", err), statusCode)
+		send_err.SendError(w, err.Error(), statusCode)
 		return
 	}
 
@@ -183,16 +348,13 @@ func (h *PvzHandler) AddProduct(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if !validation.IsValidProductType(req.Type) {
-		logger.LogHandlerError(loggerVar, errors.New("wrong product type"), http.StatusBadRequest)
-		send_err.SendError(w, "wrong product type", http.StatusBadRequest)
-		return
-	}
+	req.Sanitize()
 
-	product, err := h.uc.AddProduct(r.Context(), req.PvzId, req.Type)
+	product, err := h.uc.AddProduct(r.Context(), req)
 	if err != nil {
-		logger.LogHandlerError(loggerVar, fmt.Errorf("error on a level below (usecase): %w", err), http.StatusBadRequest)
-		send_err.SendError(w, err.Error(), http.StatusBadRequest)
+		statusCode := errStatus(err)
+		logger.LogHandlerError(loggerVar, fmt.Errorf("error on a level below (usecase): %w", err), statusCode)
+		send_err.SendError(w, err.Error(), statusCode)
 		return
 	}
 
@@ -212,6 +374,54 @@ func (h *PvzHandler) AddProduct(w http.ResponseWriter, r *http.Request) {
 	logger.LogHandlerInfo(loggerVar, "Successful", http.StatusCreated)
 }
 
+func (h *PvzHandler) AddProducts(w http.ResponseWriter, r *http.Request) {
+	loggerVar := logger.GetLoggerFromContext(r.Context()).With(slog.String("func", logger.GetFuncName()))
+
+	var req models.AddProductsBatchReq
+
+	if err := easyjson.UnmarshalFromReader(r.Body, &req); err != nil {
+		logger.LogHandlerError(loggerVar, fmt.Errorf("error while parsing JSON: %w", err), http.StatusBadRequest)
+		send_err.SendError(w, "error while parsing JSON", http.StatusBadRequest)
+		return
+	}
+
+	req.Sanitize()
+
+	statusCode := http.StatusCreated
+	result, err := h.uc.AddProducts(r.Context(), req)
+	switch {
+	case errors.Is(err, pvz.ErrInvalidBatch):
+		// отдаём результат по каждой позиции, чтобы сканер знал, что исправить
+		statusCode = http.StatusBadRequest
+		logger.LogHandlerError(loggerVar, fmt.Errorf("error on a level below (usecase): %w", err), statusCode)
+	case err != nil:
+		statusCode = errStatus(err)
+		logger.LogHandlerError(loggerVar, fmt.Errorf("error on a level below (usecase): %w", err), statusCode)
+		send_err.SendError(w, err.Error(), statusCode)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	if err := json.NewEncoder(w).Encode(result); err != nil {
+		logger.LogHandlerError(loggerVar, fmt.Errorf("error while forming JSON: %w", err), http.StatusInternalServerError)
+		send_err.SendError(w, "error while forming JSON", http.StatusInternalServerError)
+		return
+	}
+	if statusCode != http.StatusCreated {
+		return
+	}
+
+	if h.mt != nil {
+		for range result.Created {
+			h.mt.IncreaseProductTotal()
+		}
+	} else {
+		logger.LogHandlerError(loggerVar, errors.New("metrics collector is nil"), http.StatusInternalServerError)
+	}
+	logger.LogHandlerInfo(loggerVar, "Successful", http.StatusCreated)
+}
+
 func (h *PvzHandler) DeleteProduct(w http.ResponseWriter, r *http.Request) {
 	loggerVar := logger.GetLoggerFromContext(r.Context()).With(slog.String("func", logger.GetFuncName()))
 
@@ -262,3 +472,362 @@ func (h *PvzHandler) CloseReception(w http.ResponseWriter, r *http.Request) {
 
 	logger.LogHandlerInfo(loggerVar, "Success", http.StatusOK)
 }
+
+func (h *PvzHandler) GetPvzByID(w http.ResponseWriter, r *http.Request) {
+	loggerVar := logger.GetLoggerFromContext(r.Context()).With(slog.String("func", logger.GetFuncName()))
+
+	pvzID, err := uuid.FromString(mux.Vars(r)["pvzId"])
+	if err != nil {
+		logger.LogHandlerError(loggerVar, err, http.StatusBadRequest)
+		send_err.SendError(w, "wrong UUID format for pvzId query parameter", http.StatusBadRequest)
+		return
+	}
+
+	pvzItem, err := h.uc.GetPvzByID(r.Context(), pvzID)
+	if err != nil {
+		statusCode := errStatus(err)
+		logger.LogHandlerError(loggerVar, fmt.Errorf("error on a level below (usecase): %w", err), statusCode)
+		send_err.SendError(w, err.Error(), statusCode)
+		return
+	}
+
+	w.Header().Set("ETag", pvzETag(pvzItem.Version))
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(pvzItem); err != nil {
+		logger.LogHandlerError(loggerVar, fmt.Errorf("error while forming JSON: %w", err), http.StatusInternalServerError)
+		send_err.SendError(w, "error while forming JSON", http.StatusInternalServerError)
+		return
+	}
+	logger.LogHandlerInfo(loggerVar, "Successful", http.StatusOK)
+}
+
+func (h *PvzHandler) GetReception(w http.ResponseWriter, r *http.Request) {
+	loggerVar := logger.GetLoggerFromContext(r.Context()).With(slog.String("func", logger.GetFuncName()))
+
+	receptionID, err := uuid.FromString(mux.Vars(r)["id"])
+	if err != nil {
+		logger.LogHandlerError(loggerVar, err, http.StatusBadRequest)
+		send_err.SendError(w, "wrong UUID format for id query parameter", http.StatusBadRequest)
+		return
+	}
+
+	reception, err := h.uc.GetReception(r.Context(), receptionID)
+	if err != nil {
+		statusCode := errStatus(err)
+		logger.LogHandlerError(loggerVar, fmt.Errorf("error on a level below (usecase): %w", err), statusCode)
+		send_err.SendError(w, err.Error(), statusCode)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(reception); err != nil {
+		logger.LogHandlerError(loggerVar, fmt.Errorf("error while forming JSON: %w", err), http.StatusInternalServerError)
+		send_err.SendError(w, "error while forming JSON", http.StatusInternalServerError)
+		return
+	}
+	logger.LogHandlerInfo(loggerVar, "Successful", http.StatusOK)
+}
+
+// GetReceptionAct отдаёт печатный акт закрытой приёмки
+func (h *PvzHandler) GetReceptionAct(w http.ResponseWriter, r *http.Request) {
+	loggerVar := logger.GetLoggerFromContext(r.Context()).With(slog.String("func", logger.GetFuncName()))
+
+	receptionID, err := uuid.FromString(mux.Vars(r)["id"])
+	if err != nil {
+		logger.LogHandlerError(loggerVar, err, http.StatusBadRequest)
+		send_err.SendError(w, "wrong UUID format for id query parameter", http.StatusBadRequest)
+		return
+	}
+
+	act, err := h.uc.GetReceptionAct(r.Context(), receptionID)
+	if err != nil {
+		statusCode := errStatus(err)
+		logger.LogHandlerError(loggerVar, fmt.Errorf("error on a level below (usecase): %w", err), statusCode)
+		send_err.SendError(w, err.Error(), statusCode)
+		return
+	}
+
+	var buf bytes.Buffer
+	if err := actpdf.Render(&buf, act); err != nil {
+		logger.LogHandlerError(loggerVar, fmt.Errorf("error while forming PDF: %w", err), http.StatusInternalServerError)
+		send_err.SendError(w, "error while forming PDF", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/pdf")
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`inline; filename="act-%s.pdf"`, receptionID))
+	w.Write(buf.Bytes())
+	logger.LogHandlerInfo(loggerVar, "Successful", http.StatusOK)
+}
+
+func (h *PvzHandler) DeleteProductByID(w http.ResponseWriter, r *http.Request) {
+	loggerVar := logger.GetLoggerFromContext(r.Context()).With(slog.String("func", logger.GetFuncName()))
+
+	productID, err := uuid.FromString(mux.Vars(r)["productId"])
+	if err != nil {
+		logger.LogHandlerError(loggerVar, err, http.StatusBadRequest)
+		send_err.SendError(w, "wrong UUID format for productId query parameter", http.StatusBadRequest)
+		return
+	}
+
+	var req models.DeleteProductReq
+	if err := easyjson.UnmarshalFromReader(r.Body, &req); err != nil {
+		logger.LogHandlerError(loggerVar, fmt.Errorf("error while parsing JSON: %w", err), http.StatusBadRequest)
+		send_err.SendError(w, "error while parsing JSON", http.StatusBadRequest)
+		return
+	}
+
+	deletion, err := h.uc.DeleteProductByID(r.Context(), productID, req.Reason)
+	if err != nil {
+		statusCode := errStatus(err)
+		logger.LogHandlerError(loggerVar, fmt.Errorf("error on a level below (usecase): %w", err), statusCode)
+		send_err.SendError(w, err.Error(), statusCode)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(deletion); err != nil {
+		logger.LogHandlerError(loggerVar, fmt.Errorf("error while forming JSON: %w", err), http.StatusInternalServerError)
+		send_err.SendError(w, "error while forming JSON", http.StatusInternalServerError)
+		return
+	}
+	logger.LogHandlerInfo(loggerVar, "Successful", http.StatusOK)
+}
+
+func (h *PvzHandler) RestoreProduct(w http.ResponseWriter, r *http.Request) {
+	loggerVar := logger.GetLoggerFromContext(r.Context()).With(slog.String("func", logger.GetFuncName()))
+
+	productID, err := uuid.FromString(mux.Vars(r)["productId"])
+	if err != nil {
+		logger.LogHandlerError(loggerVar, err, http.StatusBadRequest)
+		send_err.SendError(w, "wrong UUID format for productId query parameter", http.StatusBadRequest)
+		return
+	}
+
+	product, err := h.uc.RestoreProduct(r.Context(), productID)
+	if err != nil {
+		statusCode := errStatus(err)
+		logger.LogHandlerError(loggerVar, fmt.Errorf("error on a level below (usecase): %w", err), statusCode)
+		send_err.SendError(w, err.Error(), statusCode)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(product); err != nil {
+		logger.LogHandlerError(loggerVar, fmt.Errorf("error while forming JSON: %w", err), http.StatusInternalServerError)
+		send_err.SendError(w, "error while forming JSON", http.StatusInternalServerError)
+		return
+	}
+	logger.LogHandlerInfo(loggerVar, "Successful", http.StatusOK)
+}
+
+func (h *PvzHandler) FindProducts(w http.ResponseWriter, r *http.Request) {
+	loggerVar := logger.GetLoggerFromContext(r.Context()).With(slog.String("func", logger.GetFuncName()))
+
+	barcode := r.URL.Query().Get("barcode")
+	if barcode == "" {
+		logger.LogHandlerError(loggerVar, errors.New("barcode query parameter is required"), http.StatusBadRequest)
+		send_err.SendError(w, "barcode query parameter is required", http.StatusBadRequest)
+		return
+	}
+
+	products, err := h.uc.FindProductsByBarcode(r.Context(), barcode)
+	if err != nil {
+		statusCode := errStatus(err)
+		logger.LogHandlerError(loggerVar, fmt.Errorf("error on a level below (usecase): %w", err), statusCode)
+		send_err.SendError(w, err.Error(), statusCode)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(products); err != nil {
+		logger.LogHandlerError(loggerVar, fmt.Errorf("error while forming JSON: %w", err), http.StatusInternalServerError)
+		send_err.SendError(w, "error while forming JSON", http.StatusInternalServerError)
+		return
+	}
+	logger.LogHandlerInfo(loggerVar, "Successful", http.StatusOK)
+}
+
+func (h *PvzHandler) FindNearbyPvz(w http.ResponseWriter, r *http.Request) {
+	loggerVar := logger.GetLoggerFromContext(r.Context()).With(slog.String("func", logger.GetFuncName()))
+
+	query := r.URL.Query()
+	filter := models.NearbyPvzFilter{
+		Status:      query.Get("status"),
+		HasCapacity: query.Get("hasCapacity") == "true",
+	}
+
+	latitude, latErr := strconv.ParseFloat(query.Get("lat"), 64)
+	longitude, lonErr := strconv.ParseFloat(query.Get("lon"), 64)
+	if latErr != nil || lonErr != nil {
+		logger.LogHandlerError(loggerVar, errors.New("lat and lon query parameters are required"), http.StatusBadRequest)
+		send_err.SendError(w, "lat and lon query parameters are required", http.StatusBadRequest)
+		return
+	}
+	filter.Latitude, filter.Longitude = latitude, longitude
+
+	if radiusStr := query.Get("radius"); radiusStr != "" {
+		radius, err := strconv.ParseFloat(radiusStr, 64)
+		if err != nil {
+			logger.LogHandlerError(loggerVar, fmt.Errorf("invalid radius: %s", radiusStr), http.StatusBadRequest)
+			send_err.SendError(w, "invalid radius", http.StatusBadRequest)
+			return
+		}
+		filter.RadiusMeters = radius
+	}
+
+	if limitStr := query.Get("limit"); limitStr != "" {
+		limit, err := strconv.Atoi(limitStr)
+		if err != nil || limit <= 0 {
+			logger.LogHandlerError(loggerVar, fmt.Errorf("invalid limit: %s", limitStr), http.StatusBadRequest)
+			send_err.SendError(w, "invalid limit", http.StatusBadRequest)
+			return
+		}
+		filter.Limit = limit
+	}
+
+	nearby, err := h.uc.FindNearbyPvz(r.Context(), filter)
+	if err != nil {
+		statusCode := errStatus(err)
+		logger.LogHandlerError(loggerVar, fmt.Errorf("error on a level below (usecase): %w", err), statusCode)
+		send_err.SendError(w, err.Error(), statusCode)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(nearby); err != nil {
+		logger.LogHandlerError(loggerVar, fmt.Errorf("error while forming JSON: %w", err), http.StatusInternalServerError)
+		send_err.SendError(w, "error while forming JSON", http.StatusInternalServerError)
+		return
+	}
+	logger.LogHandlerInfo(loggerVar, "Successful", http.StatusOK)
+}
+
+func (h *PvzHandler) GetActiveReception(w http.ResponseWriter, r *http.Request) {
+	loggerVar := logger.GetLoggerFromContext(r.Context()).With(slog.String("func", logger.GetFuncName()))
+
+	pvzID, err := uuid.FromString(mux.Vars(r)["pvzId"])
+	if err != nil {
+		logger.LogHandlerError(loggerVar, err, http.StatusBadRequest)
+		send_err.SendError(w, "wrong UUID format for pvzId query parameter", http.StatusBadRequest)
+		return
+	}
+
+	reception, err := h.uc.GetActiveReception(r.Context(), pvzID)
+	if err != nil {
+		statusCode := errStatus(err)
+		logger.LogHandlerError(loggerVar, fmt.Errorf("error on a level below (usecase): %w", err), statusCode)
+		send_err.SendError(w, err.Error(), statusCode)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(reception); err != nil {
+		logger.LogHandlerError(loggerVar, fmt.Errorf("error while forming JSON: %w", err), http.StatusInternalServerError)
+		send_err.SendError(w, "error while forming JSON", http.StatusInternalServerError)
+		return
+	}
+	logger.LogHandlerInfo(loggerVar, "Successful", http.StatusOK)
+}
+
+func (h *PvzHandler) UpdatePvz(w http.ResponseWriter, r *http.Request) {
+	loggerVar := logger.GetLoggerFromContext(r.Context()).With(slog.String("func", logger.GetFuncName()))
+
+	pvzID, err := uuid.FromString(mux.Vars(r)["pvzId"])
+	if err != nil {
+		logger.LogHandlerError(loggerVar, err, http.StatusBadRequest)
+		send_err.SendError(w, "wrong UUID format for pvzId query parameter", http.StatusBadRequest)
+		return
+	}
+
+	var req models.UpdatePvzReq
+	if err := easyjson.UnmarshalFromReader(r.Body, &req); err != nil {
+		logger.LogHandlerError(loggerVar, fmt.Errorf("error while parsing JSON: %w", err), http.StatusBadRequest)
+		send_err.SendError(w, "error while parsing JSON", http.StatusBadRequest)
+		return
+	}
+	req.Sanitize()
+
+	if ifMatch := r.Header.Get("If-Match"); ifMatch != "" {
+		version, err := strconv.Atoi(strings.Trim(strings.TrimPrefix(ifMatch, "W/"), `"`))
+		if err != nil {
+			logger.LogHandlerError(loggerVar, fmt.Errorf("wrong If-Match header: %w", err), http.StatusBadRequest)
+			send_err.SendError(w, "wrong If-Match header", http.StatusBadRequest)
+			return
+		}
+		req.Version = &version
+	}
+	if req.Version == nil {
+		logger.LogHandlerError(loggerVar, errors.New("version not provided"), http.StatusPreconditionRequired)
+		send_err.SendError(w, "If-Match header or version is required", http.StatusPreconditionRequired)
+		return
+	}
+
+	if req.Status != nil && !validation.IsValidPvzStatus(*req.Status) {
+		logger.LogHandlerError(loggerVar, errors.New("invalid status"), http.StatusBadRequest)
+		send_err.SendError(w, "invalid status", http.StatusBadRequest)
+		return
+	}
+
+	pvzItem, err := h.uc.UpdatePvz(r.Context(), pvzID, req)
+	if err != nil {
+		statusCode := errStatus(err)
+		logger.LogHandlerError(loggerVar, fmt.Errorf("error on a level below (usecase): %w", err), statusCode)
+		send_err.SendError(w, err.Error(), statusCode)
+		return
+	}
+
+	w.Header().Set("ETag", pvzETag(pvzItem.Version))
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(pvzItem); err != nil {
+		logger.LogHandlerError(loggerVar, fmt.Errorf("error while forming JSON: %w", err), http.StatusInternalServerError)
+		send_err.SendError(w, "error while forming JSON", http.StatusInternalServerError)
+		return
+	}
+	logger.LogHandlerInfo(loggerVar, "Successful", http.StatusOK)
+}
+
+func pvzETag(version int) string {
+	return `"` + strconv.Itoa(version) + `"`
+}
+
+func errStatus(err error) int {
+	switch {
+	case errors.Is(err, pvz.ErrPvzNotFound),
+		errors.Is(err, pvz.ErrReceptionNotFound),
+		errors.Is(err, pvz.ErrNoActiveReception),
+		errors.Is(err, pvz.ErrProductNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, pvz.ErrInvalidCity),
+		errors.Is(err, pvz.ErrInvalidProductType),
+		errors.Is(err, pvz.ErrInvalidBarcode),
+		errors.Is(err, pvz.ErrInvalidMeasurements),
+		errors.Is(err, pvz.ErrEmptyBatch),
+		errors.Is(err, pvz.ErrBatchTooLarge),
+		errors.Is(err, pvz.ErrEmptyImport),
+		errors.Is(err, pvz.ErrImportTooLarge),
+		errors.Is(err, pvz.ErrInvalidCapacity),
+		errors.Is(err, pvz.ErrInvalidCoordinates),
+		errors.Is(err, pvz.ErrInvalidRadius),
+		errors.Is(err, pvz.ErrUnknownPvzStatus),
+		errors.Is(err, pvz.ErrInvalidDeleteReason):
+		return http.StatusBadRequest
+	case errors.Is(err, pvz.ErrPvzVersionConflict):
+		return http.StatusPreconditionFailed
+	case errors.Is(err, pvz.ErrPvzClosed),
+		errors.Is(err, pvz.ErrPvzNotActive),
+		errors.Is(err, pvz.ErrInvalidPvzStatus),
+		errors.Is(err, pvz.ErrActiveReceptionExists),
+		errors.Is(err, pvz.ErrReceptionClosed),
+		errors.Is(err, pvz.ErrProductNotDeleted),
+		errors.Is(err, pvz.ErrReceptionNotClosed),
+		errors.Is(err, pvz.ErrPvzExists),
+		errors.Is(err, pvz.ErrPvzFull),
+		errors.Is(err, schedule.ErrOutsideWorkingHours):
+		return http.StatusConflict
+	case errors.Is(err, pvz.ErrUndoWindowExpired):
+		return http.StatusGone
+	default:
+		return http.StatusInternalServerError
+	}
+}
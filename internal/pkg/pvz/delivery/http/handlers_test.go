@@ -0,0 +1,150 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/K1tten2005/avito_pvz/internal/models"
+	"github.com/K1tten2005/avito_pvz/internal/pkg/pvz"
+	"github.com/K1tten2005/avito_pvz/internal/pkg/pvz/mocks"
+	"github.com/K1tten2005/avito_pvz/internal/pkg/schedule"
+	"github.com/golang/mock/gomock"
+	"github.com/satori/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePvzCSV(t *testing.T) {
+	tests := []struct {
+		name         string
+		body         string
+		expectedRows []models.PvzImportRow
+		expectedErr  string
+	}{
+		{
+			name: "columns in any order with BOM",
+			body: "\ufeffAddress,city,id\n" +
+				"\"ул. Ленина, 5\",Москва,\n" +
+				"\n" +
+				"<b>Невский</b>,Санкт-Петербург,0b6c6b52-8f9c-4a7e-9a3e-3f1d2b7f1c11\n",
+			expectedRows: []models.PvzImportRow{
+				{Line: 2, City: "Москва", Address: "ул. Ленина, 5"},
+				{Line: 4, Id: "0b6c6b52-8f9c-4a7e-9a3e-3f1d2b7f1c11", City: "Санкт-Петербург", Address: "&lt;b&gt;Невский&lt;/b&gt;"},
+			},
+		},
+		{
+			name: "coordinates",
+			body: "city,latitude,longitude\nМосква,55.7577,37.6138\n",
+			expectedRows: []models.PvzImportRow{
+				{Line: 2, City: "Москва", Latitude: "55.7577", Longitude: "37.6138"},
+			},
+		},
+		{
+			name:         "header only",
+			body:         "city,registration_date\n",
+			expectedRows: []models.PvzImportRow{},
+		},
+		{
+			name:        "empty file",
+			body:        "",
+			expectedErr: "empty file",
+		},
+		{
+			name:        "city column missing",
+			body:        "id,address\n,ул. Ленина\n",
+			expectedErr: "column city is required",
+		},
+		{
+			name:        "unknown column",
+			body:        "city,region\nМосква,ЦФО\n",
+			expectedErr: `unknown column "region"`,
+		},
+		{
+			name:        "wrong number of fields",
+			body:        "city,address\nМосква\n",
+			expectedErr: "wrong number of fields",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rows, err := parsePvzCSV(strings.NewReader(tt.body))
+			if tt.expectedErr != "" {
+				assert.ErrorContains(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedRows, rows)
+		})
+	}
+}
+
+func TestAddProduct_ErrorStatus(t *testing.T) {
+	tests := []struct {
+		name           string
+		ucErr          error
+		expectedStatus int
+	}{
+		{"pvz full", pvz.ErrPvzFull, http.StatusConflict},
+		{"outside working hours", schedule.ErrOutsideWorkingHours, http.StatusConflict},
+		{"pvz not active", pvz.ErrPvzNotActive, http.StatusConflict},
+		{"invalid barcode", pvz.ErrInvalidBarcode, http.StatusBadRequest},
+		{"no active reception", pvz.ErrNoActiveReception, http.StatusNotFound},
+		{"db failure", errors.New("connection reset"), http.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			uc := mocks.NewMockPvzUsecase(ctrl)
+			uc.EXPECT().AddProduct(gomock.Any(), gomock.Any()).Return(nil, tt.ucErr)
+
+			body := `{"pvzId":"` + uuid.NewV4().String() + `","type":"обувь"}`
+			req := httptest.NewRequest(http.MethodPost, "/products", strings.NewReader(body))
+			rr := httptest.NewRecorder()
+
+			CreatePvzHandler(uc, nil).AddProduct(rr, req)
+
+			assert.Equal(t, tt.expectedStatus, rr.Code)
+			assert.Contains(t, rr.Body.String(), tt.ucErr.Error())
+		})
+	}
+}
+
+func TestCreateReception_ErrorStatus(t *testing.T) {
+	tests := []struct {
+		name           string
+		ucErr          error
+		expectedStatus int
+	}{
+		{"outside working hours", schedule.ErrOutsideWorkingHours, http.StatusConflict},
+		{"pvz not active", pvz.ErrPvzNotActive, http.StatusConflict},
+		{"active reception exists", pvz.ErrActiveReceptionExists, http.StatusConflict},
+		{"pvz not found", pvz.ErrPvzNotFound, http.StatusNotFound},
+		{"db failure", errors.New("connection reset"), http.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			uc := mocks.NewMockPvzUsecase(ctrl)
+			uc.EXPECT().CreateReception(gomock.Any(), gomock.Any()).Return(models.Reception{}, tt.ucErr)
+
+			body := `{"pvzId":"` + uuid.NewV4().String() + `"}`
+			req := httptest.NewRequest(http.MethodPost, "/receptions", strings.NewReader(body))
+			rr := httptest.NewRecorder()
+
+			CreatePvzHandler(uc, nil).CreateReception(rr, req)
+
+			assert.Equal(t, tt.expectedStatus, rr.Code)
+			assert.Contains(t, rr.Body.String(), tt.ucErr.Error())
+		})
+	}
+}
@@ -13,28 +13,106 @@ var (
 	ErrActiveReceptionExists = errors.New("active reception already exsists")
 	ErrNoActiveReception     = errors.New("no active reception")
 	ErrNoProductsInReception = errors.New("no products in reception")
+	ErrPvzNotFound           = errors.New("pvz not found")
+	ErrReceptionNotFound     = errors.New("reception not found")
+	ErrPvzVersionConflict    = errors.New("pvz was modified by another request")
+	ErrPvzNotActive          = errors.New("pvz is not active")
+	ErrPvzClosed             = errors.New("pvz is closed")
+	ErrInvalidPvzStatus      = errors.New("invalid pvz status transition")
+	ErrInvalidCity           = errors.New("invalid city")
+	ErrInvalidProductType    = errors.New("wrong product type")
+	ErrInvalidBarcode        = errors.New("invalid barcode")
+	ErrInvalidMeasurements   = errors.New("weight and dimensions must be positive")
+	ErrEmptyBatch            = errors.New("batch has no items")
+	ErrBatchTooLarge         = errors.New("batch has too many items")
+	ErrInvalidBatch          = errors.New("batch has invalid items")
+	ErrProductNotFound       = errors.New("product not found")
+	ErrInvalidDeleteReason   = errors.New("invalid delete reason")
+	ErrReceptionClosed       = errors.New("reception is closed")
+	ErrProductNotDeleted     = errors.New("product is not deleted")
+	ErrUndoWindowExpired     = errors.New("undo window expired")
+	ErrReceptionNotClosed    = errors.New("reception is not closed")
+	ErrPvzExists             = errors.New("pvz already exists")
+	ErrInvalidPvzID          = errors.New("invalid pvz id")
+	ErrInvalidRegDate        = errors.New("invalid registration date")
+	ErrDuplicatePvzID        = errors.New("duplicate pvz id in import")
+	ErrEmptyImport           = errors.New("import has no rows")
+	ErrImportTooLarge        = errors.New("import has too many rows")
+	ErrInvalidImport         = errors.New("import has invalid rows")
+	ErrInvalidCapacity       = errors.New("capacity limits must be positive")
+	ErrPvzFull               = errors.New("pvz capacity exceeded")
+	ErrInvalidCoordinates    = errors.New("invalid coordinates")
+	ErrInvalidRadius         = errors.New("invalid search radius")
+	ErrUnknownPvzStatus      = errors.New("unknown pvz status")
 )
 
 type PvzRepo interface {
+	// WithinTx выполняет fn в одной транзакции: все вызовы репозитория с полученным ctx попадают в неё
+	WithinTx(ctx context.Context, fn func(ctx context.Context) error) error
+	// LockPvz блокирует строку ПВЗ до конца транзакции, сериализуя операции над его приёмками
+	LockPvz(ctx context.Context, id uuid.UUID) error
 	InsertPvz(ctx context.Context, pvz models.PVZ) error
+	InsertPvzs(ctx context.Context, pvzList []models.PVZ) error
+	GetExistingPvzIDs(ctx context.Context, ids []uuid.UUID) ([]uuid.UUID, error)
 	InsertReception(ctx context.Context, reception models.Reception) error
 	InsertProduct(ctx context.Context, product models.Product) error
-	GetPvz(ctx context.Context, startDate, endDate *time.Time, page, limit int) ([]models.PVZ, error)
+	GetPvz(ctx context.Context, filter models.PvzFilter) ([]models.PVZ, error)
+	CountPvz(ctx context.Context, includeArchived bool) (int, error)
+	GetReceptionsByPvzIDs(ctx context.Context, pvzIDs []uuid.UUID, startDate, endDate *time.Time) ([]models.Reception, error)
 	GetActiveReception(ctx context.Context, pvzId uuid.UUID) (models.Reception, error)
+	GetPvzByID(ctx context.Context, id uuid.UUID) (models.PVZ, error)
+	UpdatePvz(ctx context.Context, pvz models.PVZ, version int) (models.PVZ, error)
+	// GetPvzOccupancy считает товары на полке ПВЗ, включая открытую приёмку; Ratio не заполняется
+	GetPvzOccupancy(ctx context.Context, pvzID uuid.UUID) (models.PvzUtilization, error)
+	// GetPvzUtilizations возвращает незакрытые ПВЗ с заданной вместимостью и их занятость
+	GetPvzUtilizations(ctx context.Context) ([]models.PVZ, error)
+	// FindNearbyPvz возвращает ПВЗ в радиусе от точки, ближайшие первыми
+	FindNearbyPvz(ctx context.Context, filter models.NearbyPvzFilter) ([]models.NearbyPvz, error)
 	GetReceptionByID(ctx context.Context, id uuid.UUID) (models.Reception, error)
-	UpdateReceptionStatus(ctx context.Context, id uuid.UUID, status string) error
+	GetProductsByReceptionID(ctx context.Context, receptionID uuid.UUID) ([]models.Product, error)
+	// UpdateReceptionStatus меняет статус приёмки и возвращает время закрытия, если она закрыта
+	UpdateReceptionStatus(ctx context.Context, id uuid.UUID, status string, closedBy *uuid.UUID) (*time.Time, error)
 	HasActiveReception(ctx context.Context, pvzID uuid.UUID) (bool, error)
 	CreateReception(ctx context.Context, reception models.Reception) error
 	AddProduct(ctx context.Context, product *models.Product) error 
 	GetLastProduct(ctx context.Context, pvzID uuid.UUID) (models.Product, error)
 	DeleteProduct(ctx context.Context, productId uuid.UUID) error
+	HasBarcodeInReception(ctx context.Context, receptionID uuid.UUID, barcode string) (bool, error)
+	GetProductsByBarcode(ctx context.Context, barcode string, limit int) ([]models.ProductLocation, error)
+	AddProducts(ctx context.Context, products []models.Product) error
+	GetScannedBarcodes(ctx context.Context, receptionID uuid.UUID, barcodes []string) ([]string, error)
+	GetProductByID(ctx context.Context, id uuid.UUID) (models.Product, error)
+	// GetProductPvzID возвращает ПВЗ приёмки, в которую принят товар
+	GetProductPvzID(ctx context.Context, id uuid.UUID) (uuid.UUID, error)
+	// GetUserEmail возвращает email пользователя или пустую строку, если его нет
+	GetUserEmail(ctx context.Context, id uuid.UUID) (string, error)
+	SoftDeleteProduct(ctx context.Context, id uuid.UUID, reason string) (time.Time, error)
+	RestoreProduct(ctx context.Context, id uuid.UUID, deletedAfter time.Time) error
+}
+
+// ScheduleChecker проверяет, работает ли ПВЗ по графику в момент at
+type ScheduleChecker interface {
+	CheckOpen(ctx context.Context, pvzID uuid.UUID, at time.Time) error
 }
 
 type PvzUsecase interface {
-	CreatePvz(ctx context.Context, pvz models.PVZ) error
-	GetPvz(ctx context.Context, startDate, endDate *time.Time, page, limit int) ([]models.PVZ, error)
+	CreatePvz(ctx context.Context, pvz models.PVZ) (models.PVZ, error)
+	// ImportPvz проверяет все строки и создаёт ПВЗ одной транзакцией; при dryRun только проверяет
+	ImportPvz(ctx context.Context, rows []models.PvzImportRow, dryRun bool) (models.PvzImportReport, error)
+	GetPvz(ctx context.Context, filter models.PvzFilter) (models.PvzPage, error)
+	GetPvzByID(ctx context.Context, id uuid.UUID) (models.PVZ, error)
+	FindNearbyPvz(ctx context.Context, filter models.NearbyPvzFilter) ([]models.NearbyPvz, error)
+	UpdatePvz(ctx context.Context, id uuid.UUID, req models.UpdatePvzReq) (models.PVZ, error)
+	GetReception(ctx context.Context, id uuid.UUID) (models.Reception, error)
+	// GetReceptionAct собирает данные акта приёмки; печатать можно только закрытую приёмку
+	GetReceptionAct(ctx context.Context, id uuid.UUID) (models.ReceptionAct, error)
+	GetActiveReception(ctx context.Context, pvzID uuid.UUID) (models.Reception, error)
 	CloseReception(ctx context.Context, receptionID uuid.UUID) (models.Reception, error)
 	CreateReception(ctx context.Context, PvzId uuid.UUID) (models.Reception, error)
-	AddProduct(ctx context.Context, pvzID uuid.UUID, productType string) (*models.Product, error)
+	AddProduct(ctx context.Context, req models.AddProductReq) (*models.Product, error)
+	AddProducts(ctx context.Context, req models.AddProductsBatchReq) (models.ProductBatchResult, error)
 	DeleteProduct(ctx context.Context, pvzID uuid.UUID) error
+	DeleteProductByID(ctx context.Context, id uuid.UUID, reason string) (models.ProductDeletion, error)
+	RestoreProduct(ctx context.Context, id uuid.UUID) (models.Product, error)
+	FindProductsByBarcode(ctx context.Context, barcode string) ([]models.ProductLocation, error)
 }
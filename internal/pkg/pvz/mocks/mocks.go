@@ -51,6 +51,35 @@ func (mr *MockPvzRepoMockRecorder) AddProduct(ctx, product interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddProduct", reflect.TypeOf((*MockPvzRepo)(nil).AddProduct), ctx, product)
 }
 
+// AddProducts mocks base method.
+func (m *MockPvzRepo) AddProducts(ctx context.Context, products []models.Product) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddProducts", ctx, products)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddProducts indicates an expected call of AddProducts.
+func (mr *MockPvzRepoMockRecorder) AddProducts(ctx, products interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddProducts", reflect.TypeOf((*MockPvzRepo)(nil).AddProducts), ctx, products)
+}
+
+// CountPvz mocks base method.
+func (m *MockPvzRepo) CountPvz(ctx context.Context, includeArchived bool) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountPvz", ctx, includeArchived)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountPvz indicates an expected call of CountPvz.
+func (mr *MockPvzRepoMockRecorder) CountPvz(ctx, includeArchived interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountPvz", reflect.TypeOf((*MockPvzRepo)(nil).CountPvz), ctx, includeArchived)
+}
+
 // CreateReception mocks base method.
 func (m *MockPvzRepo) CreateReception(ctx context.Context, reception models.Reception) error {
 	m.ctrl.T.Helper()
@@ -79,6 +108,21 @@ func (mr *MockPvzRepoMockRecorder) DeleteProduct(ctx, productId interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProduct", reflect.TypeOf((*MockPvzRepo)(nil).DeleteProduct), ctx, productId)
 }
 
+// FindNearbyPvz mocks base method.
+func (m *MockPvzRepo) FindNearbyPvz(ctx context.Context, filter models.NearbyPvzFilter) ([]models.NearbyPvz, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindNearbyPvz", ctx, filter)
+	ret0, _ := ret[0].([]models.NearbyPvz)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindNearbyPvz indicates an expected call of FindNearbyPvz.
+func (mr *MockPvzRepoMockRecorder) FindNearbyPvz(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindNearbyPvz", reflect.TypeOf((*MockPvzRepo)(nil).FindNearbyPvz), ctx, filter)
+}
+
 // GetActiveReception mocks base method.
 func (m *MockPvzRepo) GetActiveReception(ctx context.Context, pvzId uuid.UUID) (models.Reception, error) {
 	m.ctrl.T.Helper()
@@ -94,6 +138,21 @@ func (mr *MockPvzRepoMockRecorder) GetActiveReception(ctx, pvzId interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActiveReception", reflect.TypeOf((*MockPvzRepo)(nil).GetActiveReception), ctx, pvzId)
 }
 
+// GetExistingPvzIDs mocks base method.
+func (m *MockPvzRepo) GetExistingPvzIDs(ctx context.Context, ids []uuid.UUID) ([]uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetExistingPvzIDs", ctx, ids)
+	ret0, _ := ret[0].([]uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetExistingPvzIDs indicates an expected call of GetExistingPvzIDs.
+func (mr *MockPvzRepoMockRecorder) GetExistingPvzIDs(ctx, ids interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExistingPvzIDs", reflect.TypeOf((*MockPvzRepo)(nil).GetExistingPvzIDs), ctx, ids)
+}
+
 // GetLastProduct mocks base method.
 func (m *MockPvzRepo) GetLastProduct(ctx context.Context, pvzID uuid.UUID) (models.Product, error) {
 	m.ctrl.T.Helper()
@@ -109,19 +168,124 @@ func (mr *MockPvzRepoMockRecorder) GetLastProduct(ctx, pvzID interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLastProduct", reflect.TypeOf((*MockPvzRepo)(nil).GetLastProduct), ctx, pvzID)
 }
 
+// GetProductByID mocks base method.
+func (m *MockPvzRepo) GetProductByID(ctx context.Context, id uuid.UUID) (models.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProductByID", ctx, id)
+	ret0, _ := ret[0].(models.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProductByID indicates an expected call of GetProductByID.
+func (mr *MockPvzRepoMockRecorder) GetProductByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProductByID", reflect.TypeOf((*MockPvzRepo)(nil).GetProductByID), ctx, id)
+}
+
+// GetProductPvzID mocks base method.
+func (m *MockPvzRepo) GetProductPvzID(ctx context.Context, id uuid.UUID) (uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProductPvzID", ctx, id)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProductPvzID indicates an expected call of GetProductPvzID.
+func (mr *MockPvzRepoMockRecorder) GetProductPvzID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProductPvzID", reflect.TypeOf((*MockPvzRepo)(nil).GetProductPvzID), ctx, id)
+}
+
+// GetProductsByBarcode mocks base method.
+func (m *MockPvzRepo) GetProductsByBarcode(ctx context.Context, barcode string, limit int) ([]models.ProductLocation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProductsByBarcode", ctx, barcode, limit)
+	ret0, _ := ret[0].([]models.ProductLocation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProductsByBarcode indicates an expected call of GetProductsByBarcode.
+func (mr *MockPvzRepoMockRecorder) GetProductsByBarcode(ctx, barcode, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProductsByBarcode", reflect.TypeOf((*MockPvzRepo)(nil).GetProductsByBarcode), ctx, barcode, limit)
+}
+
+// GetProductsByReceptionID mocks base method.
+func (m *MockPvzRepo) GetProductsByReceptionID(ctx context.Context, receptionID uuid.UUID) ([]models.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProductsByReceptionID", ctx, receptionID)
+	ret0, _ := ret[0].([]models.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProductsByReceptionID indicates an expected call of GetProductsByReceptionID.
+func (mr *MockPvzRepoMockRecorder) GetProductsByReceptionID(ctx, receptionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProductsByReceptionID", reflect.TypeOf((*MockPvzRepo)(nil).GetProductsByReceptionID), ctx, receptionID)
+}
+
 // GetPvz mocks base method.
-func (m *MockPvzRepo) GetPvz(ctx context.Context, startDate, endDate *time.Time, page, limit int) ([]models.PVZ, error) {
+func (m *MockPvzRepo) GetPvz(ctx context.Context, filter models.PvzFilter) ([]models.PVZ, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetPvz", ctx, startDate, endDate, page, limit)
+	ret := m.ctrl.Call(m, "GetPvz", ctx, filter)
 	ret0, _ := ret[0].([]models.PVZ)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetPvz indicates an expected call of GetPvz.
-func (mr *MockPvzRepoMockRecorder) GetPvz(ctx, startDate, endDate, page, limit interface{}) *gomock.Call {
+func (mr *MockPvzRepoMockRecorder) GetPvz(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPvz", reflect.TypeOf((*MockPvzRepo)(nil).GetPvz), ctx, filter)
+}
+
+// GetPvzByID mocks base method.
+func (m *MockPvzRepo) GetPvzByID(ctx context.Context, id uuid.UUID) (models.PVZ, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPvzByID", ctx, id)
+	ret0, _ := ret[0].(models.PVZ)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPvzByID indicates an expected call of GetPvzByID.
+func (mr *MockPvzRepoMockRecorder) GetPvzByID(ctx, id interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPvz", reflect.TypeOf((*MockPvzRepo)(nil).GetPvz), ctx, startDate, endDate, page, limit)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPvzByID", reflect.TypeOf((*MockPvzRepo)(nil).GetPvzByID), ctx, id)
+}
+
+// GetPvzOccupancy mocks base method.
+func (m *MockPvzRepo) GetPvzOccupancy(ctx context.Context, pvzID uuid.UUID) (models.PvzUtilization, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPvzOccupancy", ctx, pvzID)
+	ret0, _ := ret[0].(models.PvzUtilization)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPvzOccupancy indicates an expected call of GetPvzOccupancy.
+func (mr *MockPvzRepoMockRecorder) GetPvzOccupancy(ctx, pvzID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPvzOccupancy", reflect.TypeOf((*MockPvzRepo)(nil).GetPvzOccupancy), ctx, pvzID)
+}
+
+// GetPvzUtilizations mocks base method.
+func (m *MockPvzRepo) GetPvzUtilizations(ctx context.Context) ([]models.PVZ, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPvzUtilizations", ctx)
+	ret0, _ := ret[0].([]models.PVZ)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPvzUtilizations indicates an expected call of GetPvzUtilizations.
+func (mr *MockPvzRepoMockRecorder) GetPvzUtilizations(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPvzUtilizations", reflect.TypeOf((*MockPvzRepo)(nil).GetPvzUtilizations), ctx)
 }
 
 // GetReceptionByID mocks base method.
@@ -139,6 +303,51 @@ func (mr *MockPvzRepoMockRecorder) GetReceptionByID(ctx, id interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReceptionByID", reflect.TypeOf((*MockPvzRepo)(nil).GetReceptionByID), ctx, id)
 }
 
+// GetReceptionsByPvzIDs mocks base method.
+func (m *MockPvzRepo) GetReceptionsByPvzIDs(ctx context.Context, pvzIDs []uuid.UUID, startDate, endDate *time.Time) ([]models.Reception, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReceptionsByPvzIDs", ctx, pvzIDs, startDate, endDate)
+	ret0, _ := ret[0].([]models.Reception)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReceptionsByPvzIDs indicates an expected call of GetReceptionsByPvzIDs.
+func (mr *MockPvzRepoMockRecorder) GetReceptionsByPvzIDs(ctx, pvzIDs, startDate, endDate interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReceptionsByPvzIDs", reflect.TypeOf((*MockPvzRepo)(nil).GetReceptionsByPvzIDs), ctx, pvzIDs, startDate, endDate)
+}
+
+// GetScannedBarcodes mocks base method.
+func (m *MockPvzRepo) GetScannedBarcodes(ctx context.Context, receptionID uuid.UUID, barcodes []string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetScannedBarcodes", ctx, receptionID, barcodes)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetScannedBarcodes indicates an expected call of GetScannedBarcodes.
+func (mr *MockPvzRepoMockRecorder) GetScannedBarcodes(ctx, receptionID, barcodes interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetScannedBarcodes", reflect.TypeOf((*MockPvzRepo)(nil).GetScannedBarcodes), ctx, receptionID, barcodes)
+}
+
+// GetUserEmail mocks base method.
+func (m *MockPvzRepo) GetUserEmail(ctx context.Context, id uuid.UUID) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserEmail", ctx, id)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserEmail indicates an expected call of GetUserEmail.
+func (mr *MockPvzRepoMockRecorder) GetUserEmail(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserEmail", reflect.TypeOf((*MockPvzRepo)(nil).GetUserEmail), ctx, id)
+}
+
 // HasActiveReception mocks base method.
 func (m *MockPvzRepo) HasActiveReception(ctx context.Context, pvzID uuid.UUID) (bool, error) {
 	m.ctrl.T.Helper()
@@ -154,6 +363,21 @@ func (mr *MockPvzRepoMockRecorder) HasActiveReception(ctx, pvzID interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasActiveReception", reflect.TypeOf((*MockPvzRepo)(nil).HasActiveReception), ctx, pvzID)
 }
 
+// HasBarcodeInReception mocks base method.
+func (m *MockPvzRepo) HasBarcodeInReception(ctx context.Context, receptionID uuid.UUID, barcode string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HasBarcodeInReception", ctx, receptionID, barcode)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HasBarcodeInReception indicates an expected call of HasBarcodeInReception.
+func (mr *MockPvzRepoMockRecorder) HasBarcodeInReception(ctx, receptionID, barcode interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasBarcodeInReception", reflect.TypeOf((*MockPvzRepo)(nil).HasBarcodeInReception), ctx, receptionID, barcode)
+}
+
 // InsertProduct mocks base method.
 func (m *MockPvzRepo) InsertProduct(ctx context.Context, product models.Product) error {
 	m.ctrl.T.Helper()
@@ -182,6 +406,20 @@ func (mr *MockPvzRepoMockRecorder) InsertPvz(ctx, pvz interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertPvz", reflect.TypeOf((*MockPvzRepo)(nil).InsertPvz), ctx, pvz)
 }
 
+// InsertPvzs mocks base method.
+func (m *MockPvzRepo) InsertPvzs(ctx context.Context, pvzList []models.PVZ) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertPvzs", ctx, pvzList)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InsertPvzs indicates an expected call of InsertPvzs.
+func (mr *MockPvzRepoMockRecorder) InsertPvzs(ctx, pvzList interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertPvzs", reflect.TypeOf((*MockPvzRepo)(nil).InsertPvzs), ctx, pvzList)
+}
+
 // InsertReception mocks base method.
 func (m *MockPvzRepo) InsertReception(ctx context.Context, reception models.Reception) error {
 	m.ctrl.T.Helper()
@@ -196,18 +434,128 @@ func (mr *MockPvzRepoMockRecorder) InsertReception(ctx, reception interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertReception", reflect.TypeOf((*MockPvzRepo)(nil).InsertReception), ctx, reception)
 }
 
-// UpdateReceptionStatus mocks base method.
-func (m *MockPvzRepo) UpdateReceptionStatus(ctx context.Context, id uuid.UUID, status string) error {
+// LockPvz mocks base method.
+func (m *MockPvzRepo) LockPvz(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockPvz", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LockPvz indicates an expected call of LockPvz.
+func (mr *MockPvzRepoMockRecorder) LockPvz(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockPvz", reflect.TypeOf((*MockPvzRepo)(nil).LockPvz), ctx, id)
+}
+
+// RestoreProduct mocks base method.
+func (m *MockPvzRepo) RestoreProduct(ctx context.Context, id uuid.UUID, deletedAfter time.Time) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateReceptionStatus", ctx, id, status)
+	ret := m.ctrl.Call(m, "RestoreProduct", ctx, id, deletedAfter)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
+// RestoreProduct indicates an expected call of RestoreProduct.
+func (mr *MockPvzRepoMockRecorder) RestoreProduct(ctx, id, deletedAfter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreProduct", reflect.TypeOf((*MockPvzRepo)(nil).RestoreProduct), ctx, id, deletedAfter)
+}
+
+// SoftDeleteProduct mocks base method.
+func (m *MockPvzRepo) SoftDeleteProduct(ctx context.Context, id uuid.UUID, reason string) (time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SoftDeleteProduct", ctx, id, reason)
+	ret0, _ := ret[0].(time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SoftDeleteProduct indicates an expected call of SoftDeleteProduct.
+func (mr *MockPvzRepoMockRecorder) SoftDeleteProduct(ctx, id, reason interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SoftDeleteProduct", reflect.TypeOf((*MockPvzRepo)(nil).SoftDeleteProduct), ctx, id, reason)
+}
+
+// UpdatePvz mocks base method.
+func (m *MockPvzRepo) UpdatePvz(ctx context.Context, pvz models.PVZ, version int) (models.PVZ, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePvz", ctx, pvz, version)
+	ret0, _ := ret[0].(models.PVZ)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdatePvz indicates an expected call of UpdatePvz.
+func (mr *MockPvzRepoMockRecorder) UpdatePvz(ctx, pvz, version interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePvz", reflect.TypeOf((*MockPvzRepo)(nil).UpdatePvz), ctx, pvz, version)
+}
+
+// UpdateReceptionStatus mocks base method.
+func (m *MockPvzRepo) UpdateReceptionStatus(ctx context.Context, id uuid.UUID, status string, closedBy *uuid.UUID) (*time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateReceptionStatus", ctx, id, status, closedBy)
+	ret0, _ := ret[0].(*time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
 // UpdateReceptionStatus indicates an expected call of UpdateReceptionStatus.
-func (mr *MockPvzRepoMockRecorder) UpdateReceptionStatus(ctx, id, status interface{}) *gomock.Call {
+func (mr *MockPvzRepoMockRecorder) UpdateReceptionStatus(ctx, id, status, closedBy interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateReceptionStatus", reflect.TypeOf((*MockPvzRepo)(nil).UpdateReceptionStatus), ctx, id, status)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateReceptionStatus", reflect.TypeOf((*MockPvzRepo)(nil).UpdateReceptionStatus), ctx, id, status, closedBy)
+}
+
+// WithinTx mocks base method.
+func (m *MockPvzRepo) WithinTx(ctx context.Context, fn func(context.Context) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WithinTx", ctx, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WithinTx indicates an expected call of WithinTx.
+func (mr *MockPvzRepoMockRecorder) WithinTx(ctx, fn interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithinTx", reflect.TypeOf((*MockPvzRepo)(nil).WithinTx), ctx, fn)
+}
+
+// MockScheduleChecker is a mock of ScheduleChecker interface.
+type MockScheduleChecker struct {
+	ctrl     *gomock.Controller
+	recorder *MockScheduleCheckerMockRecorder
+}
+
+// MockScheduleCheckerMockRecorder is the mock recorder for MockScheduleChecker.
+type MockScheduleCheckerMockRecorder struct {
+	mock *MockScheduleChecker
+}
+
+// NewMockScheduleChecker creates a new mock instance.
+func NewMockScheduleChecker(ctrl *gomock.Controller) *MockScheduleChecker {
+	mock := &MockScheduleChecker{ctrl: ctrl}
+	mock.recorder = &MockScheduleCheckerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockScheduleChecker) EXPECT() *MockScheduleCheckerMockRecorder {
+	return m.recorder
+}
+
+// CheckOpen mocks base method.
+func (m *MockScheduleChecker) CheckOpen(ctx context.Context, pvzID uuid.UUID, at time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckOpen", ctx, pvzID, at)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CheckOpen indicates an expected call of CheckOpen.
+func (mr *MockScheduleCheckerMockRecorder) CheckOpen(ctx, pvzID, at interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckOpen", reflect.TypeOf((*MockScheduleChecker)(nil).CheckOpen), ctx, pvzID, at)
 }
 
 // MockPvzUsecase is a mock of PvzUsecase interface.
@@ -234,18 +582,33 @@ func (m *MockPvzUsecase) EXPECT() *MockPvzUsecaseMockRecorder {
 }
 
 // AddProduct mocks base method.
-func (m *MockPvzUsecase) AddProduct(ctx context.Context, pvzID uuid.UUID, productType string) (*models.Product, error) {
+func (m *MockPvzUsecase) AddProduct(ctx context.Context, req models.AddProductReq) (*models.Product, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddProduct", ctx, pvzID, productType)
+	ret := m.ctrl.Call(m, "AddProduct", ctx, req)
 	ret0, _ := ret[0].(*models.Product)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // AddProduct indicates an expected call of AddProduct.
-func (mr *MockPvzUsecaseMockRecorder) AddProduct(ctx, pvzID, productType interface{}) *gomock.Call {
+func (mr *MockPvzUsecaseMockRecorder) AddProduct(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddProduct", reflect.TypeOf((*MockPvzUsecase)(nil).AddProduct), ctx, req)
+}
+
+// AddProducts mocks base method.
+func (m *MockPvzUsecase) AddProducts(ctx context.Context, req models.AddProductsBatchReq) (models.ProductBatchResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddProducts", ctx, req)
+	ret0, _ := ret[0].(models.ProductBatchResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddProducts indicates an expected call of AddProducts.
+func (mr *MockPvzUsecaseMockRecorder) AddProducts(ctx, req interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddProduct", reflect.TypeOf((*MockPvzUsecase)(nil).AddProduct), ctx, pvzID, productType)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddProducts", reflect.TypeOf((*MockPvzUsecase)(nil).AddProducts), ctx, req)
 }
 
 // CloseReception mocks base method.
@@ -264,11 +627,12 @@ func (mr *MockPvzUsecaseMockRecorder) CloseReception(ctx, receptionID interface{
 }
 
 // CreatePvz mocks base method.
-func (m *MockPvzUsecase) CreatePvz(ctx context.Context, pvz models.PVZ) error {
+func (m *MockPvzUsecase) CreatePvz(ctx context.Context, pvz models.PVZ) (models.PVZ, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "CreatePvz", ctx, pvz)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret0, _ := ret[0].(models.PVZ)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // CreatePvz indicates an expected call of CreatePvz.
@@ -306,17 +670,167 @@ func (mr *MockPvzUsecaseMockRecorder) DeleteProduct(ctx, pvzID interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProduct", reflect.TypeOf((*MockPvzUsecase)(nil).DeleteProduct), ctx, pvzID)
 }
 
+// DeleteProductByID mocks base method.
+func (m *MockPvzUsecase) DeleteProductByID(ctx context.Context, id uuid.UUID, reason string) (models.ProductDeletion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteProductByID", ctx, id, reason)
+	ret0, _ := ret[0].(models.ProductDeletion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteProductByID indicates an expected call of DeleteProductByID.
+func (mr *MockPvzUsecaseMockRecorder) DeleteProductByID(ctx, id, reason interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProductByID", reflect.TypeOf((*MockPvzUsecase)(nil).DeleteProductByID), ctx, id, reason)
+}
+
+// FindNearbyPvz mocks base method.
+func (m *MockPvzUsecase) FindNearbyPvz(ctx context.Context, filter models.NearbyPvzFilter) ([]models.NearbyPvz, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindNearbyPvz", ctx, filter)
+	ret0, _ := ret[0].([]models.NearbyPvz)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindNearbyPvz indicates an expected call of FindNearbyPvz.
+func (mr *MockPvzUsecaseMockRecorder) FindNearbyPvz(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindNearbyPvz", reflect.TypeOf((*MockPvzUsecase)(nil).FindNearbyPvz), ctx, filter)
+}
+
+// FindProductsByBarcode mocks base method.
+func (m *MockPvzUsecase) FindProductsByBarcode(ctx context.Context, barcode string) ([]models.ProductLocation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindProductsByBarcode", ctx, barcode)
+	ret0, _ := ret[0].([]models.ProductLocation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindProductsByBarcode indicates an expected call of FindProductsByBarcode.
+func (mr *MockPvzUsecaseMockRecorder) FindProductsByBarcode(ctx, barcode interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindProductsByBarcode", reflect.TypeOf((*MockPvzUsecase)(nil).FindProductsByBarcode), ctx, barcode)
+}
+
+// GetActiveReception mocks base method.
+func (m *MockPvzUsecase) GetActiveReception(ctx context.Context, pvzID uuid.UUID) (models.Reception, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetActiveReception", ctx, pvzID)
+	ret0, _ := ret[0].(models.Reception)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetActiveReception indicates an expected call of GetActiveReception.
+func (mr *MockPvzUsecaseMockRecorder) GetActiveReception(ctx, pvzID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActiveReception", reflect.TypeOf((*MockPvzUsecase)(nil).GetActiveReception), ctx, pvzID)
+}
+
 // GetPvz mocks base method.
-func (m *MockPvzUsecase) GetPvz(ctx context.Context, startDate, endDate *time.Time, page, limit int) ([]models.PVZ, error) {
+func (m *MockPvzUsecase) GetPvz(ctx context.Context, filter models.PvzFilter) (models.PvzPage, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetPvz", ctx, startDate, endDate, page, limit)
-	ret0, _ := ret[0].([]models.PVZ)
+	ret := m.ctrl.Call(m, "GetPvz", ctx, filter)
+	ret0, _ := ret[0].(models.PvzPage)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetPvz indicates an expected call of GetPvz.
-func (mr *MockPvzUsecaseMockRecorder) GetPvz(ctx, startDate, endDate, page, limit interface{}) *gomock.Call {
+func (mr *MockPvzUsecaseMockRecorder) GetPvz(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPvz", reflect.TypeOf((*MockPvzUsecase)(nil).GetPvz), ctx, filter)
+}
+
+// GetPvzByID mocks base method.
+func (m *MockPvzUsecase) GetPvzByID(ctx context.Context, id uuid.UUID) (models.PVZ, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPvzByID", ctx, id)
+	ret0, _ := ret[0].(models.PVZ)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPvzByID indicates an expected call of GetPvzByID.
+func (mr *MockPvzUsecaseMockRecorder) GetPvzByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPvzByID", reflect.TypeOf((*MockPvzUsecase)(nil).GetPvzByID), ctx, id)
+}
+
+// GetReception mocks base method.
+func (m *MockPvzUsecase) GetReception(ctx context.Context, id uuid.UUID) (models.Reception, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReception", ctx, id)
+	ret0, _ := ret[0].(models.Reception)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReception indicates an expected call of GetReception.
+func (mr *MockPvzUsecaseMockRecorder) GetReception(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReception", reflect.TypeOf((*MockPvzUsecase)(nil).GetReception), ctx, id)
+}
+
+// GetReceptionAct mocks base method.
+func (m *MockPvzUsecase) GetReceptionAct(ctx context.Context, id uuid.UUID) (models.ReceptionAct, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReceptionAct", ctx, id)
+	ret0, _ := ret[0].(models.ReceptionAct)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReceptionAct indicates an expected call of GetReceptionAct.
+func (mr *MockPvzUsecaseMockRecorder) GetReceptionAct(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReceptionAct", reflect.TypeOf((*MockPvzUsecase)(nil).GetReceptionAct), ctx, id)
+}
+
+// ImportPvz mocks base method.
+func (m *MockPvzUsecase) ImportPvz(ctx context.Context, rows []models.PvzImportRow, dryRun bool) (models.PvzImportReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportPvz", ctx, rows, dryRun)
+	ret0, _ := ret[0].(models.PvzImportReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportPvz indicates an expected call of ImportPvz.
+func (mr *MockPvzUsecaseMockRecorder) ImportPvz(ctx, rows, dryRun interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportPvz", reflect.TypeOf((*MockPvzUsecase)(nil).ImportPvz), ctx, rows, dryRun)
+}
+
+// RestoreProduct mocks base method.
+func (m *MockPvzUsecase) RestoreProduct(ctx context.Context, id uuid.UUID) (models.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreProduct", ctx, id)
+	ret0, _ := ret[0].(models.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreProduct indicates an expected call of RestoreProduct.
+func (mr *MockPvzUsecaseMockRecorder) RestoreProduct(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreProduct", reflect.TypeOf((*MockPvzUsecase)(nil).RestoreProduct), ctx, id)
+}
+
+// UpdatePvz mocks base method.
+func (m *MockPvzUsecase) UpdatePvz(ctx context.Context, id uuid.UUID, req models.UpdatePvzReq) (models.PVZ, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePvz", ctx, id, req)
+	ret0, _ := ret[0].(models.PVZ)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdatePvz indicates an expected call of UpdatePvz.
+func (mr *MockPvzUsecaseMockRecorder) UpdatePvz(ctx, id, req interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPvz", reflect.TypeOf((*MockPvzUsecase)(nil).GetPvz), ctx, startDate, endDate, page, limit)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePvz", reflect.TypeOf((*MockPvzUsecase)(nil).UpdatePvz), ctx, id, req)
 }
@@ -11,7 +11,9 @@ import (
 	"github.com/K1tten2005/avito_pvz/internal/models"
 	"github.com/K1tten2005/avito_pvz/internal/pkg/pvz"
 	"github.com/K1tten2005/avito_pvz/internal/pkg/utils/logger"
-	"github.com/jackc/pgtype/pgxtype"
+	"github.com/K1tten2005/avito_pvz/internal/pkg/utils/pgerr"
+	"github.com/K1tten2005/avito_pvz/internal/pkg/utils/pgtx"
+	"github.com/jackc/pgx/v4"
 	"github.com/satori/uuid"
 )
 
@@ -30,6 +32,12 @@ var deleteProduct string
 //go:embed sql/getPvz.sql
 var getPvz string
 
+//go:embed sql/countPvz.sql
+var countPvz string
+
+//go:embed sql/getReceptionsByPvzIds.sql
+var getReceptionsByPvzIds string
+
 //go:embed sql/updateReceptionStatus.sql
 var updateReceptionStatus string
 
@@ -48,136 +56,535 @@ var createReception string
 //go:embed sql/getLastProduct.sql
 var getLastProduct string
 
+//go:embed sql/getPvzById.sql
+var getPvzById string
+
+//go:embed sql/getProductsByReceptionId.sql
+var getProductsByReceptionId string
+
+//go:embed sql/updatePvz.sql
+var updatePvz string
+
+//go:embed sql/hasBarcodeInReception.sql
+var hasBarcodeInReception string
+
+//go:embed sql/getProductsByBarcode.sql
+var getProductsByBarcode string
+
+//go:embed sql/getScannedBarcodes.sql
+var getScannedBarcodes string
+
+//go:embed sql/getProductById.sql
+var getProductById string
+
+//go:embed sql/softDeleteProduct.sql
+var softDeleteProduct string
+
+//go:embed sql/restoreProduct.sql
+var restoreProduct string
+
+//go:embed sql/lockPvz.sql
+var lockPvz string
+
+//go:embed sql/selectExistingPvzIds.sql
+var selectExistingPvzIds string
+
+//go:embed sql/getPvzOccupancy.sql
+var getPvzOccupancy string
+
+//go:embed sql/getPvzUtilizations.sql
+var getPvzUtilizations string
+
+//go:embed sql/findNearbyPvz.sql
+var findNearbyPvz string
+
+//go:embed sql/getProductPvzId.sql
+var getProductPvzId string
+
+//go:embed sql/getUserEmail.sql
+var getUserEmail string
+
+// activeReceptionConstraint — частичный уникальный индекс: не больше одной открытой приёмки на ПВЗ
+const activeReceptionConstraint = "reception_one_active_per_pvz_idx"
+
+const pvzPrimaryKey = "pvz_pkey"
+
 type PvzRepo struct {
-	db pgxtype.Querier
+	db pgtx.Pool
 }
 
-func CreatePvzRepo(db pgxtype.Querier) *PvzRepo {
+func CreatePvzRepo(db pgtx.Pool) *PvzRepo {
 	return &PvzRepo{
 		db: db,
 	}
 }
 
-func (repo *PvzRepo) InsertPvz(ctx context.Context, pvz models.PVZ) error {
+// conn возвращает транзакцию из контекста, если usecase работает внутри WithinTx
+func (repo *PvzRepo) conn(ctx context.Context) pgtx.Conn {
+	return pgtx.FromContext(ctx, repo.db)
+}
+
+func (repo *PvzRepo) WithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return pgtx.RunInTx(ctx, repo.db, fn)
+}
+
+func (repo *PvzRepo) LockPvz(ctx context.Context, id uuid.UUID) error {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	var lockedID uuid.UUID
+	err := repo.conn(ctx).QueryRow(ctx, lockPvz, id).Scan(&lockedID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		loggerVar.Error(pvz.ErrPvzNotFound.Error())
+		return pvz.ErrPvzNotFound
+	}
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return err
+	}
+
+	loggerVar.Info("Successful")
+	return nil
+}
+
+// productRow собирает nullable-колонки товара: в LEFT JOIN у приёмки может не быть товаров
+type productRow struct {
+	id            uuid.NullUUID
+	dateTime      sql.NullTime
+	category      sql.NullString
+	receptionID   uuid.NullUUID
+	barcode       sql.NullString
+	barcodeFormat sql.NullString
+	sku           sql.NullString
+	orderNumber   sql.NullString
+	weightGrams   sql.NullInt32
+	lengthMm      sql.NullInt32
+	widthMm       sql.NullInt32
+	heightMm      sql.NullInt32
+	status        sql.NullString
+	createdBy     uuid.NullUUID
+}
+
+func (p *productRow) dest() []any {
+	return []any{
+		&p.id, &p.dateTime, &p.category, &p.receptionID,
+		&p.barcode, &p.barcodeFormat, &p.sku, &p.orderNumber,
+		&p.weightGrams, &p.lengthMm, &p.widthMm, &p.heightMm, &p.status, &p.createdBy,
+	}
+}
+
+func (p *productRow) toModel() models.Product {
+	product := models.Product{
+		Id:            p.id.UUID,
+		DateTime:      p.dateTime.Time,
+		Type:          p.category.String,
+		ReceptionId:   p.receptionID.UUID,
+		Barcode:       p.barcode.String,
+		BarcodeFormat: p.barcodeFormat.String,
+		Sku:           p.sku.String,
+		OrderNumber:   p.orderNumber.String,
+		Status:        p.status.String,
+		CreatedBy:     nullUUIDPtr(p.createdBy),
+	}
+	if p.weightGrams.Valid {
+		weight := int(p.weightGrams.Int32)
+		product.WeightGrams = &weight
+	}
+	if p.lengthMm.Valid && p.widthMm.Valid && p.heightMm.Valid {
+		product.Dimensions = &models.Dimensions{
+			LengthMm: int(p.lengthMm.Int32),
+			WidthMm:  int(p.widthMm.Int32),
+			HeightMm: int(p.heightMm.Int32),
+		}
+	}
+	return product
+}
+
+func productArgs(product models.Product) []any {
+	var lengthMm, widthMm, heightMm *int
+	if product.Dimensions != nil {
+		lengthMm, widthMm, heightMm = &product.Dimensions.LengthMm, &product.Dimensions.WidthMm, &product.Dimensions.HeightMm
+	}
+	return []any{
+		product.Id, product.DateTime, product.ReceptionId, product.Type,
+		product.Barcode, product.BarcodeFormat, product.Sku, product.OrderNumber,
+		product.WeightGrams, lengthMm, widthMm, heightMm, product.CreatedBy,
+	}
+}
+
+// nullUUIDPtr переводит nullable-колонку с id пользователя в поле модели
+func nullUUIDPtr(id uuid.NullUUID) *uuid.UUID {
+	if !id.Valid {
+		return nil
+	}
+	return &id.UUID
+}
+
+func pvzArgs(pvzItem models.PVZ) []any {
+	return []any{
+		pvzItem.Id, pvzItem.RegistrationDate, pvzItem.City, pvzItem.Address, pvzItem.Metadata,
+		pvzItem.Capacity.MaxItems, pvzItem.Capacity.MaxVolumeCm3, pvzItem.Capacity.MaxWeightGrams,
+		pvzItem.Latitude, pvzItem.Longitude,
+	}
+}
+
+func pvzDest(pvzItem *models.PVZ) []any {
+	return []any{
+		&pvzItem.Id, &pvzItem.RegistrationDate, &pvzItem.City, &pvzItem.Address, &pvzItem.Latitude, &pvzItem.Longitude,
+		&pvzItem.Status, &pvzItem.Metadata, &pvzItem.Version, &pvzItem.ClosedAt,
+		&pvzItem.Capacity.MaxItems, &pvzItem.Capacity.MaxVolumeCm3, &pvzItem.Capacity.MaxWeightGrams,
+	}
+}
+
+func scanPvz(row pgx.Row) (models.PVZ, error) {
+	pvzItem := models.PVZ{Receptions: []models.Reception{}}
+	err := row.Scan(pvzDest(&pvzItem)...)
+	return pvzItem, err
+}
+
+func (repo *PvzRepo) InsertPvz(ctx context.Context, pvzItem models.PVZ) error {
 	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
 
-	_, err := repo.db.Exec(ctx, insertPvz, pvz.Id, pvz.RegistrationDate, pvz.City)
+	_, err := repo.conn(ctx).Exec(ctx, insertPvz, pvzArgs(pvzItem)...)
+	if pgerr.IsUniqueViolation(err, pvzPrimaryKey) {
+		loggerVar.Error(pvz.ErrPvzExists.Error())
+		return pvz.ErrPvzExists
+	}
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return err
+	}
+
+	loggerVar.Info("Successful")
+	return nil
+}
+
+func (repo *PvzRepo) InsertPvzs(ctx context.Context, pvzList []models.PVZ) error {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	tx, err := repo.conn(ctx).Begin(ctx)
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return err
+	}
+	// внутри WithinTx это savepoint, иначе — отдельная транзакция
+	defer tx.Rollback(ctx)
+
+	batch := &pgx.Batch{}
+	for _, pvzItem := range pvzList {
+		batch.Queue(insertPvz, pvzArgs(pvzItem)...)
+	}
+
+	results := tx.SendBatch(ctx, batch)
+	for range pvzList {
+		if _, err = results.Exec(); err != nil {
+			break
+		}
+	}
+	if closeErr := results.Close(); err == nil {
+		err = closeErr
+	}
+	if pgerr.IsUniqueViolation(err, pvzPrimaryKey) {
+		loggerVar.Error(pvz.ErrPvzExists.Error())
+		return pvz.ErrPvzExists
+	}
 	if err != nil {
 		loggerVar.Error(err.Error())
 		return err
 	}
 
+	if err := tx.Commit(ctx); err != nil {
+		loggerVar.Error(err.Error())
+		return err
+	}
+
 	loggerVar.Info("Successful")
 	return nil
 }
 
-func (repo *PvzRepo) GetPvz(ctx context.Context, startDate, endDate *time.Time, page, limit int) ([]models.PVZ, error) {
+func (repo *PvzRepo) GetExistingPvzIDs(ctx context.Context, ids []uuid.UUID) ([]uuid.UUID, error) {
 	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
 
-	offset := (page - 1) * limit
+	idStrs := make([]string, 0, len(ids))
+	for _, id := range ids {
+		idStrs = append(idStrs, id.String())
+	}
 
-	rows, err := repo.db.Query(ctx, getPvz, startDate, endDate, limit, offset)
+	rows, err := repo.conn(ctx).Query(ctx, selectExistingPvzIds, idStrs)
 	if err != nil {
 		loggerVar.Error(err.Error())
 		return nil, err
 	}
 	defer rows.Close()
 
-	pvzMap := make(map[string]*models.PVZ)
+	existing := []uuid.UUID{}
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			loggerVar.Error(err.Error())
+			return nil, err
+		}
+		existing = append(existing, id)
+	}
+	if err := rows.Err(); err != nil {
+		loggerVar.Error(err.Error())
+		return nil, err
+	}
 
+	loggerVar.Info("Successful")
+	return existing, nil
+}
+
+func (repo *PvzRepo) GetPvz(ctx context.Context, filter models.PvzFilter) ([]models.PVZ, error) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	var (
+		cursorDate *time.Time
+		cursorID   *uuid.UUID
+		offset     int
+	)
+	if filter.Cursor != nil {
+		cursorDate = &filter.Cursor.RegistrationDate
+		cursorID = &filter.Cursor.Id
+	} else {
+		offset = (filter.Page - 1) * filter.Limit
+	}
+
+	rows, err := repo.conn(ctx).Query(ctx, getPvz, cursorDate, cursorID, filter.Limit, offset, filter.IncludeArchived)
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return nil, err
+	}
+	defer rows.Close()
+
+	result := []models.PVZ{}
 	for rows.Next() {
-		var (
-			pvzID   uuid.NullUUID
-			pvzCity sql.NullString
-			pvzDate sql.NullTime
+		pvz, err := scanPvz(rows)
+		if err != nil {
+			loggerVar.Error(err.Error())
+			return nil, err
+		}
+		result = append(result, pvz)
+	}
 
-			receptionID     uuid.NullUUID
-			receptionDate   sql.NullTime
-			receptionStatus sql.NullString
+	if err := rows.Err(); err != nil {
+		loggerVar.Error(err.Error())
+		return nil, err
+	}
 
-			productID       uuid.NullUUID
-			productDate     sql.NullTime
-			productCategory sql.NullString
-		)
+	loggerVar.Info("Successful")
+	return result, nil
+}
 
-		err := rows.Scan(
-			&pvzID, &pvzCity, &pvzDate,
-			&receptionID, &receptionDate, &receptionStatus,
-			&productID, &productDate, &productCategory,
+func (repo *PvzRepo) CountPvz(ctx context.Context, includeArchived bool) (int, error) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	var total int
+	if err := repo.conn(ctx).QueryRow(ctx, countPvz, includeArchived).Scan(&total); err != nil {
+		loggerVar.Error(err.Error())
+		return 0, err
+	}
+
+	loggerVar.Info("Successful")
+	return total, nil
+}
+
+func (repo *PvzRepo) GetReceptionsByPvzIDs(ctx context.Context, pvzIDs []uuid.UUID, startDate, endDate *time.Time) ([]models.Reception, error) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	ids := make([]string, 0, len(pvzIDs))
+	for _, id := range pvzIDs {
+		ids = append(ids, id.String())
+	}
+
+	rows, err := repo.conn(ctx).Query(ctx, getReceptionsByPvzIds, ids, startDate, endDate)
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return nil, err
+	}
+	defer rows.Close()
+
+	result := []models.Reception{}
+	for rows.Next() {
+		var (
+			reception           models.Reception
+			createdBy, closedBy uuid.NullUUID
+			product             productRow
 		)
+
+		dest := append([]any{&reception.Id, &reception.DateTime, &reception.PvzId, &reception.Status, &createdBy, &closedBy}, product.dest()...)
+		err := rows.Scan(dest...)
 		if err != nil {
 			loggerVar.Error(err.Error())
 			return nil, err
 		}
+		reception.CreatedBy, reception.ClosedBy = nullUUIDPtr(createdBy), nullUUIDPtr(closedBy)
 
-		if !pvzID.Valid {
-			continue
+		// Строки отсортированы по приёмке, поэтому товары одной приёмки идут подряд
+		if len(result) == 0 || result[len(result)-1].Id != reception.Id {
+			reception.Products = []models.Product{}
+			result = append(result, reception)
 		}
 
-		pvzUUID := pvzID.UUID
-		pvzKey := pvzUUID.String()
+		if product.id.Valid {
+			last := &result[len(result)-1]
+			last.Products = append(last.Products, product.toModel())
+		}
+	}
 
-		if _, exists := pvzMap[pvzKey]; !exists {
-			pvzMap[pvzKey] = &models.PVZ{
-				Id:               pvzUUID,
-				City:             pvzCity.String,
-				RegistrationDate: pvzDate.Time,
-				Receptions:       []models.Reception{},
-			}
+	if err := rows.Err(); err != nil {
+		loggerVar.Error(err.Error())
+		return nil, err
+	}
+
+	loggerVar.Info("Successful")
+	return result, nil
+}
+
+func (repo *PvzRepo) GetPvzByID(ctx context.Context, id uuid.UUID) (models.PVZ, error) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	pvzItem, err := scanPvz(repo.conn(ctx).QueryRow(ctx, getPvzById, id))
+	if errors.Is(err, pgx.ErrNoRows) {
+		loggerVar.Error(pvz.ErrPvzNotFound.Error())
+		return models.PVZ{}, pvz.ErrPvzNotFound
+	}
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return models.PVZ{}, err
+	}
+
+	loggerVar.Info("Successful")
+	return pvzItem, nil
+}
+
+func (repo *PvzRepo) UpdatePvz(ctx context.Context, pvzItem models.PVZ, version int) (models.PVZ, error) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	updated, err := scanPvz(repo.conn(ctx).QueryRow(ctx, updatePvz,
+		pvzItem.Id, pvzItem.City, pvzItem.Status, pvzItem.Metadata, version, pvzItem.Address,
+		pvzItem.Capacity.MaxItems, pvzItem.Capacity.MaxVolumeCm3, pvzItem.Capacity.MaxWeightGrams,
+		pvzItem.Latitude, pvzItem.Longitude,
+	))
+	if errors.Is(err, pgx.ErrNoRows) {
+		loggerVar.Error(pvz.ErrPvzVersionConflict.Error())
+		return models.PVZ{}, pvz.ErrPvzVersionConflict
+	}
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return models.PVZ{}, err
+	}
+
+	loggerVar.Info("Successful")
+	return updated, nil
+}
+
+func (repo *PvzRepo) GetPvzOccupancy(ctx context.Context, pvzID uuid.UUID) (models.PvzUtilization, error) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	var occupancy models.PvzUtilization
+	err := repo.conn(ctx).QueryRow(ctx, getPvzOccupancy, pvzID).
+		Scan(&occupancy.Items, &occupancy.VolumeCm3, &occupancy.WeightGrams)
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return models.PvzUtilization{}, err
+	}
+
+	loggerVar.Info("Successful")
+	return occupancy, nil
+}
+
+func (repo *PvzRepo) GetPvzUtilizations(ctx context.Context) ([]models.PVZ, error) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	rows, err := repo.conn(ctx).Query(ctx, getPvzUtilizations)
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return nil, err
+	}
+	defer rows.Close()
+
+	result := []models.PVZ{}
+	for rows.Next() {
+		var (
+			pvzItem   models.PVZ
+			occupancy models.PvzUtilization
+		)
+		err := rows.Scan(
+			&pvzItem.Id, &pvzItem.City,
+			&pvzItem.Capacity.MaxItems, &pvzItem.Capacity.MaxVolumeCm3, &pvzItem.Capacity.MaxWeightGrams,
+			&occupancy.Items, &occupancy.VolumeCm3, &occupancy.WeightGrams,
+		)
+		if err != nil {
+			loggerVar.Error(err.Error())
+			return nil, err
 		}
+		pvzItem.Utilization = &occupancy
+		result = append(result, pvzItem)
+	}
+
+	if err := rows.Err(); err != nil {
+		loggerVar.Error(err.Error())
+		return nil, err
+	}
+
+	loggerVar.Info("Successful")
+	return result, nil
+}
+
+func (repo *PvzRepo) FindNearbyPvz(ctx context.Context, filter models.NearbyPvzFilter) ([]models.NearbyPvz, error) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	rows, err := repo.conn(ctx).Query(ctx, findNearbyPvz,
+		filter.Latitude, filter.Longitude, filter.RadiusMeters, filter.Status, filter.HasCapacity, filter.Limit,
+	)
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return nil, err
+	}
+	defer rows.Close()
 
-		if receptionID.Valid {
-			receptionUUID := receptionID.UUID
-			recFound := false
-
-			for i := range pvzMap[pvzKey].Receptions {
-				if pvzMap[pvzKey].Receptions[i].Id == receptionUUID {
-					if productID.Valid {
-						pvzMap[pvzKey].Receptions[i].Products = append(
-							pvzMap[pvzKey].Receptions[i].Products,
-							models.Product{
-								Id:          productID.UUID,
-								DateTime:    productDate.Time,
-								Type:        productCategory.String,
-								ReceptionId: receptionUUID,
-							},
-						)
-					}
-					recFound = true
-					break
-				}
-			}
-
-			if !recFound {
-				rec := models.Reception{
-					Id:       receptionUUID,
-					DateTime: receptionDate.Time,
-					PvzId:    pvzUUID,
-					Status:   receptionStatus.String,
-					Products: []models.Product{},
-				}
-
-				if productID.Valid {
-					rec.Products = append(rec.Products, models.Product{
-						Id:          productID.UUID,
-						DateTime:    productDate.Time,
-						Type:        productCategory.String,
-						ReceptionId: receptionUUID,
-					})
-				}
-
-				pvzMap[pvzKey].Receptions = append(pvzMap[pvzKey].Receptions, rec)
-			}
+	result := []models.NearbyPvz{}
+	for rows.Next() {
+		item := models.NearbyPvz{Pvz: models.PVZ{Receptions: []models.Reception{}}}
+		if err := rows.Scan(append(pvzDest(&item.Pvz), &item.DistanceMeters)...); err != nil {
+			loggerVar.Error(err.Error())
+			return nil, err
 		}
+		result = append(result, item)
 	}
 
 	if err := rows.Err(); err != nil {
+		loggerVar.Error(err.Error())
 		return nil, err
 	}
 
-	var result []models.PVZ
-	for _, val := range pvzMap {
-		result = append(result, *val)
+	loggerVar.Info("Successful")
+	return result, nil
+}
+
+func (repo *PvzRepo) GetProductsByReceptionID(ctx context.Context, receptionID uuid.UUID) ([]models.Product, error) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	rows, err := repo.conn(ctx).Query(ctx, getProductsByReceptionId, receptionID)
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return nil, err
+	}
+	defer rows.Close()
+
+	result := []models.Product{}
+	for rows.Next() {
+		var product productRow
+		if err := rows.Scan(product.dest()...); err != nil {
+			loggerVar.Error(err.Error())
+			return nil, err
+		}
+		result = append(result, product.toModel())
+	}
+
+	if err := rows.Err(); err != nil {
+		loggerVar.Error(err.Error())
+		return nil, err
 	}
 
 	loggerVar.Info("Successful")
@@ -187,13 +594,25 @@ func (repo *PvzRepo) GetPvz(ctx context.Context, startDate, endDate *time.Time,
 func (repo *PvzRepo) GetReceptionByID(ctx context.Context, id uuid.UUID) (models.Reception, error) {
 	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
 
-	var reception models.Reception
-	err := repo.db.QueryRow(ctx, getReceptionById, id).
-		Scan(&reception.Id, &reception.DateTime, &reception.PvzId, &reception.Status)
+	var (
+		reception           models.Reception
+		closedAt            sql.NullTime
+		createdBy, closedBy uuid.NullUUID
+	)
+	err := repo.conn(ctx).QueryRow(ctx, getReceptionById, id).
+		Scan(&reception.Id, &reception.DateTime, &reception.PvzId, &reception.Status, &closedAt, &createdBy, &closedBy)
+	if errors.Is(err, pgx.ErrNoRows) {
+		loggerVar.Error(pvz.ErrReceptionNotFound.Error())
+		return models.Reception{}, pvz.ErrReceptionNotFound
+	}
 	if err != nil {
 		loggerVar.Error(err.Error())
 		return models.Reception{}, err
 	}
+	if closedAt.Valid {
+		reception.ClosedAt = &closedAt.Time
+	}
+	reception.CreatedBy, reception.ClosedBy = nullUUIDPtr(createdBy), nullUUIDPtr(closedBy)
 
 	loggerVar.Info("Successful")
 	return reception, nil
@@ -202,7 +621,11 @@ func (repo *PvzRepo) GetReceptionByID(ctx context.Context, id uuid.UUID) (models
 func (repo *PvzRepo) InsertReception(ctx context.Context, reception models.Reception) error {
 	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
 
-	_, err := repo.db.Exec(ctx, insertReception, reception.Id, reception.DateTime, reception.PvzId, reception.Status)
+	_, err := repo.conn(ctx).Exec(ctx, insertReception, reception.Id, reception.DateTime, reception.PvzId, reception.Status, reception.CreatedBy)
+	if pgerr.IsUniqueViolation(err, activeReceptionConstraint) {
+		loggerVar.Error(pvz.ErrActiveReceptionExists.Error())
+		return pvz.ErrActiveReceptionExists
+	}
 	if err != nil {
 		loggerVar.Error(err.Error())
 		return err
@@ -215,7 +638,7 @@ func (repo *PvzRepo) InsertReception(ctx context.Context, reception models.Recep
 func (repo *PvzRepo) InsertProduct(ctx context.Context, product models.Product) error {
 	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
 
-	_, err := repo.db.Exec(ctx, insertProduct, product.Id, product.DateTime, product.ReceptionId, product.Type)
+	_, err := repo.conn(ctx).Exec(ctx, insertProduct, productArgs(product)...)
 	if err != nil {
 		loggerVar.Error(err.Error())
 		return err
@@ -229,7 +652,7 @@ func (repo *PvzRepo) HasActiveReception(ctx context.Context, pvzID uuid.UUID) (b
 	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
 
 	var exists bool
-	err := repo.db.QueryRow(ctx, hasActiveReception, pvzID).Scan(&exists)
+	err := repo.conn(ctx).QueryRow(ctx, hasActiveReception, pvzID).Scan(&exists)
 	if err != nil {
 		loggerVar.Error(err.Error())
 	}
@@ -241,10 +664,13 @@ func (repo *PvzRepo) HasActiveReception(ctx context.Context, pvzID uuid.UUID) (b
 func (repo *PvzRepo) GetActiveReception(ctx context.Context, pvzId uuid.UUID) (models.Reception, error) {
 	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
 
-	var reception models.Reception
-	err := repo.db.QueryRow(ctx, getActiveReception, pvzId).
-		Scan(&reception.Id, &reception.DateTime, &reception.PvzId, &reception.Status)
-	if err == sql.ErrNoRows {
+	var (
+		reception           models.Reception
+		createdBy, closedBy uuid.NullUUID
+	)
+	err := repo.conn(ctx).QueryRow(ctx, getActiveReception, pvzId).
+		Scan(&reception.Id, &reception.DateTime, &reception.PvzId, &reception.Status, &createdBy, &closedBy)
+	if errors.Is(err, pgx.ErrNoRows) {
 		loggerVar.Error(pvz.ErrNoActiveReception.Error())
 		return models.Reception{}, pvz.ErrNoActiveReception
 	}
@@ -252,6 +678,7 @@ func (repo *PvzRepo) GetActiveReception(ctx context.Context, pvzId uuid.UUID) (m
 		loggerVar.Error(err.Error())
 		return models.Reception{}, err
 	}
+	reception.CreatedBy, reception.ClosedBy = nullUUIDPtr(createdBy), nullUUIDPtr(closedBy)
 
 	loggerVar.Info("Successful")
 	return reception, nil
@@ -260,7 +687,12 @@ func (repo *PvzRepo) GetActiveReception(ctx context.Context, pvzId uuid.UUID) (m
 func (repo *PvzRepo) CreateReception(ctx context.Context, reception models.Reception) error {
 	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
 
-	_, err := repo.db.Exec(ctx, createReception, reception.Id, reception.DateTime, reception.PvzId, reception.Status)
+	_, err := repo.conn(ctx).Exec(ctx, createReception, reception.Id, reception.DateTime, reception.PvzId, reception.Status, reception.CreatedBy)
+	if pgerr.IsUniqueViolation(err, activeReceptionConstraint) {
+		// индекс страхует от гонки, если запрос прошёл мимо блокировки ПВЗ
+		loggerVar.Error(pvz.ErrActiveReceptionExists.Error())
+		return pvz.ErrActiveReceptionExists
+	}
 	if err != nil {
 		loggerVar.Error(err.Error())
 	}
@@ -272,7 +704,12 @@ func (repo *PvzRepo) CreateReception(ctx context.Context, reception models.Recep
 func (repo *PvzRepo) AddProduct(ctx context.Context, product *models.Product) error {
 	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
 
-	_, err := repo.db.Exec(ctx, insertProduct, product.Id, product.DateTime, product.ReceptionId, product.Type)
+	_, err := repo.conn(ctx).Exec(ctx, insertProduct, productArgs(*product)...)
+	if pgerr.IsForeignKeyViolation(err) {
+		// Кэш справочника мог устареть, поэтому опираемся на внешний ключ
+		loggerVar.Error(pvz.ErrInvalidProductType.Error())
+		return pvz.ErrInvalidProductType
+	}
 	if err != nil {
 		loggerVar.Error(err.Error())
 	}
@@ -284,15 +721,10 @@ func (repo *PvzRepo) AddProduct(ctx context.Context, product *models.Product) er
 func (repo *PvzRepo) GetLastProduct(ctx context.Context, pvzID uuid.UUID) (models.Product, error) {
 	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
 
-	var product models.Product
-	err := repo.db.QueryRow(ctx, getLastProduct, pvzID).Scan(
-		&product.Id,
-		&product.DateTime,
-		&product.Type,
-		&product.ReceptionId,
-	)
+	var product productRow
+	err := repo.conn(ctx).QueryRow(ctx, getLastProduct, pvzID).Scan(product.dest()...)
 
-	if err == sql.ErrNoRows {
+	if errors.Is(err, pgx.ErrNoRows) {
 		loggerVar.Error(pvz.ErrNoProductsInReception.Error())
 		return models.Product{}, pvz.ErrNoProductsInReception
 	}
@@ -302,13 +734,13 @@ func (repo *PvzRepo) GetLastProduct(ctx context.Context, pvzID uuid.UUID) (model
 	}
 
 	loggerVar.Info("Successful")
-	return product, nil
+	return product.toModel(), nil
 }
 
 func (repo *PvzRepo) DeleteProduct(ctx context.Context, productId uuid.UUID) error {
 	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
 
-	result, err := repo.db.Exec(ctx, deleteProduct, productId)
+	result, err := repo.conn(ctx).Exec(ctx, deleteProduct, productId)
 	if err != nil {
 		loggerVar.Error(err.Error())
 		return err
@@ -325,16 +757,240 @@ func (repo *PvzRepo) DeleteProduct(ctx context.Context, productId uuid.UUID) err
 	return nil
 }
 
-func (repo *PvzRepo) UpdateReceptionStatus(ctx context.Context, id uuid.UUID, status string) error {
+func (repo *PvzRepo) UpdateReceptionStatus(ctx context.Context, id uuid.UUID, status string, closedBy *uuid.UUID) (*time.Time, error) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	var closedAt sql.NullTime
+	err := repo.conn(ctx).QueryRow(ctx, updateReceptionStatus, status, id, closedBy).Scan(&closedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, errors.New("nothing was updated")
+	}
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return nil, err
+	}
+
+	loggerVar.Info("Successful")
+	if !closedAt.Valid {
+		return nil, nil
+	}
+	return &closedAt.Time, nil
+}
+
+func (repo *PvzRepo) HasBarcodeInReception(ctx context.Context, receptionID uuid.UUID, barcode string) (bool, error) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	var exists bool
+	if err := repo.conn(ctx).QueryRow(ctx, hasBarcodeInReception, receptionID, barcode).Scan(&exists); err != nil {
+		loggerVar.Error(err.Error())
+		return false, err
+	}
+
+	loggerVar.Info("Successful")
+	return exists, nil
+}
+
+func (repo *PvzRepo) GetProductsByBarcode(ctx context.Context, barcode string, limit int) ([]models.ProductLocation, error) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	rows, err := repo.conn(ctx).Query(ctx, getProductsByBarcode, barcode, limit)
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return nil, err
+	}
+	defer rows.Close()
+
+	result := []models.ProductLocation{}
+	for rows.Next() {
+		var (
+			product  productRow
+			location models.ProductLocation
+		)
+
+		dest := append(product.dest(), &location.PvzId, &location.City, &location.ReceptionStatus)
+		if err := rows.Scan(dest...); err != nil {
+			loggerVar.Error(err.Error())
+			return nil, err
+		}
+		location.Product = product.toModel()
+		result = append(result, location)
+	}
+
+	if err := rows.Err(); err != nil {
+		loggerVar.Error(err.Error())
+		return nil, err
+	}
+
+	loggerVar.Info("Successful")
+	return result, nil
+}
+
+func (repo *PvzRepo) AddProducts(ctx context.Context, products []models.Product) error {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	tx, err := repo.conn(ctx).Begin(ctx)
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return err
+	}
+	// внутри WithinTx это savepoint, иначе — отдельная транзакция
+	defer tx.Rollback(ctx)
+
+	batch := &pgx.Batch{}
+	for _, product := range products {
+		batch.Queue(insertProduct, productArgs(product)...)
+	}
+
+	results := tx.SendBatch(ctx, batch)
+	for range products {
+		if _, err = results.Exec(); err != nil {
+			break
+		}
+	}
+	if closeErr := results.Close(); err == nil {
+		err = closeErr
+	}
+	if pgerr.IsForeignKeyViolation(err) {
+		loggerVar.Error(pvz.ErrInvalidProductType.Error())
+		return pvz.ErrInvalidProductType
+	}
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		loggerVar.Error(err.Error())
+		return err
+	}
+
+	loggerVar.Info("Successful")
+	return nil
+}
+
+func (repo *PvzRepo) GetScannedBarcodes(ctx context.Context, receptionID uuid.UUID, barcodes []string) ([]string, error) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	rows, err := repo.conn(ctx).Query(ctx, getScannedBarcodes, receptionID, barcodes)
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return nil, err
+	}
+	defer rows.Close()
+
+	result := []string{}
+	for rows.Next() {
+		var barcode string
+		if err := rows.Scan(&barcode); err != nil {
+			loggerVar.Error(err.Error())
+			return nil, err
+		}
+		result = append(result, barcode)
+	}
+
+	if err := rows.Err(); err != nil {
+		loggerVar.Error(err.Error())
+		return nil, err
+	}
+
+	loggerVar.Info("Successful")
+	return result, nil
+}
+
+func (repo *PvzRepo) GetProductByID(ctx context.Context, id uuid.UUID) (models.Product, error) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	var (
+		product      productRow
+		deletedAt    sql.NullTime
+		deleteReason sql.NullString
+	)
+	err := repo.conn(ctx).QueryRow(ctx, getProductById, id).Scan(append(product.dest(), &deletedAt, &deleteReason)...)
+	if errors.Is(err, pgx.ErrNoRows) {
+		loggerVar.Error(pvz.ErrProductNotFound.Error())
+		return models.Product{}, pvz.ErrProductNotFound
+	}
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return models.Product{}, err
+	}
+
+	result := product.toModel()
+	if deletedAt.Valid {
+		result.DeletedAt = &deletedAt.Time
+		result.DeleteReason = deleteReason.String
+	}
+
+	loggerVar.Info("Successful")
+	return result, nil
+}
+
+func (repo *PvzRepo) GetProductPvzID(ctx context.Context, id uuid.UUID) (uuid.UUID, error) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	var pvzID uuid.UUID
+	err := repo.conn(ctx).QueryRow(ctx, getProductPvzId, id).Scan(&pvzID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		loggerVar.Error(pvz.ErrProductNotFound.Error())
+		return uuid.Nil, pvz.ErrProductNotFound
+	}
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return uuid.Nil, err
+	}
+
+	loggerVar.Info("Successful")
+	return pvzID, nil
+}
+
+func (repo *PvzRepo) GetUserEmail(ctx context.Context, id uuid.UUID) (string, error) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	var email string
+	err := repo.conn(ctx).QueryRow(ctx, getUserEmail, id).Scan(&email)
+	if errors.Is(err, pgx.ErrNoRows) {
+		loggerVar.Info("user not found")
+		return "", nil
+	}
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return "", err
+	}
+
+	loggerVar.Info("Successful")
+	return email, nil
+}
+
+func (repo *PvzRepo) SoftDeleteProduct(ctx context.Context, id uuid.UUID, reason string) (time.Time, error) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	var deletedAt time.Time
+	err := repo.conn(ctx).QueryRow(ctx, softDeleteProduct, id, reason).Scan(&deletedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		// товар уже удалили параллельным запросом
+		loggerVar.Error(pvz.ErrProductNotFound.Error())
+		return time.Time{}, pvz.ErrProductNotFound
+	}
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return time.Time{}, err
+	}
+
+	loggerVar.Info("Successful")
+	return deletedAt, nil
+}
+
+func (repo *PvzRepo) RestoreProduct(ctx context.Context, id uuid.UUID, deletedAfter time.Time) error {
 	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
 
-	cmd, err := repo.db.Exec(ctx, updateReceptionStatus, status, id)
+	tag, err := repo.conn(ctx).Exec(ctx, restoreProduct, id, deletedAfter)
 	if err != nil {
 		loggerVar.Error(err.Error())
 		return err
 	}
-	if cmd.RowsAffected() == 0 {
-		return errors.New("nothing was updated")
+	if tag.RowsAffected() == 0 {
+		loggerVar.Error(pvz.ErrUndoWindowExpired.Error())
+		return pvz.ErrUndoWindowExpired
 	}
 
 	loggerVar.Info("Successful")
@@ -31,16 +31,21 @@ func TestIntegration_PvzReceptionFlow(t *testing.T) {
 		Id:               pvzID,
 		City:             "Москва",
 		RegistrationDate: time.Now(),
+		Metadata:         map[string]string{},
 	}
 	err := repo.InsertPvz(ctx, pvz)
 	require.NoError(t, err)
 
-	receptionID := uuid.NewV4()
+	receptionID, userID := uuid.NewV4(), uuid.NewV4()
+	_, err = db.Exec(ctx, "INSERT INTO users (id, email, role, password_hash) VALUES ($1, $2, 'employee', $3)",
+		userID, userID.String()+"@mail.ru", []byte("hash"))
+	require.NoError(t, err)
 	reception := models.Reception{
-		Id:       receptionID,
-		DateTime: time.Now(),
-		PvzId:    pvzID,
-		Status:   "active",
+		Id:        receptionID,
+		DateTime:  time.Now(),
+		PvzId:     pvzID,
+		Status:    "active",
+		CreatedBy: &userID,
 	}
 	err = repo.InsertReception(ctx, reception)
 	require.NoError(t, err)
@@ -56,25 +61,27 @@ func TestIntegration_PvzReceptionFlow(t *testing.T) {
 		require.NoError(t, err)
 	}
 
-	err = repo.UpdateReceptionStatus(ctx, receptionID, "closed")
+	_, err = repo.UpdateReceptionStatus(ctx, receptionID, "closed", &userID)
 	require.NoError(t, err)
 
 	receptionFromDB, err := repo.GetReceptionByID(ctx, receptionID)
 	require.NoError(t, err)
 	require.Equal(t, "closed", receptionFromDB.Status)
+	require.Equal(t, &userID, receptionFromDB.CreatedBy)
+
+	pvzs, err := repo.GetPvz(ctx, models.PvzFilter{Page: 1, Limit: 10})
+	require.NoError(t, err)
+
+	require.NotEmpty(t, pvzs)
 
-	pvzs, err := repo.GetPvz(ctx, nil, nil, 1, 10)
+	receptions, err := repo.GetReceptionsByPvzIDs(ctx, []uuid.UUID{pvzID}, nil, nil)
 	require.NoError(t, err)
 
 	var found bool
-	for _, p := range pvzs {
-		if p.Id == pvzID {
-			for _, r := range p.Receptions {
-				if r.Id == receptionID {
-					require.Len(t, r.Products, 50)
-					found = true
-				}
-			}
+	for _, r := range receptions {
+		if r.Id == receptionID {
+			require.Len(t, r.Products, 50)
+			found = true
 		}
 	}
 	require.True(t, found, "Reception with products is not found")
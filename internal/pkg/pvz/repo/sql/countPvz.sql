@@ -0,0 +1 @@
+SELECT count(*) FROM pvz WHERE $1 OR status <> 'closed'
\ No newline at end of file
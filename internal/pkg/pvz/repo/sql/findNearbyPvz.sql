@@ -0,0 +1,31 @@
+-- Расстояние считается по формуле гаверсинуса на сфере радиусом 6371 км;
+-- least защищает asin от погрешности округления у противоположных точек
+WITH nearby AS (
+    SELECT id, registration_date, city, address, latitude, longitude, status, metadata, version, closed_at,
+        capacity_items, capacity_volume_cm3, capacity_weight_grams,
+        2 * 6371000 * asin(least(1, sqrt(
+            power(sin(radians(latitude - $1::float8) / 2), 2)
+            + cos(radians($1::float8)) * cos(radians(latitude)) * power(sin(radians(longitude - $2::float8) / 2), 2)
+        ))) AS distance
+    FROM pvz
+    WHERE latitude BETWEEN $1::float8 - degrees($3::float8 / 6371000) AND $1::float8 + degrees($3::float8 / 6371000)
+        AND (status::text = $4::text OR ($4::text = '' AND status <> 'closed'))
+)
+SELECT id, registration_date, city, address, latitude, longitude, status, metadata, version, closed_at,
+    capacity_items, capacity_volume_cm3, capacity_weight_grams, distance
+FROM nearby
+WHERE distance <= $3::float8
+    AND (NOT $5::boolean OR (
+        SELECT
+            (nearby.capacity_items IS NULL OR count(*) < nearby.capacity_items)
+            AND (nearby.capacity_volume_cm3 IS NULL
+                OR coalesce(sum(product.length_mm::bigint * product.width_mm * product.height_mm), 0) / 1000 < nearby.capacity_volume_cm3)
+            AND (nearby.capacity_weight_grams IS NULL OR coalesce(sum(product.weight_grams), 0) < nearby.capacity_weight_grams)
+        FROM product
+        JOIN reception ON reception.id = product.reception_id
+        WHERE reception.pvz_id = nearby.id
+            AND product.status IN ('received', 'ready_for_pickup')
+            AND product.deleted_at IS NULL
+    ))
+ORDER BY distance, id
+LIMIT $6
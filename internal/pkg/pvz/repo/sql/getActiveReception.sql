@@ -1 +1 @@
-SELECT * FROM reception WHERE pvz_id = $1 AND status = 'in_progress' ORDER BY reception_time DESC LIMIT 1
\ No newline at end of file
+SELECT id, reception_time, pvz_id, status, created_by, closed_by FROM reception WHERE pvz_id = $1 AND status = 'in_progress' ORDER BY reception_time DESC LIMIT 1
\ No newline at end of file
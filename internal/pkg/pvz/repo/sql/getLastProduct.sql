@@ -1,6 +1,8 @@
-SELECT p.id, p.reception_time, p.category, p.reception_id
+SELECT p.id, p.reception_time, p.category, p.reception_id,
+        p.barcode, p.barcode_format, p.sku, p.order_number,
+        p.weight_grams, p.length_mm, p.width_mm, p.height_mm, p.status, p.created_by
         FROM product p
         JOIN reception r ON p.reception_id = r.id
-        WHERE r.pvz_id = $1 AND r.status = 'in_progress'
+        WHERE r.pvz_id = $1 AND r.status = 'in_progress' AND p.deleted_at IS NULL
         ORDER BY p.reception_time DESC
-        LIMIT 1
\ No newline at end of file
+        LIMIT 1
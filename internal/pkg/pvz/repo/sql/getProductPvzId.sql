@@ -0,0 +1,4 @@
+SELECT reception.pvz_id
+FROM product
+JOIN reception ON reception.id = product.reception_id
+WHERE product.id = $1
@@ -0,0 +1,11 @@
+SELECT
+    product.id, product.reception_time, product.category, product.reception_id,
+    product.barcode, product.barcode_format, product.sku, product.order_number,
+    product.weight_grams, product.length_mm, product.width_mm, product.height_mm, product.status, product.created_by,
+    reception.pvz_id, pvz.city, reception.status
+FROM product
+JOIN reception ON reception.id = product.reception_id
+JOIN pvz ON pvz.id = reception.pvz_id
+WHERE product.barcode = $1 AND product.deleted_at IS NULL
+ORDER BY product.reception_time DESC, product.id
+LIMIT $2
@@ -0,0 +1,6 @@
+SELECT id, reception_time, category, reception_id,
+    barcode, barcode_format, sku, order_number,
+    weight_grams, length_mm, width_mm, height_mm, status, created_by
+FROM product
+WHERE reception_id = $1 AND deleted_at IS NULL
+ORDER BY reception_time, id
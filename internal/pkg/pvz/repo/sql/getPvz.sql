@@ -1,13 +1,7 @@
-SELECT 
-    pvz.id, pvz.city, pvz.registration_date,
-    reception.id, reception.reception_time, reception.status,
-    product.id, product.reception_time, product.category
+SELECT id, registration_date, city, address, latitude, longitude, status, metadata, version, closed_at,
+    capacity_items, capacity_volume_cm3, capacity_weight_grams
 FROM pvz
-LEFT JOIN reception 
-    ON pvz.id = reception.pvz_id 
-    AND ($1::timestamptz IS NULL OR reception.reception_time >= $1)
-    AND ($2::timestamptz IS NULL OR reception.reception_time <= $2)
-LEFT JOIN product 
-    ON product.reception_id = reception.id
-ORDER BY pvz.id, reception.reception_time NULLS LAST
-LIMIT $3 OFFSET $4;
+WHERE ($1::date IS NULL OR (registration_date, id) > ($1::date, $2::uuid))
+    AND ($5 OR status <> 'closed')
+ORDER BY registration_date, id
+LIMIT $3 OFFSET $4
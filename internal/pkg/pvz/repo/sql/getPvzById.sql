@@ -0,0 +1,2 @@
+SELECT id, registration_date, city, address, latitude, longitude, status, metadata, version, closed_at,
+    capacity_items, capacity_volume_cm3, capacity_weight_grams FROM pvz WHERE id = $1
\ No newline at end of file
@@ -0,0 +1,9 @@
+SELECT
+    count(*),
+    (coalesce(sum(product.length_mm::bigint * product.width_mm * product.height_mm), 0) / 1000)::bigint,
+    coalesce(sum(product.weight_grams), 0)
+FROM product
+JOIN reception ON reception.id = product.reception_id
+WHERE reception.pvz_id = $1
+    AND product.status IN ('received', 'ready_for_pickup')
+    AND product.deleted_at IS NULL
\ No newline at end of file
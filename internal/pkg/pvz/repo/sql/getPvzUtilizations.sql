@@ -0,0 +1,13 @@
+SELECT
+    pvz.id, pvz.city, pvz.capacity_items, pvz.capacity_volume_cm3, pvz.capacity_weight_grams,
+    count(product.id),
+    (coalesce(sum(product.length_mm::bigint * product.width_mm * product.height_mm), 0) / 1000)::bigint,
+    coalesce(sum(product.weight_grams), 0)
+FROM pvz
+LEFT JOIN reception ON reception.pvz_id = pvz.id
+LEFT JOIN product ON product.reception_id = reception.id
+    AND product.status IN ('received', 'ready_for_pickup')
+    AND product.deleted_at IS NULL
+WHERE pvz.status <> 'closed'
+    AND (pvz.capacity_items IS NOT NULL OR pvz.capacity_volume_cm3 IS NOT NULL OR pvz.capacity_weight_grams IS NOT NULL)
+GROUP BY pvz.id
\ No newline at end of file
@@ -1 +1 @@
-SELECT id, reception_time, pvz_id, status FROM reception WHERE id=$1
\ No newline at end of file
+SELECT id, reception_time, pvz_id, status, closed_at, created_by, closed_by FROM reception WHERE id=$1
\ No newline at end of file
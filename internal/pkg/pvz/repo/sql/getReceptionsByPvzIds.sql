@@ -0,0 +1,13 @@
+SELECT
+    reception.id, reception.reception_time, reception.pvz_id, reception.status,
+    reception.created_by, reception.closed_by,
+    product.id, product.reception_time, product.category, product.reception_id,
+    product.barcode, product.barcode_format, product.sku, product.order_number,
+    product.weight_grams, product.length_mm, product.width_mm, product.height_mm, product.status, product.created_by
+FROM reception
+LEFT JOIN product
+    ON product.reception_id = reception.id AND product.deleted_at IS NULL
+WHERE reception.pvz_id = ANY($1::uuid[])
+    AND ($2::timestamptz IS NULL OR reception.reception_time >= $2)
+    AND ($3::timestamptz IS NULL OR reception.reception_time <= $3)
+ORDER BY reception.pvz_id, reception.reception_time, reception.id, product.reception_time, product.id
@@ -0,0 +1,3 @@
+SELECT DISTINCT barcode
+FROM product
+WHERE reception_id = $1 AND barcode = ANY($2::text[]) AND deleted_at IS NULL
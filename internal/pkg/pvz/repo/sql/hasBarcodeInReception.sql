@@ -0,0 +1 @@
+SELECT EXISTS(SELECT 1 FROM product WHERE reception_id = $1 AND barcode = $2 AND deleted_at IS NULL)
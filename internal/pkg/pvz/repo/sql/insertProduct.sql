@@ -1 +1,5 @@
-INSERT INTO product (id, reception_time, reception_id, category) VALUES ($1, $2, $3, $4)
\ No newline at end of file
+INSERT INTO product (
+    id, reception_time, reception_id, category,
+    barcode, barcode_format, sku, order_number,
+    weight_grams, length_mm, width_mm, height_mm, created_by
+) VALUES ($1, $2, $3, $4, NULLIF($5, ''), NULLIF($6, ''), NULLIF($7, ''), NULLIF($8, ''), $9, $10, $11, $12, $13)
@@ -1 +1,2 @@
-INSERT INTO pvz (id, registration_date, city) VALUES ($1, $2, $3)
\ No newline at end of file
+INSERT INTO pvz (id, registration_date, city, address, metadata, capacity_items, capacity_volume_cm3, capacity_weight_grams, latitude, longitude)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
\ No newline at end of file
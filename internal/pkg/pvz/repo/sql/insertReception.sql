@@ -1 +1 @@
-INSERT INTO reception (id, reception_time, pvz_id, status) VALUES ($1, $2, $3, $4)
\ No newline at end of file
+INSERT INTO reception (id, reception_time, pvz_id, status, created_by) VALUES ($1, $2, $3, $4, $5)
\ No newline at end of file
@@ -0,0 +1 @@
+SELECT id FROM pvz WHERE id = $1 FOR UPDATE
@@ -0,0 +1,3 @@
+UPDATE product
+SET deleted_at = NULL, delete_reason = NULL
+WHERE id = $1 AND deleted_at >= $2
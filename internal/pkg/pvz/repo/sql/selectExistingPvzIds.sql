@@ -0,0 +1 @@
+SELECT id FROM pvz WHERE id = ANY($1::uuid[])
\ No newline at end of file
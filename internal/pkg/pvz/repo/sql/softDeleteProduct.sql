@@ -0,0 +1,4 @@
+UPDATE product
+SET deleted_at = now(), delete_reason = $2
+WHERE id = $1 AND deleted_at IS NULL
+RETURNING deleted_at
@@ -0,0 +1,16 @@
+UPDATE pvz
+SET city = $2,
+    status = $3,
+    metadata = $4,
+    address = $6,
+    capacity_items = $7,
+    capacity_volume_cm3 = $8,
+    capacity_weight_grams = $9,
+    latitude = $10,
+    longitude = $11,
+    version = version + 1,
+    updated_at = now(),
+    closed_at = CASE WHEN $3 = 'closed' THEN coalesce(closed_at, now()) ELSE closed_at END
+WHERE id = $1 AND version = $5
+RETURNING id, registration_date, city, address, latitude, longitude, status, metadata, version, closed_at,
+    capacity_items, capacity_volume_cm3, capacity_weight_grams
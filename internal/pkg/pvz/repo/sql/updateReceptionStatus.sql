@@ -1 +1,6 @@
-UPDATE reception SET status=$1 WHERE id=$2
\ No newline at end of file
+UPDATE reception
+SET status = $1::reception_status,
+    closed_at = CASE WHEN $1::reception_status = 'close' THEN now() ELSE closed_at END,
+    closed_by = CASE WHEN $1::reception_status = 'close' THEN $3 ELSE closed_by END
+WHERE id = $2
+RETURNING closed_at
\ No newline at end of file
@@ -2,59 +2,494 @@ package usecase
 
 import (
 	"context"
+	"encoding/json"
 	"log/slog"
+	"slices"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/K1tten2005/avito_pvz/internal/models"
+	"github.com/K1tten2005/avito_pvz/internal/pkg/outbox"
 	"github.com/K1tten2005/avito_pvz/internal/pkg/pvz"
 	"github.com/K1tten2005/avito_pvz/internal/pkg/utils/logger"
+	"github.com/K1tten2005/avito_pvz/internal/pkg/utils/pagination"
+	"github.com/K1tten2005/avito_pvz/internal/pkg/utils/principal"
+	"github.com/K1tten2005/avito_pvz/internal/pkg/utils/validation"
 	"github.com/satori/uuid"
 )
 
+// Config — настраиваемые параметры бизнес-логики ПВЗ
+type Config struct {
+	// UndoWindow — сколько времени удалённый товар можно восстановить
+	UndoWindow time.Duration
+	// CapacityWarnRatio — доля заполнения ПВЗ, после которой приёмка получает предупреждение; 0 отключает его
+	CapacityWarnRatio float64
+}
+
 type PvzUsecase struct {
-	repo pvz.PvzRepo
+	repo     pvz.PvzRepo
+	outbox   outbox.OutboxRepo
+	schedule pvz.ScheduleChecker
+	cfg      Config
+}
+
+func CreatePvzUsecase(repo pvz.PvzRepo, outboxRepo outbox.OutboxRepo, schedule pvz.ScheduleChecker, cfg Config) *PvzUsecase {
+	return &PvzUsecase{repo: repo, outbox: outboxRepo, schedule: schedule, cfg: cfg}
+}
+
+// emit пишет доменное событие в outbox; вызывать внутри WithinTx вместе с изменением данных
+func (uc *PvzUsecase) emit(ctx context.Context, eventType string, pvzID uuid.UUID, payload any) error {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	return uc.outbox.AddEvent(ctx, models.Event{
+		Id:          uuid.NewV4(),
+		Type:        eventType,
+		AggregateId: pvzID,
+		CreatedAt:   time.Now(),
+		Payload:     data,
+	})
+}
+
+const (
+	warnDuplicateBarcode = "barcode already scanned in this reception"
+	warnPvzNearlyFull    = "pvz is nearly full"
+	maxBarcodeMatches    = 50
+	maxProductBatchSize  = 100
+	maxPvzImportRows     = 1000
+	defaultNearbyRadius  = 5000
+	maxNearbyRadius      = 50000
+	defaultNearbyLimit   = 20
+	maxNearbyLimit       = 100
+)
+
+// pvzDateLayouts — допустимые форматы даты регистрации в CSV импорта
+var pvzDateLayouts = []string{time.DateOnly, time.RFC3339}
+
+var pvzStatusTransitions = map[string][]string{
+	models.PvzStatusActive:    {models.PvzStatusSuspended, models.PvzStatusClosed},
+	models.PvzStatusSuspended: {models.PvzStatusActive, models.PvzStatusClosed},
+}
+
+func (uc *PvzUsecase) CreatePvz(ctx context.Context, pvzItem models.PVZ) (models.PVZ, error) {
+	if city, ok := validation.NormalizeCity(pvzItem.City); ok {
+		pvzItem.City = city
+	}
+	pvzItem.Status = models.PvzStatusActive
+	pvzItem.Version = 1
+	if pvzItem.Metadata == nil {
+		pvzItem.Metadata = map[string]string{}
+	}
+	if pvzItem.Receptions == nil {
+		pvzItem.Receptions = []models.Reception{}
+	}
+	pvzItem.Utilization = nil
+	if !validCapacity(pvzItem.Capacity) {
+		return models.PVZ{}, pvz.ErrInvalidCapacity
+	}
+	if !validation.IsValidCoordinates(pvzItem.Latitude, pvzItem.Longitude) {
+		return models.PVZ{}, pvz.ErrInvalidCoordinates
+	}
+
+	err := uc.repo.WithinTx(ctx, func(ctx context.Context) error {
+		if err := uc.repo.InsertPvz(ctx, pvzItem); err != nil {
+			return err
+		}
+		return uc.emit(ctx, models.EventPvzCreated, pvzItem.Id, pvzItem)
+	})
+	if err != nil {
+		return models.PVZ{}, err
+	}
+	return pvzItem, nil
+}
+
+func (uc *PvzUsecase) ImportPvz(ctx context.Context, rows []models.PvzImportRow, dryRun bool) (models.PvzImportReport, error) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	if len(rows) == 0 {
+		loggerVar.Error(pvz.ErrEmptyImport.Error())
+		return models.PvzImportReport{}, pvz.ErrEmptyImport
+	}
+	if len(rows) > maxPvzImportRows {
+		loggerVar.Error(pvz.ErrImportTooLarge.Error())
+		return models.PvzImportReport{}, pvz.ErrImportTooLarge
+	}
+
+	// файл импортируется целиком, поэтому сначала проверяем все строки
+	report := models.PvzImportReport{DryRun: dryRun, Total: len(rows), Items: make([]models.PvzImportItem, len(rows))}
+	pvzList := make([]models.PVZ, len(rows))
+	lines := map[uuid.UUID]int{}
+	now := time.Now()
+	for i, row := range rows {
+		report.Items[i] = models.PvzImportItem{Line: row.Line, Status: models.ImportItemValid}
+
+		pvzItem, err := newImportedPvz(row, now)
+		if err == nil {
+			if _, ok := lines[pvzItem.Id]; ok {
+				err = pvz.ErrDuplicatePvzID
+			} else {
+				lines[pvzItem.Id] = i
+			}
+		}
+		if err != nil {
+			report.Items[i].Status = models.ImportItemRejected
+			report.Items[i].Error = err.Error()
+			continue
+		}
+		pvzList[i] = pvzItem
+	}
+
+	ids := make([]uuid.UUID, 0, len(lines))
+	for id := range lines {
+		ids = append(ids, id)
+	}
+	if len(ids) > 0 {
+		existing, err := uc.repo.GetExistingPvzIDs(ctx, ids)
+		if err != nil {
+			loggerVar.Error(err.Error())
+			return models.PvzImportReport{}, err
+		}
+		for _, id := range existing {
+			report.Items[lines[id]].Status = models.ImportItemRejected
+			report.Items[lines[id]].Error = pvz.ErrPvzExists.Error()
+		}
+	}
+
+	invalid := false
+	for i := range report.Items {
+		if report.Items[i].Status == models.ImportItemRejected {
+			invalid = true
+			continue
+		}
+		report.Items[i].Pvz = &pvzList[i]
+	}
+	if invalid {
+		loggerVar.Error(pvz.ErrInvalidImport.Error())
+		return report, pvz.ErrInvalidImport
+	}
+	if dryRun {
+		loggerVar.Info("Success")
+		return report, nil
+	}
+
+	err := uc.repo.WithinTx(ctx, func(ctx context.Context) error {
+		if err := uc.repo.InsertPvzs(ctx, pvzList); err != nil {
+			return err
+		}
+		for _, pvzItem := range pvzList {
+			if err := uc.emit(ctx, models.EventPvzCreated, pvzItem.Id, pvzItem); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return models.PvzImportReport{}, err
+	}
+
+	report.Imported = len(pvzList)
+	for i := range report.Items {
+		report.Items[i].Status = models.ImportItemCreated
+	}
+
+	loggerVar.Info("Success")
+	return report, nil
+}
+
+// newImportedPvz разбирает строку импорта; пустой id генерируется, пустая дата — текущая
+func newImportedPvz(row models.PvzImportRow, now time.Time) (models.PVZ, error) {
+	pvzItem := models.PVZ{
+		Id:               uuid.NewV4(),
+		RegistrationDate: now,
+		Address:          strings.TrimSpace(row.Address),
+		Status:           models.PvzStatusActive,
+		Metadata:         map[string]string{},
+		Version:          1,
+		Receptions:       []models.Reception{},
+	}
+
+	if id := strings.TrimSpace(row.Id); id != "" {
+		parsed, err := uuid.FromString(id)
+		if err != nil || parsed == uuid.Nil {
+			return models.PVZ{}, pvz.ErrInvalidPvzID
+		}
+		pvzItem.Id = parsed
+	}
+
+	city, ok := validation.NormalizeCity(row.City)
+	if !ok {
+		return models.PVZ{}, pvz.ErrInvalidCity
+	}
+	pvzItem.City = city
+
+	if date := strings.TrimSpace(row.RegistrationDate); date != "" {
+		parsed, err := parsePvzDate(date)
+		if err != nil {
+			return models.PVZ{}, pvz.ErrInvalidRegDate
+		}
+		pvzItem.RegistrationDate = parsed
+	}
+
+	latitude, longitude := strings.TrimSpace(row.Latitude), strings.TrimSpace(row.Longitude)
+	if latitude != "" || longitude != "" {
+		lat, latErr := strconv.ParseFloat(latitude, 64)
+		lon, lonErr := strconv.ParseFloat(longitude, 64)
+		if latErr != nil || lonErr != nil || !validation.IsValidCoordinates(&lat, &lon) {
+			return models.PVZ{}, pvz.ErrInvalidCoordinates
+		}
+		pvzItem.Latitude, pvzItem.Longitude = &lat, &lon
+	}
+	return pvzItem, nil
+}
+
+func parsePvzDate(value string) (time.Time, error) {
+	var err error
+	for _, layout := range pvzDateLayouts {
+		var parsed time.Time
+		if parsed, err = time.Parse(layout, value); err == nil {
+			return parsed, nil
+		}
+	}
+	return time.Time{}, err
 }
 
-func CreatePvzUsecase(repo pvz.PvzRepo) *PvzUsecase {
-	return &PvzUsecase{repo: repo}
+func (uc *PvzUsecase) GetPvz(ctx context.Context, filter models.PvzFilter) (models.PvzPage, error) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	// Запрашиваем на один ПВЗ больше, чтобы понять, есть ли следующая страница
+	limit := filter.Limit
+	filter.Limit = limit + 1
+
+	pvzList, err := uc.repo.GetPvz(ctx, filter)
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return models.PvzPage{}, err
+	}
+
+	page := models.PvzPage{Items: pvzList}
+	if len(pvzList) > limit {
+		page.Items = pvzList[:limit]
+		last := page.Items[limit-1]
+		page.NextCursor = pagination.EncodeCursor(models.PvzCursor{
+			RegistrationDate: last.RegistrationDate,
+			Id:               last.Id,
+		})
+	}
+
+	page.Total, err = uc.repo.CountPvz(ctx, filter.IncludeArchived)
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return models.PvzPage{}, err
+	}
+
+	if len(page.Items) == 0 {
+		loggerVar.Info("Success")
+		return page, nil
+	}
+
+	ids := make([]uuid.UUID, 0, len(page.Items))
+	index := make(map[uuid.UUID]int, len(page.Items))
+	for i, item := range page.Items {
+		ids = append(ids, item.Id)
+		index[item.Id] = i
+	}
+
+	receptions, err := uc.repo.GetReceptionsByPvzIDs(ctx, ids, filter.StartDate, filter.EndDate)
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return models.PvzPage{}, err
+	}
+
+	for _, reception := range receptions {
+		i := index[reception.PvzId]
+		page.Items[i].Receptions = append(page.Items[i].Receptions, reception)
+	}
+
+	loggerVar.Info("Success")
+	return page, nil
 }
 
-func (uc *PvzUsecase) CreatePvz(ctx context.Context, pvz models.PVZ) error {
-	return uc.repo.InsertPvz(ctx, pvz)
+func (uc *PvzUsecase) GetPvzByID(ctx context.Context, id uuid.UUID) (models.PVZ, error) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	pvzItem, err := uc.repo.GetPvzByID(ctx, id)
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return models.PVZ{}, err
+	}
+
+	pvzItem.Receptions, err = uc.repo.GetReceptionsByPvzIDs(ctx, []uuid.UUID{id}, nil, nil)
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return models.PVZ{}, err
+	}
+
+	occupancy, err := uc.repo.GetPvzOccupancy(ctx, id)
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return models.PVZ{}, err
+	}
+	uc.fillUtilization(pvzItem.Capacity, &occupancy)
+	pvzItem.Utilization = &occupancy
+
+	loggerVar.Info("Success")
+	return pvzItem, nil
 }
 
-func (uc *PvzUsecase) GetPvz(ctx context.Context, startDate, endDate *time.Time, page, limit int) ([]models.PVZ, error) {
+func (uc *PvzUsecase) FindNearbyPvz(ctx context.Context, filter models.NearbyPvzFilter) ([]models.NearbyPvz, error) {
 	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
 
-	pvz, err := uc.repo.GetPvz(ctx, startDate, endDate, page, limit)
+	if !validation.IsValidCoordinates(&filter.Latitude, &filter.Longitude) {
+		loggerVar.Error(pvz.ErrInvalidCoordinates.Error())
+		return nil, pvz.ErrInvalidCoordinates
+	}
+	if filter.RadiusMeters == 0 {
+		filter.RadiusMeters = defaultNearbyRadius
+	}
+	if !(filter.RadiusMeters > 0 && filter.RadiusMeters <= maxNearbyRadius) {
+		loggerVar.Error(pvz.ErrInvalidRadius.Error())
+		return nil, pvz.ErrInvalidRadius
+	}
+	if filter.Status != "" && !validation.IsValidPvzStatus(filter.Status) {
+		loggerVar.Error(pvz.ErrUnknownPvzStatus.Error())
+		return nil, pvz.ErrUnknownPvzStatus
+	}
+	if filter.Limit <= 0 {
+		filter.Limit = defaultNearbyLimit
+	}
+	filter.Limit = min(filter.Limit, maxNearbyLimit)
+
+	result, err := uc.repo.FindNearbyPvz(ctx, filter)
 	if err != nil {
 		loggerVar.Error(err.Error())
 		return nil, err
 	}
-	return pvz, nil
+
+	loggerVar.Info("Success")
+	return result, nil
 }
 
-func (uc *PvzUsecase) CreateReception(ctx context.Context, PvzId uuid.UUID) (models.Reception, error) {
+func (uc *PvzUsecase) UpdatePvz(ctx context.Context, id uuid.UUID, req models.UpdatePvzReq) (models.PVZ, error) {
 	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
 
-	active, err := uc.repo.HasActiveReception(ctx, PvzId)
+	var updated models.PVZ
+	// Блокировка ПВЗ не даёт открыть приёмку между проверкой и закрытием ПВЗ
+	err := uc.repo.WithinTx(ctx, func(ctx context.Context) error {
+		if err := uc.repo.LockPvz(ctx, id); err != nil {
+			return err
+		}
+
+		current, err := uc.repo.GetPvzByID(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		updated, err = applyPvzUpdate(current, req)
+		if err != nil {
+			return err
+		}
+
+		if updated.Status == models.PvzStatusClosed && current.Status != models.PvzStatusClosed {
+			active, err := uc.repo.HasActiveReception(ctx, id)
+			if err != nil {
+				return err
+			}
+			if active {
+				return pvz.ErrActiveReceptionExists
+			}
+		}
+
+		updated, err = uc.repo.UpdatePvz(ctx, updated, current.Version)
+		return err
+	})
 	if err != nil {
 		loggerVar.Error(err.Error())
-		return models.Reception{}, err
+		return models.PVZ{}, err
+	}
+
+	loggerVar.Info("Success")
+	return updated, nil
+}
+
+// applyPvzUpdate проверяет изменения и накладывает их на текущее состояние ПВЗ
+func applyPvzUpdate(current models.PVZ, req models.UpdatePvzReq) (models.PVZ, error) {
+	// Закрытый ПВЗ хранится только как архив вместе с историей приёмок
+	if current.Status == models.PvzStatusClosed {
+		return models.PVZ{}, pvz.ErrPvzClosed
 	}
-	if active {
-		loggerVar.Error(pvz.ErrActiveReceptionExists.Error())
-		return models.Reception{}, pvz.ErrActiveReceptionExists
+
+	if req.Version != nil && *req.Version != current.Version {
+		return models.PVZ{}, pvz.ErrPvzVersionConflict
 	}
 
-	reception := models.Reception{
-		Id:       uuid.NewV4(),
-		DateTime: time.Now(),
-		PvzId:    PvzId,
-		Status:   models.StatusInProgress,
+	updated := current
+	if req.City != nil {
+		city, ok := validation.NormalizeCity(*req.City)
+		if !ok {
+			return models.PVZ{}, pvz.ErrInvalidCity
+		}
+		updated.City = city
+	}
+
+	if req.Address != nil {
+		updated.Address = strings.TrimSpace(*req.Address)
 	}
 
-	err = uc.repo.CreateReception(ctx, reception)
+	// Координаты меняются только парой, чтобы точка не оказалась наполовину старой
+	if req.Latitude != nil || req.Longitude != nil {
+		if req.Latitude == nil || req.Longitude == nil || !validation.IsValidCoordinates(req.Latitude, req.Longitude) {
+			return models.PVZ{}, pvz.ErrInvalidCoordinates
+		}
+		updated.Latitude, updated.Longitude = req.Latitude, req.Longitude
+	}
+
+	// Вместимость заменяется целиком: не переданный лимит снимается
+	if req.Capacity != nil {
+		if !validCapacity(*req.Capacity) {
+			return models.PVZ{}, pvz.ErrInvalidCapacity
+		}
+		updated.Capacity = *req.Capacity
+	}
+
+	if req.Metadata != nil {
+		metadata := make(map[string]string, len(current.Metadata)+len(req.Metadata))
+		for key, val := range current.Metadata {
+			metadata[key] = val
+		}
+		// Пустое значение удаляет ключ
+		for key, val := range req.Metadata {
+			if val == "" {
+				delete(metadata, key)
+				continue
+			}
+			metadata[key] = val
+		}
+		updated.Metadata = metadata
+	}
+
+	if req.Status != nil && *req.Status != current.Status {
+		if !slices.Contains(pvzStatusTransitions[current.Status], *req.Status) {
+			return models.PVZ{}, pvz.ErrInvalidPvzStatus
+		}
+		updated.Status = *req.Status
+	}
+
+	return updated, nil
+}
+
+func (uc *PvzUsecase) GetReception(ctx context.Context, id uuid.UUID) (models.Reception, error) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	reception, err := uc.repo.GetReceptionByID(ctx, id)
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return models.Reception{}, err
+	}
+
+	reception.Products, err = uc.repo.GetProductsByReceptionID(ctx, reception.Id)
 	if err != nil {
 		loggerVar.Error(err.Error())
 		return models.Reception{}, err
@@ -64,23 +499,184 @@ func (uc *PvzUsecase) CreateReception(ctx context.Context, PvzId uuid.UUID) (mod
 	return reception, nil
 }
 
-func (uc *PvzUsecase) AddProduct(ctx context.Context, pvzID uuid.UUID, productType string) (*models.Product, error) {
+func (uc *PvzUsecase) GetReceptionAct(ctx context.Context, id uuid.UUID) (models.ReceptionAct, error) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	reception, err := uc.GetReception(ctx, id)
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return models.ReceptionAct{}, err
+	}
+	if reception.Status != models.StatusClose {
+		loggerVar.Error(pvz.ErrReceptionNotClosed.Error())
+		return models.ReceptionAct{}, pvz.ErrReceptionNotClosed
+	}
+
+	pvzModel, err := uc.repo.GetPvzByID(ctx, reception.PvzId)
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return models.ReceptionAct{}, err
+	}
+
+	var closedByEmail string
+	if reception.ClosedBy != nil {
+		closedByEmail, err = uc.repo.GetUserEmail(ctx, *reception.ClosedBy)
+		if err != nil {
+			loggerVar.Error(err.Error())
+			return models.ReceptionAct{}, err
+		}
+	}
+
+	counts := map[string]int{}
+	for _, product := range reception.Products {
+		counts[product.Type]++
+	}
+	totals := make([]models.ProductTypeTotal, 0, len(counts))
+	for productType, count := range counts {
+		totals = append(totals, models.ProductTypeTotal{Type: productType, Count: count})
+	}
+	slices.SortFunc(totals, func(a, b models.ProductTypeTotal) int {
+		return strings.Compare(a.Type, b.Type)
+	})
+
+	loggerVar.Info("Success")
+	return models.ReceptionAct{
+		Reception:     reception,
+		City:          pvzModel.City,
+		ClosedByEmail: closedByEmail,
+		Totals:        totals,
+	}, nil
+}
+
+func (uc *PvzUsecase) GetActiveReception(ctx context.Context, pvzID uuid.UUID) (models.Reception, error) {
 	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
 
+	if _, err := uc.repo.GetPvzByID(ctx, pvzID); err != nil {
+		loggerVar.Error(err.Error())
+		return models.Reception{}, err
+	}
+
 	reception, err := uc.repo.GetActiveReception(ctx, pvzID)
 	if err != nil {
+		loggerVar.Error(err.Error())
+		return models.Reception{}, err
+	}
+
+	reception.Products, err = uc.repo.GetProductsByReceptionID(ctx, reception.Id)
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return models.Reception{}, err
+	}
+
+	loggerVar.Info("Success")
+	return reception, nil
+}
+
+func (uc *PvzUsecase) CreateReception(ctx context.Context, PvzId uuid.UUID) (models.Reception, error) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	reception := models.Reception{
+		Id:        uuid.NewV4(),
+		DateTime:  time.Now(),
+		PvzId:     PvzId,
+		Status:    models.StatusInProgress,
+		CreatedBy: principal.UserID(ctx),
+	}
+
+	if err := uc.schedule.CheckOpen(ctx, PvzId, reception.DateTime); err != nil {
+		loggerVar.Error(err.Error())
+		return models.Reception{}, err
+	}
+
+	err := uc.repo.WithinTx(ctx, func(ctx context.Context) error {
+		if err := uc.repo.LockPvz(ctx, PvzId); err != nil {
+			return err
+		}
+
+		pvzItem, err := uc.repo.GetPvzByID(ctx, PvzId)
+		if err != nil {
+			return err
+		}
+		if pvzItem.Status != models.PvzStatusActive {
+			return pvz.ErrPvzNotActive
+		}
+
+		active, err := uc.repo.HasActiveReception(ctx, PvzId)
+		if err != nil {
+			return err
+		}
+		if active {
+			return pvz.ErrActiveReceptionExists
+		}
+
+		if err := uc.repo.CreateReception(ctx, reception); err != nil {
+			return err
+		}
+		return uc.emit(ctx, models.EventReceptionOpened, PvzId, reception)
+	})
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return models.Reception{}, err
+	}
+
+	loggerVar.Info("Success")
+	return reception, nil
+}
+
+func (uc *PvzUsecase) AddProduct(ctx context.Context, req models.AddProductReq) (*models.Product, error) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	if err := validateProductReq(&req); err != nil {
 		loggerVar.Error(err.Error())
 		return nil, err
 	}
 
-	product := &models.Product{
-		Id:          uuid.NewV4(),
-		DateTime:    time.Now(),
-		Type:        productType,
-		ReceptionId: reception.Id,
+	if err := uc.schedule.CheckOpen(ctx, req.PvzId, time.Now()); err != nil {
+		loggerVar.Error(err.Error())
+		return nil, err
 	}
 
-	if err := uc.repo.AddProduct(ctx, product); err != nil {
+	var product *models.Product
+	err := uc.repo.WithinTx(ctx, func(ctx context.Context) error {
+		// под блокировкой ПВЗ приёмку не закроют, пока товар не записан
+		if err := uc.repo.LockPvz(ctx, req.PvzId); err != nil {
+			return err
+		}
+
+		reception, err := uc.repo.GetActiveReception(ctx, req.PvzId)
+		if err != nil {
+			return err
+		}
+
+		product = newProduct(req, reception.Id, time.Now(), principal.UserID(ctx))
+
+		// повторный скан не блокируем: у одинаковых товаров бывает общий штрихкод
+		if product.Barcode != "" {
+			duplicate, err := uc.repo.HasBarcodeInReception(ctx, reception.Id, product.Barcode)
+			if err != nil {
+				return err
+			}
+			if duplicate {
+				loggerVar.Warn("barcode already scanned in this reception", slog.String("barcode", product.Barcode))
+				product.Warnings = append(product.Warnings, warnDuplicateBarcode)
+			}
+		}
+
+		nearlyFull, err := uc.reserveCapacity(ctx, req.PvzId, []models.Product{*product})
+		if err != nil {
+			return err
+		}
+		if nearlyFull {
+			loggerVar.Warn(warnPvzNearlyFull, slog.String("pvz_id", req.PvzId.String()))
+			product.Warnings = append(product.Warnings, warnPvzNearlyFull)
+		}
+
+		if err := uc.repo.AddProduct(ctx, product); err != nil {
+			return err
+		}
+		return uc.emit(ctx, models.EventProductAdded, req.PvzId, product)
+	})
+	if err != nil {
 		loggerVar.Error(err.Error())
 		return nil, err
 	}
@@ -89,26 +685,320 @@ func (uc *PvzUsecase) AddProduct(ctx context.Context, pvzID uuid.UUID, productTy
 	return product, nil
 }
 
-func (uc *PvzUsecase) DeleteProduct(ctx context.Context, pvzID uuid.UUID) error {
+func (uc *PvzUsecase) AddProducts(ctx context.Context, req models.AddProductsBatchReq) (models.ProductBatchResult, error) {
 	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
 
-	active, err := uc.repo.HasActiveReception(ctx, pvzID)
+	if len(req.Items) == 0 {
+		loggerVar.Error(pvz.ErrEmptyBatch.Error())
+		return models.ProductBatchResult{}, pvz.ErrEmptyBatch
+	}
+	if len(req.Items) > maxProductBatchSize {
+		loggerVar.Error(pvz.ErrBatchTooLarge.Error())
+		return models.ProductBatchResult{}, pvz.ErrBatchTooLarge
+	}
+
+	// пачка вставляется целиком, поэтому сначала проверяем все позиции
+	result := models.ProductBatchResult{Items: make([]models.ProductBatchItem, len(req.Items))}
+	invalid := false
+	for i := range req.Items {
+		req.Items[i].PvzId = req.PvzId
+		result.Items[i] = models.ProductBatchItem{Index: i, Status: models.BatchItemSkipped}
+		if err := validateProductReq(&req.Items[i]); err != nil {
+			result.Items[i].Status = models.BatchItemRejected
+			result.Items[i].Error = err.Error()
+			invalid = true
+		}
+	}
+	if invalid {
+		loggerVar.Error(pvz.ErrInvalidBatch.Error())
+		return result, pvz.ErrInvalidBatch
+	}
+
+	if err := uc.schedule.CheckOpen(ctx, req.PvzId, time.Now()); err != nil {
+		loggerVar.Error(err.Error())
+		return models.ProductBatchResult{}, err
+	}
+
+	barcodes := []string{}
+	for _, item := range req.Items {
+		if item.Barcode != "" {
+			barcodes = append(barcodes, item.Barcode)
+		}
+	}
+
+	var (
+		reception models.Reception
+		products  []models.Product
+	)
+	err := uc.repo.WithinTx(ctx, func(ctx context.Context) error {
+		if err := uc.repo.LockPvz(ctx, req.PvzId); err != nil {
+			return err
+		}
+
+		var err error
+		reception, err = uc.repo.GetActiveReception(ctx, req.PvzId)
+		if err != nil {
+			return err
+		}
+
+		seen := map[string]bool{}
+		if len(barcodes) > 0 {
+			scanned, err := uc.repo.GetScannedBarcodes(ctx, reception.Id, barcodes)
+			if err != nil {
+				return err
+			}
+			for _, barcode := range scanned {
+				seen[barcode] = true
+			}
+		}
+
+		// сдвигаем время на микросекунду, чтобы сохранить порядок сканирования для удаления последнего товара
+		now, createdBy := time.Now(), principal.UserID(ctx)
+		products = make([]models.Product, 0, len(req.Items))
+		for i, item := range req.Items {
+			product := newProduct(item, reception.Id, now.Add(time.Duration(i)*time.Microsecond), createdBy)
+			if product.Barcode != "" {
+				if seen[product.Barcode] {
+					product.Warnings = append(product.Warnings, warnDuplicateBarcode)
+				}
+				seen[product.Barcode] = true
+			}
+			products = append(products, *product)
+		}
+
+		nearlyFull, err := uc.reserveCapacity(ctx, req.PvzId, products)
+		if err != nil {
+			return err
+		}
+		if nearlyFull {
+			loggerVar.Warn(warnPvzNearlyFull, slog.String("pvz_id", req.PvzId.String()))
+			result.Warnings = append(result.Warnings, warnPvzNearlyFull)
+		}
+
+		if err := uc.repo.AddProducts(ctx, products); err != nil {
+			return err
+		}
+		for _, product := range products {
+			if err := uc.emit(ctx, models.EventProductAdded, req.PvzId, product); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
 	if err != nil {
 		loggerVar.Error(err.Error())
-		return err
+		return models.ProductBatchResult{}, err
+	}
+
+	result.ReceptionId = &reception.Id
+	result.Created = len(products)
+	for i := range products {
+		result.Items[i].Status = models.BatchItemCreated
+		result.Items[i].Product = &products[i]
 	}
-	if !active {
-		loggerVar.Error(pvz.ErrNoActiveReception.Error())
-		return pvz.ErrNoActiveReception
+
+	loggerVar.Info("Success")
+	return result, nil
+}
+
+// validateProductReq проверяет позицию и дописывает формат штрихкода, если клиент его не указал
+func validateProductReq(req *models.AddProductReq) error {
+	if !validation.IsValidProductType(req.Type) {
+		return pvz.ErrInvalidProductType
 	}
 
-	product, err := uc.repo.GetLastProduct(ctx, pvzID)
+	if req.Barcode != "" {
+		if req.BarcodeFormat == "" {
+			req.BarcodeFormat = validation.DetectBarcodeFormat(req.Barcode)
+		}
+		if !validation.IsValidBarcode(req.BarcodeFormat, req.Barcode, req.BarcodeChecksum) {
+			return pvz.ErrInvalidBarcode
+		}
+	} else if req.BarcodeFormat != "" || req.BarcodeChecksum != nil {
+		return pvz.ErrInvalidBarcode
+	}
+
+	if !validMeasurements(req.WeightGrams, req.Dimensions) {
+		return pvz.ErrInvalidMeasurements
+	}
+	return nil
+}
+
+func newProduct(req models.AddProductReq, receptionID uuid.UUID, dateTime time.Time, createdBy *uuid.UUID) *models.Product {
+	return &models.Product{
+		Id:            uuid.NewV4(),
+		DateTime:      dateTime,
+		Type:          req.Type,
+		ReceptionId:   receptionID,
+		Barcode:       req.Barcode,
+		BarcodeFormat: req.BarcodeFormat,
+		Sku:           req.Sku,
+		OrderNumber:   req.OrderNumber,
+		WeightGrams:   req.WeightGrams,
+		Dimensions:    req.Dimensions,
+		Status:        models.ProductStatusReceived,
+		CreatedBy:     createdBy,
+	}
+}
+
+func validMeasurements(weight *int, dims *models.Dimensions) bool {
+	if weight != nil && *weight <= 0 {
+		return false
+	}
+	if dims != nil && (dims.LengthMm <= 0 || dims.WidthMm <= 0 || dims.HeightMm <= 0) {
+		return false
+	}
+	return true
+}
+
+// reserveCapacity проверяет, что товары помещаются в ПВЗ, и возвращает true, если после приёмки
+// заполнение достигнет мягкого порога. Вызывать внутри WithinTx после LockPvz: блокировка не даёт
+// параллельным приёмкам вместе превысить лимит.
+func (uc *PvzUsecase) reserveCapacity(ctx context.Context, pvzID uuid.UUID, products []models.Product) (bool, error) {
+	pvzItem, err := uc.repo.GetPvzByID(ctx, pvzID)
+	if err != nil {
+		return false, err
+	}
+	if !pvzItem.Capacity.IsSet() {
+		return false, nil
+	}
+
+	occupancy, err := uc.repo.GetPvzOccupancy(ctx, pvzID)
+	if err != nil {
+		return false, err
+	}
+	for _, product := range products {
+		occupancy.Items++
+		if product.Dimensions != nil {
+			occupancy.VolumeCm3 += product.Dimensions.LengthMm * product.Dimensions.WidthMm * product.Dimensions.HeightMm / 1000
+		}
+		if product.WeightGrams != nil {
+			occupancy.WeightGrams += *product.WeightGrams
+		}
+	}
+
+	if exceedsCapacity(pvzItem.Capacity, occupancy) {
+		return false, pvz.ErrPvzFull
+	}
+	uc.fillUtilization(pvzItem.Capacity, &occupancy)
+	return occupancy.NearlyFull, nil
+}
+
+// fillUtilization дописывает долю заполнения по лимитам ПВЗ; без лимитов оставляет только счётчики
+func (uc *PvzUsecase) fillUtilization(capacity models.PvzCapacity, occupancy *models.PvzUtilization) {
+	if !capacity.IsSet() {
+		return
+	}
+
+	ratio := 0.0
+	for _, limit := range capacityLimits(capacity, *occupancy) {
+		ratio = max(ratio, float64(limit.used)/float64(limit.max))
+	}
+	occupancy.Ratio = &ratio
+	occupancy.NearlyFull = uc.cfg.CapacityWarnRatio > 0 && ratio >= uc.cfg.CapacityWarnRatio
+}
+
+type capacityLimit struct {
+	used, max int
+}
+
+// capacityLimits сопоставляет занятость с заданными лимитами ПВЗ
+func capacityLimits(capacity models.PvzCapacity, occupancy models.PvzUtilization) []capacityLimit {
+	limits := make([]capacityLimit, 0, 3)
+	if capacity.MaxItems != nil {
+		limits = append(limits, capacityLimit{used: occupancy.Items, max: *capacity.MaxItems})
+	}
+	if capacity.MaxVolumeCm3 != nil {
+		limits = append(limits, capacityLimit{used: occupancy.VolumeCm3, max: *capacity.MaxVolumeCm3})
+	}
+	if capacity.MaxWeightGrams != nil {
+		limits = append(limits, capacityLimit{used: occupancy.WeightGrams, max: *capacity.MaxWeightGrams})
+	}
+	return limits
+}
+
+func exceedsCapacity(capacity models.PvzCapacity, occupancy models.PvzUtilization) bool {
+	return slices.ContainsFunc(capacityLimits(capacity, occupancy), func(limit capacityLimit) bool {
+		return limit.used > limit.max
+	})
+}
+
+func validCapacity(capacity models.PvzCapacity) bool {
+	for _, limit := range []*int{capacity.MaxItems, capacity.MaxVolumeCm3, capacity.MaxWeightGrams} {
+		if limit != nil && *limit <= 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// RunUtilizationReporter периодически пересчитывает заполненность ПВЗ с заданной вместимостью
+// и передаёт её в report, например в метрики
+func (uc *PvzUsecase) RunUtilizationReporter(ctx context.Context, interval time.Duration, report func([]models.PVZ)) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		pvzList, err := uc.repo.GetPvzUtilizations(ctx)
+		if err != nil {
+			loggerVar.Error(err.Error())
+		} else {
+			for _, pvzItem := range pvzList {
+				uc.fillUtilization(pvzItem.Capacity, pvzItem.Utilization)
+			}
+			report(pvzList)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (uc *PvzUsecase) FindProductsByBarcode(ctx context.Context, barcode string) ([]models.ProductLocation, error) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	products, err := uc.repo.GetProductsByBarcode(ctx, barcode, maxBarcodeMatches)
 	if err != nil {
 		loggerVar.Error(err.Error())
-		return err
+		return nil, err
 	}
 
-	if err := uc.repo.DeleteProduct(ctx, product.Id); err != nil {
+	loggerVar.Info("Success")
+	return products, nil
+}
+
+func (uc *PvzUsecase) DeleteProduct(ctx context.Context, pvzID uuid.UUID) error {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	err := uc.repo.WithinTx(ctx, func(ctx context.Context) error {
+		if err := uc.repo.LockPvz(ctx, pvzID); err != nil {
+			return err
+		}
+
+		active, err := uc.repo.HasActiveReception(ctx, pvzID)
+		if err != nil {
+			return err
+		}
+		if !active {
+			return pvz.ErrNoActiveReception
+		}
+
+		product, err := uc.repo.GetLastProduct(ctx, pvzID)
+		if err != nil {
+			return err
+		}
+
+		if err := uc.repo.DeleteProduct(ctx, product.Id); err != nil {
+			return err
+		}
+		return uc.emit(ctx, models.EventProductDeleted, pvzID, product)
+	})
+	if err != nil {
 		loggerVar.Error(err.Error())
 		return err
 	}
@@ -117,23 +1007,150 @@ func (uc *PvzUsecase) DeleteProduct(ctx context.Context, pvzID uuid.UUID) error
 	return nil
 }
 
-func (uc *PvzUsecase) CloseReception(ctx context.Context, pvzID uuid.UUID) (models.Reception, error) {
+func (uc *PvzUsecase) DeleteProductByID(ctx context.Context, id uuid.UUID, reason string) (models.ProductDeletion, error) {
 	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
 
-	reception, err := uc.repo.GetActiveReception(ctx, pvzID)
+	if !validation.IsValidDeleteReason(reason) {
+		loggerVar.Error(pvz.ErrInvalidDeleteReason.Error())
+		return models.ProductDeletion{}, pvz.ErrInvalidDeleteReason
+	}
+
+	var product models.Product
+	err := uc.withProductPvzLocked(ctx, id, func(ctx context.Context, pvzID uuid.UUID) error {
+		var err error
+		product, err = uc.repo.GetProductByID(ctx, id)
+		if err != nil {
+			return err
+		}
+		if product.DeletedAt != nil {
+			return pvz.ErrProductNotFound
+		}
+		if _, err := uc.activeReception(ctx, product.ReceptionId); err != nil {
+			return err
+		}
+
+		deletedAt, err := uc.repo.SoftDeleteProduct(ctx, id, reason)
+		if err != nil {
+			return err
+		}
+		product.DeletedAt = &deletedAt
+		product.DeleteReason = reason
+		return uc.emit(ctx, models.EventProductDeleted, pvzID, product)
+	})
 	if err != nil {
 		loggerVar.Error(err.Error())
-		return models.Reception{}, err
+		return models.ProductDeletion{}, err
 	}
 
-	_, err = uc.repo.GetLastProduct(ctx, pvzID)
+	loggerVar.Info("Success")
+	return models.ProductDeletion{Product: product, UndoUntil: product.DeletedAt.Add(uc.cfg.UndoWindow)}, nil
+}
+
+func (uc *PvzUsecase) RestoreProduct(ctx context.Context, id uuid.UUID) (models.Product, error) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	var product models.Product
+	err := uc.withProductPvzLocked(ctx, id, func(ctx context.Context, pvzID uuid.UUID) error {
+		var err error
+		product, err = uc.repo.GetProductByID(ctx, id)
+		if err != nil {
+			return err
+		}
+		if product.DeletedAt == nil {
+			return pvz.ErrProductNotDeleted
+		}
+		if _, err := uc.activeReception(ctx, product.ReceptionId); err != nil {
+			return err
+		}
+
+		deletedAfter := time.Now().Add(-uc.cfg.UndoWindow)
+		if product.DeletedAt.Before(deletedAfter) {
+			return pvz.ErrUndoWindowExpired
+		}
+
+		// удалённый товар не входит в занятость ПВЗ, поэтому восстановление проверяется как приёмка
+		nearlyFull, err := uc.reserveCapacity(ctx, pvzID, []models.Product{product})
+		if err != nil {
+			return err
+		}
+		if nearlyFull {
+			loggerVar.Warn(warnPvzNearlyFull, slog.String("pvz_id", pvzID.String()))
+			product.Warnings = append(product.Warnings, warnPvzNearlyFull)
+		}
+
+		if err := uc.repo.RestoreProduct(ctx, id, deletedAfter); err != nil {
+			return err
+		}
+		product.DeletedAt = nil
+		product.DeleteReason = ""
+		return uc.emit(ctx, models.EventProductRestored, pvzID, product)
+	})
 	if err != nil {
 		loggerVar.Error(err.Error())
+		return models.Product{}, err
+	}
+
+	loggerVar.Info("Success")
+	return product, nil
+}
+
+// withProductPvzLocked выполняет fn в транзакции под блокировкой ПВЗ, в который принят товар,
+// чтобы приёмку не закрыли между проверкой и изменением товара
+func (uc *PvzUsecase) withProductPvzLocked(ctx context.Context, productID uuid.UUID, fn func(ctx context.Context, pvzID uuid.UUID) error) error {
+	// ПВЗ приёмки не меняется, поэтому его можно узнать до блокировки
+	pvzID, err := uc.repo.GetProductPvzID(ctx, productID)
+	if err != nil {
+		return err
+	}
+
+	return uc.repo.WithinTx(ctx, func(ctx context.Context) error {
+		if err := uc.repo.LockPvz(ctx, pvzID); err != nil {
+			return err
+		}
+		return fn(ctx, pvzID)
+	})
+}
+
+// activeReception не даёт менять состав уже закрытой приёмки
+func (uc *PvzUsecase) activeReception(ctx context.Context, receptionID uuid.UUID) (models.Reception, error) {
+	reception, err := uc.repo.GetReceptionByID(ctx, receptionID)
+	if err != nil {
 		return models.Reception{}, err
 	}
+	if reception.Status != models.StatusInProgress {
+		return models.Reception{}, pvz.ErrReceptionClosed
+	}
+	return reception, nil
+}
+
+func (uc *PvzUsecase) CloseReception(ctx context.Context, pvzID uuid.UUID) (models.Reception, error) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	var reception models.Reception
+	err := uc.repo.WithinTx(ctx, func(ctx context.Context) error {
+		if err := uc.repo.LockPvz(ctx, pvzID); err != nil {
+			return err
+		}
+
+		var err error
+		reception, err = uc.repo.GetActiveReception(ctx, pvzID)
+		if err != nil {
+			return err
+		}
 
-	reception.Status = models.StatusClose
-	if err := uc.repo.UpdateReceptionStatus(ctx, reception.Id, reception.Status); err != nil {
+		if _, err := uc.repo.GetLastProduct(ctx, pvzID); err != nil {
+			return err
+		}
+
+		reception.Status = models.StatusClose
+		reception.ClosedBy = principal.UserID(ctx)
+		reception.ClosedAt, err = uc.repo.UpdateReceptionStatus(ctx, reception.Id, reception.Status, reception.ClosedBy)
+		if err != nil {
+			return err
+		}
+		return uc.emit(ctx, models.EventReceptionClosed, pvzID, reception)
+	})
+	if err != nil {
 		loggerVar.Error(err.Error())
 		return models.Reception{}, err
 	}
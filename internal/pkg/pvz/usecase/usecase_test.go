@@ -0,0 +1,1072 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/K1tten2005/avito_pvz/internal/models"
+	outboxMocks "github.com/K1tten2005/avito_pvz/internal/pkg/outbox/mocks"
+	"github.com/K1tten2005/avito_pvz/internal/pkg/pvz"
+	"github.com/K1tten2005/avito_pvz/internal/pkg/pvz/mocks"
+	"github.com/K1tten2005/avito_pvz/internal/pkg/schedule"
+	"github.com/K1tten2005/avito_pvz/internal/pkg/utils/pagination"
+	"github.com/K1tten2005/avito_pvz/internal/pkg/utils/principal"
+	"github.com/K1tten2005/avito_pvz/internal/pkg/utils/validation"
+	"github.com/golang/mock/gomock"
+	"github.com/satori/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPvzUsecase_GetPvz(t *testing.T) {
+	date := time.Date(2025, 4, 10, 0, 0, 0, 0, time.UTC)
+	first := models.PVZ{Id: uuid.NewV4(), RegistrationDate: date, City: "Москва", Receptions: []models.Reception{}}
+	second := models.PVZ{Id: uuid.NewV4(), RegistrationDate: date, City: "Казань", Receptions: []models.Reception{}}
+	third := models.PVZ{Id: uuid.NewV4(), RegistrationDate: date, City: "Казань", Receptions: []models.Reception{}}
+
+	reception := models.Reception{Id: uuid.NewV4(), PvzId: second.Id, Status: models.StatusClose}
+
+	tests := []struct {
+		name           string
+		filter         models.PvzFilter
+		mockBehavior   func(repo *mocks.MockPvzRepo)
+		expectedIDs    []uuid.UUID
+		expectedCursor *models.PvzCursor
+	}{
+		{
+			name:   "has next page",
+			filter: models.PvzFilter{Page: 1, Limit: 2},
+			mockBehavior: func(repo *mocks.MockPvzRepo) {
+				repo.EXPECT().GetPvz(gomock.Any(), models.PvzFilter{Page: 1, Limit: 3}).
+					Return([]models.PVZ{first, second, third}, nil)
+				repo.EXPECT().CountPvz(gomock.Any(), false).Return(3, nil)
+				repo.EXPECT().GetReceptionsByPvzIDs(gomock.Any(), []uuid.UUID{first.Id, second.Id}, nil, nil).
+					Return([]models.Reception{reception}, nil)
+			},
+			expectedIDs:    []uuid.UUID{first.Id, second.Id},
+			expectedCursor: &models.PvzCursor{RegistrationDate: date, Id: second.Id},
+		},
+		{
+			name:   "last page",
+			filter: models.PvzFilter{Page: 1, Limit: 2, Cursor: &models.PvzCursor{RegistrationDate: date, Id: second.Id}},
+			mockBehavior: func(repo *mocks.MockPvzRepo) {
+				repo.EXPECT().GetPvz(gomock.Any(), gomock.Any()).Return([]models.PVZ{third}, nil)
+				repo.EXPECT().CountPvz(gomock.Any(), false).Return(3, nil)
+				repo.EXPECT().GetReceptionsByPvzIDs(gomock.Any(), []uuid.UUID{third.Id}, nil, nil).
+					Return([]models.Reception{}, nil)
+			},
+			expectedIDs: []uuid.UUID{third.Id},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repo := mocks.NewMockPvzRepo(ctrl)
+			tt.mockBehavior(repo)
+
+			page, err := CreatePvzUsecase(repo, acceptEvents(ctrl), alwaysOpen(ctrl), Config{}).GetPvz(context.Background(), tt.filter)
+			require.NoError(t, err)
+			assert.Equal(t, 3, page.Total)
+
+			var ids []uuid.UUID
+			for _, item := range page.Items {
+				ids = append(ids, item.Id)
+				if item.Id == second.Id {
+					assert.Len(t, item.Receptions, 1)
+				}
+			}
+			assert.Equal(t, tt.expectedIDs, ids)
+
+			if tt.expectedCursor == nil {
+				assert.Empty(t, page.NextCursor)
+				return
+			}
+			cursor, err := pagination.DecodeCursor(page.NextCursor)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedCursor.Id, cursor.Id)
+		})
+	}
+}
+
+func TestPvzUsecase_GetActiveReception(t *testing.T) {
+	pvzID := uuid.NewV4()
+	reception := models.Reception{Id: uuid.NewV4(), PvzId: pvzID, Status: models.StatusInProgress}
+	product := models.Product{Id: uuid.NewV4(), ReceptionId: reception.Id, Type: "обувь"}
+
+	tests := []struct {
+		name         string
+		mockBehavior func(repo *mocks.MockPvzRepo)
+		expectedErr  error
+	}{
+		{
+			name: "pvz not found",
+			mockBehavior: func(repo *mocks.MockPvzRepo) {
+				repo.EXPECT().GetPvzByID(gomock.Any(), pvzID).Return(models.PVZ{}, pvz.ErrPvzNotFound)
+			},
+			expectedErr: pvz.ErrPvzNotFound,
+		},
+		{
+			name: "no active reception",
+			mockBehavior: func(repo *mocks.MockPvzRepo) {
+				repo.EXPECT().GetPvzByID(gomock.Any(), pvzID).Return(models.PVZ{Id: pvzID}, nil)
+				repo.EXPECT().GetActiveReception(gomock.Any(), pvzID).Return(models.Reception{}, pvz.ErrNoActiveReception)
+			},
+			expectedErr: pvz.ErrNoActiveReception,
+		},
+		{
+			name: "success",
+			mockBehavior: func(repo *mocks.MockPvzRepo) {
+				repo.EXPECT().GetPvzByID(gomock.Any(), pvzID).Return(models.PVZ{Id: pvzID}, nil)
+				repo.EXPECT().GetActiveReception(gomock.Any(), pvzID).Return(reception, nil)
+				repo.EXPECT().GetProductsByReceptionID(gomock.Any(), reception.Id).Return([]models.Product{product}, nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repo := mocks.NewMockPvzRepo(ctrl)
+			tt.mockBehavior(repo)
+
+			result, err := CreatePvzUsecase(repo, acceptEvents(ctrl), alwaysOpen(ctrl), Config{}).GetActiveReception(context.Background(), pvzID)
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, []models.Product{product}, result.Products)
+		})
+	}
+}
+
+func TestPvzUsecase_GetReceptionAct(t *testing.T) {
+	pvzID, userID := uuid.NewV4(), uuid.NewV4()
+	receptionID := uuid.NewV4()
+	products := []models.Product{
+		{Id: uuid.NewV4(), ReceptionId: receptionID, Type: "электроника"},
+		{Id: uuid.NewV4(), ReceptionId: receptionID, Type: "обувь"},
+		{Id: uuid.NewV4(), ReceptionId: receptionID, Type: "электроника"},
+	}
+
+	tests := []struct {
+		name           string
+		mockBehavior   func(repo *mocks.MockPvzRepo)
+		expectedErr    error
+		expectedTotals []models.ProductTypeTotal
+		expectedEmail  string
+	}{
+		{
+			name: "reception not found",
+			mockBehavior: func(repo *mocks.MockPvzRepo) {
+				repo.EXPECT().GetReceptionByID(gomock.Any(), receptionID).Return(models.Reception{}, pvz.ErrReceptionNotFound)
+			},
+			expectedErr: pvz.ErrReceptionNotFound,
+		},
+		{
+			name: "reception in progress",
+			mockBehavior: func(repo *mocks.MockPvzRepo) {
+				repo.EXPECT().GetReceptionByID(gomock.Any(), receptionID).Return(models.Reception{Id: receptionID, PvzId: pvzID, Status: models.StatusInProgress}, nil)
+				repo.EXPECT().GetProductsByReceptionID(gomock.Any(), receptionID).Return(products, nil)
+			},
+			expectedErr: pvz.ErrReceptionNotClosed,
+		},
+		{
+			// приёмку закрыли токеном без пользователя
+			name: "closed without user id",
+			mockBehavior: func(repo *mocks.MockPvzRepo) {
+				repo.EXPECT().GetReceptionByID(gomock.Any(), receptionID).Return(models.Reception{Id: receptionID, PvzId: pvzID, Status: models.StatusClose}, nil)
+				repo.EXPECT().GetProductsByReceptionID(gomock.Any(), receptionID).Return(products, nil)
+				repo.EXPECT().GetPvzByID(gomock.Any(), pvzID).Return(models.PVZ{Id: pvzID, City: "Москва"}, nil)
+			},
+			expectedTotals: []models.ProductTypeTotal{
+				{Type: "обувь", Count: 1},
+				{Type: "электроника", Count: 2},
+			},
+		},
+		{
+			name: "success",
+			mockBehavior: func(repo *mocks.MockPvzRepo) {
+				repo.EXPECT().GetReceptionByID(gomock.Any(), receptionID).Return(models.Reception{Id: receptionID, PvzId: pvzID, Status: models.StatusClose, ClosedBy: &userID}, nil)
+				repo.EXPECT().GetProductsByReceptionID(gomock.Any(), receptionID).Return(products, nil)
+				repo.EXPECT().GetPvzByID(gomock.Any(), pvzID).Return(models.PVZ{Id: pvzID, City: "Москва"}, nil)
+				repo.EXPECT().GetUserEmail(gomock.Any(), userID).Return("employee@mail.ru", nil)
+			},
+			expectedEmail: "employee@mail.ru",
+			expectedTotals: []models.ProductTypeTotal{
+				{Type: "обувь", Count: 1},
+				{Type: "электроника", Count: 2},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repo := mocks.NewMockPvzRepo(ctrl)
+			tt.mockBehavior(repo)
+
+			act, err := CreatePvzUsecase(repo, acceptEvents(ctrl), alwaysOpen(ctrl), Config{}).GetReceptionAct(context.Background(), receptionID)
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "Москва", act.City)
+			assert.Equal(t, tt.expectedTotals, act.Totals)
+			assert.Equal(t, tt.expectedEmail, act.ClosedByEmail)
+			assert.Len(t, act.Reception.Products, len(products))
+		})
+	}
+}
+
+func TestPvzUsecase_UpdatePvz(t *testing.T) {
+	validation.SetCities([]models.City{
+		{Name: "Москва", Enabled: true},
+		{Name: "Казань", Enabled: true},
+	})
+
+	pvzID := uuid.NewV4()
+	current := models.PVZ{
+		Id:       pvzID,
+		City:     "Москва",
+		Status:   models.PvzStatusActive,
+		Metadata: map[string]string{"floor": "1"},
+		Version:  3,
+	}
+
+	strPtr := func(s string) *string { return &s }
+	intPtr := func(i int) *int { return &i }
+	floatPtr := func(f float64) *float64 { return &f }
+
+	tests := []struct {
+		name         string
+		current      models.PVZ
+		req          models.UpdatePvzReq
+		mockBehavior func(repo *mocks.MockPvzRepo)
+		expectedErr  error
+	}{
+		{
+			name:        "version conflict",
+			current:     current,
+			req:         models.UpdatePvzReq{City: strPtr("Казань"), Version: intPtr(2)},
+			expectedErr: pvz.ErrPvzVersionConflict,
+		},
+		{
+			name:        "closed pvz is read only",
+			current:     models.PVZ{Id: pvzID, Status: models.PvzStatusClosed, Version: 3},
+			req:         models.UpdatePvzReq{City: strPtr("Казань"), Version: intPtr(3)},
+			expectedErr: pvz.ErrPvzClosed,
+		},
+		{
+			name:        "invalid city",
+			current:     current,
+			req:         models.UpdatePvzReq{City: strPtr("Новосибирск"), Version: intPtr(3)},
+			expectedErr: pvz.ErrInvalidCity,
+		},
+		{
+			name:        "invalid capacity",
+			current:     current,
+			req:         models.UpdatePvzReq{Capacity: &models.PvzCapacity{MaxItems: intPtr(0)}, Version: intPtr(3)},
+			expectedErr: pvz.ErrInvalidCapacity,
+		},
+		{
+			name:        "latitude without longitude",
+			current:     current,
+			req:         models.UpdatePvzReq{Latitude: floatPtr(55.75), Version: intPtr(3)},
+			expectedErr: pvz.ErrInvalidCoordinates,
+		},
+		{
+			name:    "cannot close with active reception",
+			current: current,
+			req:     models.UpdatePvzReq{Status: strPtr(models.PvzStatusClosed), Version: intPtr(3)},
+			mockBehavior: func(repo *mocks.MockPvzRepo) {
+				repo.EXPECT().HasActiveReception(gomock.Any(), pvzID).Return(true, nil)
+			},
+			expectedErr: pvz.ErrActiveReceptionExists,
+		},
+		{
+			name:    "close without active reception",
+			current: current,
+			req:     models.UpdatePvzReq{Status: strPtr(models.PvzStatusClosed), Version: intPtr(3)},
+			mockBehavior: func(repo *mocks.MockPvzRepo) {
+				expected := current
+				expected.Status = models.PvzStatusClosed
+				gomock.InOrder(
+					repo.EXPECT().HasActiveReception(gomock.Any(), pvzID).Return(false, nil),
+					repo.EXPECT().UpdatePvz(gomock.Any(), expected, 3).Return(expected, nil),
+				)
+			},
+		},
+		{
+			name:    "success",
+			current: current,
+			req: models.UpdatePvzReq{
+				City:     strPtr("Казань"),
+				Status:   strPtr(models.PvzStatusSuspended),
+				Metadata: map[string]string{"floor": "", "phone": "123"},
+				Version:  intPtr(3),
+			},
+			mockBehavior: func(repo *mocks.MockPvzRepo) {
+				expected := current
+				expected.City = "Казань"
+				expected.Status = models.PvzStatusSuspended
+				expected.Metadata = map[string]string{"phone": "123"}
+				repo.EXPECT().UpdatePvz(gomock.Any(), expected, 3).Return(expected, nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repo := mocks.NewMockPvzRepo(ctrl)
+			runInTx(repo)
+			// ПВЗ блокируется до чтения текущего состояния
+			gomock.InOrder(
+				repo.EXPECT().LockPvz(gomock.Any(), pvzID).Return(nil),
+				repo.EXPECT().GetPvzByID(gomock.Any(), pvzID).Return(tt.current, nil),
+			)
+			if tt.mockBehavior != nil {
+				tt.mockBehavior(repo)
+			}
+
+			_, err := CreatePvzUsecase(repo, acceptEvents(ctrl), alwaysOpen(ctrl), Config{}).UpdatePvz(context.Background(), pvzID, tt.req)
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestPvzUsecase_GetPvzByID(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	pvzID := uuid.NewV4()
+	maxItems, maxWeight := 100, 50_000
+	repo := mocks.NewMockPvzRepo(ctrl)
+	repo.EXPECT().GetPvzByID(gomock.Any(), pvzID).Return(models.PVZ{
+		Id:       pvzID,
+		Capacity: models.PvzCapacity{MaxItems: &maxItems, MaxWeightGrams: &maxWeight},
+	}, nil)
+	repo.EXPECT().GetReceptionsByPvzIDs(gomock.Any(), []uuid.UUID{pvzID}, nil, nil).Return([]models.Reception{}, nil)
+	repo.EXPECT().GetPvzOccupancy(gomock.Any(), pvzID).Return(models.PvzUtilization{Items: 40, WeightGrams: 46_000}, nil)
+
+	result, err := CreatePvzUsecase(repo, acceptEvents(ctrl), alwaysOpen(ctrl), Config{CapacityWarnRatio: 0.9}).GetPvzByID(context.Background(), pvzID)
+	require.NoError(t, err)
+	require.NotNil(t, result.Utilization)
+	require.NotNil(t, result.Utilization.Ratio)
+	// заполнение считается по самому тесному лимиту — здесь по весу
+	assert.InDelta(t, 0.92, *result.Utilization.Ratio, 1e-9)
+	assert.True(t, result.Utilization.NearlyFull)
+}
+
+func TestPvzUsecase_FindNearbyPvz(t *testing.T) {
+	moscow := models.NearbyPvzFilter{Latitude: 55.7558, Longitude: 37.6173}
+
+	tests := []struct {
+		name           string
+		filter         models.NearbyPvzFilter
+		expectedFilter *models.NearbyPvzFilter
+		expectedErr    error
+	}{
+		{
+			name:        "latitude out of range",
+			filter:      models.NearbyPvzFilter{Latitude: 91, Longitude: 37.6173},
+			expectedErr: pvz.ErrInvalidCoordinates,
+		},
+		{
+			name:        "radius too large",
+			filter:      models.NearbyPvzFilter{Latitude: 55.7558, Longitude: 37.6173, RadiusMeters: 100_000},
+			expectedErr: pvz.ErrInvalidRadius,
+		},
+		{
+			name:        "negative radius",
+			filter:      models.NearbyPvzFilter{Latitude: 55.7558, Longitude: 37.6173, RadiusMeters: -1},
+			expectedErr: pvz.ErrInvalidRadius,
+		},
+		{
+			name:        "unknown status",
+			filter:      models.NearbyPvzFilter{Latitude: 55.7558, Longitude: 37.6173, Status: "open"},
+			expectedErr: pvz.ErrUnknownPvzStatus,
+		},
+		{
+			name:   "defaults",
+			filter: moscow,
+			expectedFilter: &models.NearbyPvzFilter{
+				Latitude: 55.7558, Longitude: 37.6173, RadiusMeters: defaultNearbyRadius, Limit: defaultNearbyLimit,
+			},
+		},
+		{
+			name: "limit is capped",
+			filter: models.NearbyPvzFilter{
+				Latitude: 55.7558, Longitude: 37.6173, RadiusMeters: 1500, Status: models.PvzStatusActive, HasCapacity: true, Limit: 1000,
+			},
+			expectedFilter: &models.NearbyPvzFilter{
+				Latitude: 55.7558, Longitude: 37.6173, RadiusMeters: 1500, Status: models.PvzStatusActive, HasCapacity: true, Limit: maxNearbyLimit,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repo := mocks.NewMockPvzRepo(ctrl)
+			if tt.expectedFilter != nil {
+				repo.EXPECT().FindNearbyPvz(gomock.Any(), *tt.expectedFilter).Return([]models.NearbyPvz{}, nil)
+			}
+
+			result, err := CreatePvzUsecase(repo, acceptEvents(ctrl), alwaysOpen(ctrl), Config{}).FindNearbyPvz(context.Background(), tt.filter)
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.NotNil(t, result)
+		})
+	}
+}
+
+func TestPvzUsecase_AddProduct(t *testing.T) {
+	validation.SetProductTypes([]models.ProductType{{Code: "обувь", Active: true}})
+
+	pvzID := uuid.NewV4()
+	reception := models.Reception{Id: uuid.NewV4(), PvzId: pvzID, Status: models.StatusInProgress}
+	weight := 0
+	maxItems := 10
+	limited := models.PVZ{Id: pvzID, Capacity: models.PvzCapacity{MaxItems: &maxItems}}
+
+	tests := []struct {
+		name             string
+		req              models.AddProductReq
+		mockBehavior     func(repo *mocks.MockPvzRepo)
+		expectedErr      error
+		expectedFormat   string
+		expectedWarnings []string
+	}{
+		{
+			name:         "invalid checksum",
+			req:          models.AddProductReq{PvzId: pvzID, Type: "обувь", Barcode: "4006381333932"},
+			mockBehavior: func(repo *mocks.MockPvzRepo) {},
+			expectedErr:  pvz.ErrInvalidBarcode,
+		},
+		{
+			name:         "code128 without checksum",
+			req:          models.AddProductReq{PvzId: pvzID, Type: "обувь", Barcode: "ORDER-42"},
+			mockBehavior: func(repo *mocks.MockPvzRepo) {},
+			expectedErr:  pvz.ErrInvalidBarcode,
+		},
+		{
+			name:         "non-positive weight",
+			req:          models.AddProductReq{PvzId: pvzID, Type: "обувь", WeightGrams: &weight},
+			mockBehavior: func(repo *mocks.MockPvzRepo) {},
+			expectedErr:  pvz.ErrInvalidMeasurements,
+		},
+		{
+			name: "reception closed before lock acquired",
+			req:  models.AddProductReq{PvzId: pvzID, Type: "обувь"},
+			mockBehavior: func(repo *mocks.MockPvzRepo) {
+				runInTx(repo)
+				gomock.InOrder(
+					repo.EXPECT().LockPvz(gomock.Any(), pvzID).Return(nil),
+					repo.EXPECT().GetActiveReception(gomock.Any(), pvzID).Return(models.Reception{}, pvz.ErrNoActiveReception),
+				)
+			},
+			expectedErr: pvz.ErrNoActiveReception,
+		},
+		{
+			name: "detects format",
+			req:  models.AddProductReq{PvzId: pvzID, Type: "обувь", Barcode: "4006381333931"},
+			mockBehavior: func(repo *mocks.MockPvzRepo) {
+				repo.EXPECT().GetActiveReception(gomock.Any(), pvzID).Return(reception, nil)
+				repo.EXPECT().HasBarcodeInReception(gomock.Any(), reception.Id, "4006381333931").Return(false, nil)
+				runInTx(repo)
+				repo.EXPECT().LockPvz(gomock.Any(), pvzID).Return(nil)
+				repo.EXPECT().GetPvzByID(gomock.Any(), pvzID).Return(models.PVZ{Id: pvzID}, nil)
+				repo.EXPECT().AddProduct(gomock.Any(), gomock.Any()).Return(nil)
+			},
+			expectedFormat: models.BarcodeFormatEAN13,
+		},
+		{
+			name: "duplicate barcode",
+			req:  models.AddProductReq{PvzId: pvzID, Type: "обувь", Barcode: "4006381333931"},
+			mockBehavior: func(repo *mocks.MockPvzRepo) {
+				repo.EXPECT().GetActiveReception(gomock.Any(), pvzID).Return(reception, nil)
+				repo.EXPECT().HasBarcodeInReception(gomock.Any(), reception.Id, "4006381333931").Return(true, nil)
+				runInTx(repo)
+				repo.EXPECT().LockPvz(gomock.Any(), pvzID).Return(nil)
+				repo.EXPECT().GetPvzByID(gomock.Any(), pvzID).Return(models.PVZ{Id: pvzID}, nil)
+				repo.EXPECT().AddProduct(gomock.Any(), gomock.Any()).Return(nil)
+			},
+			expectedFormat:   models.BarcodeFormatEAN13,
+			expectedWarnings: []string{warnDuplicateBarcode},
+		},
+		{
+			name: "pvz nearly full",
+			req:  models.AddProductReq{PvzId: pvzID, Type: "обувь"},
+			mockBehavior: func(repo *mocks.MockPvzRepo) {
+				repo.EXPECT().GetActiveReception(gomock.Any(), pvzID).Return(reception, nil)
+				runInTx(repo)
+				repo.EXPECT().LockPvz(gomock.Any(), pvzID).Return(nil)
+				repo.EXPECT().GetPvzByID(gomock.Any(), pvzID).Return(limited, nil)
+				repo.EXPECT().GetPvzOccupancy(gomock.Any(), pvzID).Return(models.PvzUtilization{Items: 8}, nil)
+				repo.EXPECT().AddProduct(gomock.Any(), gomock.Any()).Return(nil)
+			},
+			expectedWarnings: []string{warnPvzNearlyFull},
+		},
+		{
+			name: "pvz full",
+			req:  models.AddProductReq{PvzId: pvzID, Type: "обувь"},
+			mockBehavior: func(repo *mocks.MockPvzRepo) {
+				repo.EXPECT().GetActiveReception(gomock.Any(), pvzID).Return(reception, nil)
+				runInTx(repo)
+				repo.EXPECT().LockPvz(gomock.Any(), pvzID).Return(nil)
+				repo.EXPECT().GetPvzByID(gomock.Any(), pvzID).Return(limited, nil)
+				repo.EXPECT().GetPvzOccupancy(gomock.Any(), pvzID).Return(models.PvzUtilization{Items: 10}, nil)
+			},
+			expectedErr: pvz.ErrPvzFull,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repo := mocks.NewMockPvzRepo(ctrl)
+			tt.mockBehavior(repo)
+
+			product, err := CreatePvzUsecase(repo, acceptEvents(ctrl), alwaysOpen(ctrl), Config{CapacityWarnRatio: 0.9}).AddProduct(context.Background(), tt.req)
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, reception.Id, product.ReceptionId)
+			assert.Equal(t, tt.expectedFormat, product.BarcodeFormat)
+			assert.Equal(t, tt.expectedWarnings, product.Warnings)
+		})
+	}
+}
+
+func TestPvzUsecase_AddProducts(t *testing.T) {
+	validation.SetProductTypes([]models.ProductType{{Code: "обувь", Active: true}})
+
+	pvzID := uuid.NewV4()
+	reception := models.Reception{Id: uuid.NewV4(), PvzId: pvzID, Status: models.StatusInProgress}
+
+	t.Run("invalid item rejects batch", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		repo := mocks.NewMockPvzRepo(ctrl)
+
+		result, err := CreatePvzUsecase(repo, acceptEvents(ctrl), alwaysOpen(ctrl), Config{}).AddProducts(context.Background(), models.AddProductsBatchReq{
+			PvzId: pvzID,
+			Items: []models.AddProductReq{{Type: "обувь"}, {Type: "мебель"}},
+		})
+		assert.ErrorIs(t, err, pvz.ErrInvalidBatch)
+		require.Len(t, result.Items, 2)
+		assert.Equal(t, models.BatchItemSkipped, result.Items[0].Status)
+		assert.Equal(t, models.BatchItemRejected, result.Items[1].Status)
+		assert.Equal(t, pvz.ErrInvalidProductType.Error(), result.Items[1].Error)
+	})
+
+	t.Run("success with duplicate barcodes", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		repo := mocks.NewMockPvzRepo(ctrl)
+		repo.EXPECT().GetActiveReception(gomock.Any(), pvzID).Return(reception, nil)
+		repo.EXPECT().GetScannedBarcodes(gomock.Any(), reception.Id, []string{"4006381333931", "4006381333931"}).Return([]string{}, nil)
+		runInTx(repo)
+		repo.EXPECT().LockPvz(gomock.Any(), pvzID).Return(nil)
+		repo.EXPECT().GetPvzByID(gomock.Any(), pvzID).Return(models.PVZ{Id: pvzID}, nil)
+		repo.EXPECT().AddProducts(gomock.Any(), gomock.Len(3)).Return(nil)
+
+		userID := uuid.NewV4()
+		ctx := principal.WithPrincipal(context.Background(), models.Principal{UserId: userID, Role: models.RoleEmployee})
+		result, err := CreatePvzUsecase(repo, acceptEvents(ctrl), alwaysOpen(ctrl), Config{}).AddProducts(ctx, models.AddProductsBatchReq{
+			PvzId: pvzID,
+			Items: []models.AddProductReq{
+				{Type: "обувь", Barcode: "4006381333931"},
+				{Type: "обувь"},
+				{Type: "обувь", Barcode: "4006381333931"},
+			},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 3, result.Created)
+		assert.Empty(t, result.Items[0].Product.Warnings)
+		assert.Equal(t, []string{warnDuplicateBarcode}, result.Items[2].Product.Warnings)
+		assert.True(t, result.Items[1].Product.DateTime.After(result.Items[0].Product.DateTime))
+		for _, item := range result.Items {
+			assert.Equal(t, &userID, item.Product.CreatedBy)
+		}
+	})
+
+	t.Run("batch over volume limit", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		maxVolume := 10_000
+		repo := mocks.NewMockPvzRepo(ctrl)
+		repo.EXPECT().GetActiveReception(gomock.Any(), pvzID).Return(reception, nil)
+		runInTx(repo)
+		repo.EXPECT().LockPvz(gomock.Any(), pvzID).Return(nil)
+		repo.EXPECT().GetPvzByID(gomock.Any(), pvzID).Return(models.PVZ{Id: pvzID, Capacity: models.PvzCapacity{MaxVolumeCm3: &maxVolume}}, nil)
+		repo.EXPECT().GetPvzOccupancy(gomock.Any(), pvzID).Return(models.PvzUtilization{Items: 3, VolumeCm3: 5_000}, nil)
+
+		// две коробки по 3 литра не помещаются в оставшиеся 5
+		box := &models.Dimensions{LengthMm: 300, WidthMm: 100, HeightMm: 100}
+		_, err := CreatePvzUsecase(repo, acceptEvents(ctrl), alwaysOpen(ctrl), Config{}).AddProducts(context.Background(), models.AddProductsBatchReq{
+			PvzId: pvzID,
+			Items: []models.AddProductReq{{Type: "обувь", Dimensions: box}, {Type: "обувь", Dimensions: box}},
+		})
+		assert.ErrorIs(t, err, pvz.ErrPvzFull)
+	})
+
+	t.Run("batch too large", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		_, err := CreatePvzUsecase(mocks.NewMockPvzRepo(ctrl), acceptEvents(ctrl), alwaysOpen(ctrl), Config{}).AddProducts(context.Background(), models.AddProductsBatchReq{
+			PvzId: pvzID,
+			Items: make([]models.AddProductReq, maxProductBatchSize+1),
+		})
+		assert.ErrorIs(t, err, pvz.ErrBatchTooLarge)
+	})
+}
+
+func TestPvzUsecase_ImportPvz(t *testing.T) {
+	validation.SetCities([]models.City{
+		{Name: "Москва", Enabled: true},
+		{Name: "Санкт-Петербург", Aliases: []string{"Санкт Петербург"}, Enabled: true},
+	})
+
+	existingID := uuid.NewV4()
+	rows := []models.PvzImportRow{
+		{Line: 2, City: "Москва", RegistrationDate: "2025-04-01", Address: "ул. Тверская, 1", Latitude: "55.7577", Longitude: " 37.6138"},
+		{Line: 3, Id: uuid.NewV4().String(), City: "санкт петербург"},
+	}
+
+	t.Run("dry run reports every invalid row", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		duplicateID := uuid.NewV4().String()
+		repo := mocks.NewMockPvzRepo(ctrl)
+		repo.EXPECT().GetExistingPvzIDs(gomock.Any(), gomock.Len(3)).Return([]uuid.UUID{existingID}, nil)
+
+		report, err := CreatePvzUsecase(repo, acceptEvents(ctrl), alwaysOpen(ctrl), Config{}).ImportPvz(context.Background(), []models.PvzImportRow{
+			{Line: 2, City: "Москва"},
+			{Line: 3, City: "Тула"},
+			{Line: 4, Id: "not-a-uuid", City: "Москва"},
+			{Line: 5, City: "Москва", RegistrationDate: "01.04.2025"},
+			{Line: 6, Id: duplicateID, City: "Москва"},
+			{Line: 7, Id: duplicateID, City: "Москва"},
+			{Line: 8, Id: existingID.String(), City: "Москва"},
+			{Line: 9, City: "Москва", Latitude: "55.7577"},
+		}, true)
+		assert.ErrorIs(t, err, pvz.ErrInvalidImport)
+		require.Len(t, report.Items, 8)
+
+		expected := []string{
+			"", pvz.ErrInvalidCity.Error(), pvz.ErrInvalidPvzID.Error(), pvz.ErrInvalidRegDate.Error(),
+			"", pvz.ErrDuplicatePvzID.Error(), pvz.ErrPvzExists.Error(), pvz.ErrInvalidCoordinates.Error(),
+		}
+		for i, item := range report.Items {
+			assert.Equal(t, i+2, item.Line)
+			assert.Equal(t, expected[i], item.Error)
+		}
+		assert.Equal(t, models.ImportItemValid, report.Items[0].Status)
+		assert.Equal(t, models.ImportItemRejected, report.Items[1].Status)
+		assert.Zero(t, report.Imported)
+	})
+
+	t.Run("dry run does not write", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		repo := mocks.NewMockPvzRepo(ctrl)
+		repo.EXPECT().GetExistingPvzIDs(gomock.Any(), gomock.Len(2)).Return([]uuid.UUID{}, nil)
+
+		report, err := CreatePvzUsecase(repo, acceptEvents(ctrl), alwaysOpen(ctrl), Config{}).ImportPvz(context.Background(), rows, true)
+		require.NoError(t, err)
+		assert.True(t, report.DryRun)
+		assert.Zero(t, report.Imported)
+		assert.Equal(t, "Санкт-Петербург", report.Items[1].Pvz.City)
+	})
+
+	t.Run("success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		repo := mocks.NewMockPvzRepo(ctrl)
+		repo.EXPECT().GetExistingPvzIDs(gomock.Any(), gomock.Len(2)).Return([]uuid.UUID{}, nil)
+		runInTx(repo)
+		repo.EXPECT().InsertPvzs(gomock.Any(), gomock.Len(2)).Return(nil)
+
+		report, err := CreatePvzUsecase(repo, acceptEvents(ctrl), alwaysOpen(ctrl), Config{}).ImportPvz(context.Background(), rows, false)
+		require.NoError(t, err)
+		assert.Equal(t, 2, report.Imported)
+		assert.Equal(t, models.ImportItemCreated, report.Items[0].Status)
+		assert.Equal(t, "ул. Тверская, 1", report.Items[0].Pvz.Address)
+		require.NotNil(t, report.Items[0].Pvz.Latitude)
+		assert.Equal(t, 55.7577, *report.Items[0].Pvz.Latitude)
+		assert.Equal(t, 37.6138, *report.Items[0].Pvz.Longitude)
+		assert.Nil(t, report.Items[1].Pvz.Latitude)
+		assert.Equal(t, time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC), report.Items[0].Pvz.RegistrationDate)
+		assert.NotEqual(t, uuid.Nil, report.Items[0].Pvz.Id)
+	})
+
+	t.Run("insert fails", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		repo := mocks.NewMockPvzRepo(ctrl)
+		repo.EXPECT().GetExistingPvzIDs(gomock.Any(), gomock.Len(2)).Return([]uuid.UUID{}, nil)
+		runInTx(repo)
+		repo.EXPECT().InsertPvzs(gomock.Any(), gomock.Len(2)).Return(pvz.ErrPvzExists)
+
+		_, err := CreatePvzUsecase(repo, acceptEvents(ctrl), alwaysOpen(ctrl), Config{}).ImportPvz(context.Background(), rows, false)
+		assert.ErrorIs(t, err, pvz.ErrPvzExists)
+	})
+
+	t.Run("empty import", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		_, err := CreatePvzUsecase(mocks.NewMockPvzRepo(ctrl), acceptEvents(ctrl), alwaysOpen(ctrl), Config{}).ImportPvz(context.Background(), nil, false)
+		assert.ErrorIs(t, err, pvz.ErrEmptyImport)
+	})
+}
+
+func TestPvzUsecase_DeleteProductByID(t *testing.T) {
+	reception := models.Reception{Id: uuid.NewV4(), PvzId: uuid.NewV4(), Status: models.StatusInProgress}
+	product := models.Product{Id: uuid.NewV4(), ReceptionId: reception.Id, Type: "обувь"}
+	deletedAt := time.Now()
+
+	tests := []struct {
+		name         string
+		reason       string
+		mockBehavior func(repo *mocks.MockPvzRepo)
+		expectedErr  error
+	}{
+		{
+			name:         "invalid reason",
+			reason:       "lost",
+			mockBehavior: func(repo *mocks.MockPvzRepo) {},
+			expectedErr:  pvz.ErrInvalidDeleteReason,
+		},
+		{
+			name:   "already deleted",
+			reason: models.DeleteReasonMisScan,
+			mockBehavior: func(repo *mocks.MockPvzRepo) {
+				deleted := product
+				deleted.DeletedAt = &deletedAt
+				lockProductPvz(repo, product.Id, reception.PvzId)
+				repo.EXPECT().GetProductByID(gomock.Any(), product.Id).Return(deleted, nil)
+			},
+			expectedErr: pvz.ErrProductNotFound,
+		},
+		{
+			name:   "reception closed",
+			reason: models.DeleteReasonMisScan,
+			mockBehavior: func(repo *mocks.MockPvzRepo) {
+				lockProductPvz(repo, product.Id, reception.PvzId)
+				repo.EXPECT().GetProductByID(gomock.Any(), product.Id).Return(product, nil)
+				repo.EXPECT().GetReceptionByID(gomock.Any(), reception.Id).Return(models.Reception{Id: reception.Id, Status: models.StatusClose}, nil)
+			},
+			expectedErr: pvz.ErrReceptionClosed,
+		},
+		{
+			name:   "success",
+			reason: models.DeleteReasonMisScan,
+			mockBehavior: func(repo *mocks.MockPvzRepo) {
+				lockProductPvz(repo, product.Id, reception.PvzId)
+				repo.EXPECT().GetProductByID(gomock.Any(), product.Id).Return(product, nil)
+				repo.EXPECT().GetReceptionByID(gomock.Any(), reception.Id).Return(reception, nil)
+				repo.EXPECT().SoftDeleteProduct(gomock.Any(), product.Id, models.DeleteReasonMisScan).Return(deletedAt, nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repo := mocks.NewMockPvzRepo(ctrl)
+			tt.mockBehavior(repo)
+
+			result, err := CreatePvzUsecase(repo, acceptEvents(ctrl), alwaysOpen(ctrl), Config{UndoWindow: time.Minute}).DeleteProductByID(context.Background(), product.Id, tt.reason)
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, models.DeleteReasonMisScan, result.Product.DeleteReason)
+			assert.Equal(t, deletedAt.Add(time.Minute), result.UndoUntil)
+		})
+	}
+}
+
+func TestPvzUsecase_RestoreProduct(t *testing.T) {
+	reception := models.Reception{Id: uuid.NewV4(), PvzId: uuid.NewV4(), Status: models.StatusInProgress}
+	recent := time.Now().Add(-10 * time.Second)
+	expired := time.Now().Add(-time.Hour)
+	maxItems := 1
+	full := models.PVZ{Id: reception.PvzId, Capacity: models.PvzCapacity{MaxItems: &maxItems}}
+
+	tests := []struct {
+		name         string
+		deletedAt    *time.Time
+		mockBehavior func(repo *mocks.MockPvzRepo, id uuid.UUID)
+		expectedErr  error
+	}{
+		{
+			name:        "not deleted",
+			expectedErr: pvz.ErrProductNotDeleted,
+		},
+		{
+			name:      "window expired",
+			deletedAt: &expired,
+			mockBehavior: func(repo *mocks.MockPvzRepo, id uuid.UUID) {
+				repo.EXPECT().GetReceptionByID(gomock.Any(), reception.Id).Return(reception, nil)
+			},
+			expectedErr: pvz.ErrUndoWindowExpired,
+		},
+		{
+			name:      "pvz full",
+			deletedAt: &recent,
+			mockBehavior: func(repo *mocks.MockPvzRepo, id uuid.UUID) {
+				repo.EXPECT().GetReceptionByID(gomock.Any(), reception.Id).Return(reception, nil)
+				repo.EXPECT().GetPvzByID(gomock.Any(), reception.PvzId).Return(full, nil)
+				repo.EXPECT().GetPvzOccupancy(gomock.Any(), reception.PvzId).Return(models.PvzUtilization{Items: 1}, nil)
+			},
+			expectedErr: pvz.ErrPvzFull,
+		},
+		{
+			name:      "success",
+			deletedAt: &recent,
+			mockBehavior: func(repo *mocks.MockPvzRepo, id uuid.UUID) {
+				repo.EXPECT().GetReceptionByID(gomock.Any(), reception.Id).Return(reception, nil)
+				repo.EXPECT().GetPvzByID(gomock.Any(), reception.PvzId).Return(models.PVZ{Id: reception.PvzId}, nil)
+				repo.EXPECT().RestoreProduct(gomock.Any(), id, gomock.Any()).Return(nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			product := models.Product{Id: uuid.NewV4(), ReceptionId: reception.Id, DeletedAt: tt.deletedAt}
+			if tt.deletedAt != nil {
+				product.DeleteReason = models.DeleteReasonDuplicate
+			}
+
+			repo := mocks.NewMockPvzRepo(ctrl)
+			lockProductPvz(repo, product.Id, reception.PvzId)
+			repo.EXPECT().GetProductByID(gomock.Any(), product.Id).Return(product, nil)
+			if tt.mockBehavior != nil {
+				tt.mockBehavior(repo, product.Id)
+			}
+
+			result, err := CreatePvzUsecase(repo, acceptEvents(ctrl), alwaysOpen(ctrl), Config{UndoWindow: time.Minute}).RestoreProduct(context.Background(), product.Id)
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Nil(t, result.DeletedAt)
+			assert.Empty(t, result.DeleteReason)
+		})
+	}
+}
+
+// acceptEvents — outbox, который принимает любые события; порядок событий проверяется отдельно
+func acceptEvents(ctrl *gomock.Controller) *outboxMocks.MockOutboxRepo {
+	events := outboxMocks.NewMockOutboxRepo(ctrl)
+	events.EXPECT().AddEvent(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	return events
+}
+
+func alwaysOpen(ctrl *gomock.Controller) *mocks.MockScheduleChecker {
+	checker := mocks.NewMockScheduleChecker(ctrl)
+	checker.EXPECT().CheckOpen(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	return checker
+}
+
+func runInTx(repo *mocks.MockPvzRepo) {
+	repo.EXPECT().WithinTx(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, fn func(ctx context.Context) error) error {
+			return fn(ctx)
+		})
+}
+
+// lockProductPvz ожидает, что товар меняется в транзакции под блокировкой его ПВЗ
+func lockProductPvz(repo *mocks.MockPvzRepo, productID, pvzID uuid.UUID) {
+	gomock.InOrder(
+		repo.EXPECT().GetProductPvzID(gomock.Any(), productID).Return(pvzID, nil),
+		repo.EXPECT().WithinTx(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, fn func(ctx context.Context) error) error {
+				return fn(ctx)
+			}),
+		repo.EXPECT().LockPvz(gomock.Any(), pvzID).Return(nil),
+	)
+}
+
+func TestPvzUsecase_CreateReception(t *testing.T) {
+	pvzID := uuid.NewV4()
+
+	tests := []struct {
+		name         string
+		mockBehavior func(repo *mocks.MockPvzRepo)
+		expectedErr  error
+	}{
+		{
+			name: "pvz not found",
+			mockBehavior: func(repo *mocks.MockPvzRepo) {
+				runInTx(repo)
+				repo.EXPECT().LockPvz(gomock.Any(), pvzID).Return(pvz.ErrPvzNotFound)
+			},
+			expectedErr: pvz.ErrPvzNotFound,
+		},
+		{
+			name: "active reception exists",
+			mockBehavior: func(repo *mocks.MockPvzRepo) {
+				runInTx(repo)
+				repo.EXPECT().LockPvz(gomock.Any(), pvzID).Return(nil)
+				repo.EXPECT().GetPvzByID(gomock.Any(), pvzID).Return(models.PVZ{Id: pvzID, Status: models.PvzStatusActive}, nil)
+				repo.EXPECT().HasActiveReception(gomock.Any(), pvzID).Return(true, nil)
+			},
+			expectedErr: pvz.ErrActiveReceptionExists,
+		},
+		{
+			name: "lost race to unique index",
+			mockBehavior: func(repo *mocks.MockPvzRepo) {
+				runInTx(repo)
+				repo.EXPECT().LockPvz(gomock.Any(), pvzID).Return(nil)
+				repo.EXPECT().GetPvzByID(gomock.Any(), pvzID).Return(models.PVZ{Id: pvzID, Status: models.PvzStatusActive}, nil)
+				repo.EXPECT().HasActiveReception(gomock.Any(), pvzID).Return(false, nil)
+				repo.EXPECT().CreateReception(gomock.Any(), gomock.Any()).Return(pvz.ErrActiveReceptionExists)
+			},
+			expectedErr: pvz.ErrActiveReceptionExists,
+		},
+		{
+			name: "success",
+			mockBehavior: func(repo *mocks.MockPvzRepo) {
+				runInTx(repo)
+				repo.EXPECT().LockPvz(gomock.Any(), pvzID).Return(nil)
+				repo.EXPECT().GetPvzByID(gomock.Any(), pvzID).Return(models.PVZ{Id: pvzID, Status: models.PvzStatusActive}, nil)
+				repo.EXPECT().HasActiveReception(gomock.Any(), pvzID).Return(false, nil)
+				repo.EXPECT().CreateReception(gomock.Any(), gomock.Any()).Return(nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repo := mocks.NewMockPvzRepo(ctrl)
+			tt.mockBehavior(repo)
+
+			reception, err := CreatePvzUsecase(repo, acceptEvents(ctrl), alwaysOpen(ctrl), Config{}).CreateReception(context.Background(), pvzID)
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, pvzID, reception.PvzId)
+			assert.Equal(t, models.StatusInProgress, reception.Status)
+		})
+	}
+}
+
+func TestPvzUsecase_OutsideWorkingHours(t *testing.T) {
+	validation.SetProductTypes([]models.ProductType{{Code: "обувь", Active: true}})
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	pvzID := uuid.NewV4()
+	checker := mocks.NewMockScheduleChecker(ctrl)
+	checker.EXPECT().CheckOpen(gomock.Any(), pvzID, gomock.Any()).Return(schedule.ErrOutsideWorkingHours).Times(2)
+
+	// до репозитория дело не доходит
+	uc := CreatePvzUsecase(mocks.NewMockPvzRepo(ctrl), acceptEvents(ctrl), checker, Config{})
+
+	_, err := uc.CreateReception(context.Background(), pvzID)
+	assert.ErrorIs(t, err, schedule.ErrOutsideWorkingHours)
+
+	_, err = uc.AddProduct(context.Background(), models.AddProductReq{PvzId: pvzID, Type: "обувь"})
+	assert.ErrorIs(t, err, schedule.ErrOutsideWorkingHours)
+}
+
+func TestPvzUsecase_CloseReception(t *testing.T) {
+	pvzID, userID := uuid.NewV4(), uuid.NewV4()
+	closedAt := time.Date(2025, 3, 10, 18, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name         string
+		user         models.Principal
+		wantClosedBy *uuid.UUID
+	}{
+		{"registered user", models.Principal{UserId: userID, Role: models.RoleEmployee}, &userID},
+		// токен dummyLogin без sub: закрывший приёмку не записывается
+		{"token without user id", models.Principal{Role: models.RoleEmployee}, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reception := models.Reception{Id: uuid.NewV4(), PvzId: pvzID, Status: models.StatusInProgress}
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repo := mocks.NewMockPvzRepo(ctrl)
+			runInTx(repo)
+			gomock.InOrder(
+				repo.EXPECT().LockPvz(gomock.Any(), pvzID).Return(nil),
+				repo.EXPECT().GetActiveReception(gomock.Any(), pvzID).Return(reception, nil),
+				repo.EXPECT().GetLastProduct(gomock.Any(), pvzID).Return(models.Product{Id: uuid.NewV4()}, nil),
+				repo.EXPECT().UpdateReceptionStatus(gomock.Any(), reception.Id, models.StatusClose, tt.wantClosedBy).Return(&closedAt, nil),
+			)
+
+			events := outboxMocks.NewMockOutboxRepo(ctrl)
+			events.EXPECT().AddEvent(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, event models.Event) error {
+				assert.Equal(t, models.EventReceptionClosed, event.Type)
+				assert.Equal(t, pvzID, event.AggregateId)
+				assert.Contains(t, string(event.Payload), reception.Id.String())
+				assert.Contains(t, string(event.Payload), `"closedAt":"2025-03-10T18:00:00Z"`)
+				return nil
+			})
+
+			ctx := principal.WithPrincipal(context.Background(), tt.user)
+			result, err := CreatePvzUsecase(repo, events, alwaysOpen(ctrl), Config{}).CloseReception(ctx, pvzID)
+			require.NoError(t, err)
+			assert.Equal(t, models.StatusClose, result.Status)
+			assert.Equal(t, tt.wantClosedBy, result.ClosedBy)
+			assert.Equal(t, &closedAt, result.ClosedAt)
+		})
+	}
+}
@@ -0,0 +1,81 @@
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/K1tten2005/avito_pvz/internal/models"
+	"github.com/K1tten2005/avito_pvz/internal/pkg/report"
+	"github.com/K1tten2005/avito_pvz/internal/pkg/utils/logger"
+	"github.com/K1tten2005/avito_pvz/internal/pkg/utils/send_err"
+)
+
+const defaultReportRange = 7 * 24 * time.Hour
+
+type ReportHandler struct {
+	uc report.ReportUsecase
+}
+
+func CreateReportHandler(uc report.ReportUsecase) *ReportHandler {
+	return &ReportHandler{uc: uc}
+}
+
+func (h *ReportHandler) GetIntakeReport(w http.ResponseWriter, r *http.Request) {
+	loggerVar := logger.GetLoggerFromContext(r.Context()).With(slog.String("func", logger.GetFuncName()))
+
+	filter := models.IntakeReportFilter{
+		GroupBy: r.URL.Query().Get("groupBy"),
+		City:    r.URL.Query().Get("city"),
+		To:      time.Now(),
+	}
+	if filter.GroupBy == "" {
+		filter.GroupBy = models.ReportGroupByDay
+	}
+
+	if toStr := r.URL.Query().Get("to"); toStr != "" {
+		t, err := time.Parse(time.RFC3339, toStr)
+		if err != nil {
+			send_err.SendError(w, "wrong to format", http.StatusBadRequest)
+			return
+		}
+		filter.To = t
+	}
+	filter.From = filter.To.Add(-defaultReportRange)
+	if fromStr := r.URL.Query().Get("from"); fromStr != "" {
+		t, err := time.Parse(time.RFC3339, fromStr)
+		if err != nil {
+			send_err.SendError(w, "wrong from format", http.StatusBadRequest)
+			return
+		}
+		filter.From = t
+	}
+
+	intakeReport, err := h.uc.GetIntakeReport(r.Context(), filter)
+	if err != nil {
+		statusCode := errStatus(err)
+		logger.LogHandlerError(loggerVar, fmt.Errorf("error on a level below (usecase): %w", err), statusCode)
+		send_err.SendError(w, err.Error(), statusCode)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(intakeReport); err != nil {
+		logger.LogHandlerError(loggerVar, fmt.Errorf("error while forming JSON: %w", err), http.StatusInternalServerError)
+		send_err.SendError(w, "error while forming JSON", http.StatusInternalServerError)
+		return
+	}
+	logger.LogHandlerInfo(loggerVar, "Successful", http.StatusOK)
+}
+
+func errStatus(err error) int {
+	switch {
+	case errors.Is(err, report.ErrInvalidGroupBy), errors.Is(err, report.ErrInvalidRange), errors.Is(err, report.ErrInvalidCity):
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
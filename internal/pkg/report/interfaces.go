@@ -0,0 +1,23 @@
+package report
+
+import (
+	"context"
+	"errors"
+
+	"github.com/K1tten2005/avito_pvz/internal/models"
+)
+
+var (
+	ErrInvalidGroupBy = errors.New("invalid groupBy")
+	ErrInvalidRange   = errors.New("invalid date range")
+	ErrInvalidCity    = errors.New("invalid city")
+)
+
+type ReportRepo interface {
+	SelectReceptionStats(ctx context.Context, filter models.IntakeReportFilter) ([]models.IntakeReportRow, error)
+	SelectProductCounts(ctx context.Context, filter models.IntakeReportFilter) ([]models.IntakeProductCount, error)
+}
+
+type ReportUsecase interface {
+	GetIntakeReport(ctx context.Context, filter models.IntakeReportFilter) (models.IntakeReport, error)
+}
@@ -0,0 +1,104 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/pkg/report/interfaces.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	models "github.com/K1tten2005/avito_pvz/internal/models"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockReportRepo is a mock of ReportRepo interface.
+type MockReportRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockReportRepoMockRecorder
+}
+
+// MockReportRepoMockRecorder is the mock recorder for MockReportRepo.
+type MockReportRepoMockRecorder struct {
+	mock *MockReportRepo
+}
+
+// NewMockReportRepo creates a new mock instance.
+func NewMockReportRepo(ctrl *gomock.Controller) *MockReportRepo {
+	mock := &MockReportRepo{ctrl: ctrl}
+	mock.recorder = &MockReportRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReportRepo) EXPECT() *MockReportRepoMockRecorder {
+	return m.recorder
+}
+
+// SelectProductCounts mocks base method.
+func (m *MockReportRepo) SelectProductCounts(ctx context.Context, filter models.IntakeReportFilter) ([]models.IntakeProductCount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectProductCounts", ctx, filter)
+	ret0, _ := ret[0].([]models.IntakeProductCount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectProductCounts indicates an expected call of SelectProductCounts.
+func (mr *MockReportRepoMockRecorder) SelectProductCounts(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectProductCounts", reflect.TypeOf((*MockReportRepo)(nil).SelectProductCounts), ctx, filter)
+}
+
+// SelectReceptionStats mocks base method.
+func (m *MockReportRepo) SelectReceptionStats(ctx context.Context, filter models.IntakeReportFilter) ([]models.IntakeReportRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectReceptionStats", ctx, filter)
+	ret0, _ := ret[0].([]models.IntakeReportRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectReceptionStats indicates an expected call of SelectReceptionStats.
+func (mr *MockReportRepoMockRecorder) SelectReceptionStats(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectReceptionStats", reflect.TypeOf((*MockReportRepo)(nil).SelectReceptionStats), ctx, filter)
+}
+
+// MockReportUsecase is a mock of ReportUsecase interface.
+type MockReportUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockReportUsecaseMockRecorder
+}
+
+// MockReportUsecaseMockRecorder is the mock recorder for MockReportUsecase.
+type MockReportUsecaseMockRecorder struct {
+	mock *MockReportUsecase
+}
+
+// NewMockReportUsecase creates a new mock instance.
+func NewMockReportUsecase(ctrl *gomock.Controller) *MockReportUsecase {
+	mock := &MockReportUsecase{ctrl: ctrl}
+	mock.recorder = &MockReportUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReportUsecase) EXPECT() *MockReportUsecaseMockRecorder {
+	return m.recorder
+}
+
+// GetIntakeReport mocks base method.
+func (m *MockReportUsecase) GetIntakeReport(ctx context.Context, filter models.IntakeReportFilter) (models.IntakeReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetIntakeReport", ctx, filter)
+	ret0, _ := ret[0].(models.IntakeReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetIntakeReport indicates an expected call of GetIntakeReport.
+func (mr *MockReportUsecaseMockRecorder) GetIntakeReport(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIntakeReport", reflect.TypeOf((*MockReportUsecase)(nil).GetIntakeReport), ctx, filter)
+}
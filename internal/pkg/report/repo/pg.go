@@ -0,0 +1,96 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	_ "embed"
+	"log/slog"
+
+	"github.com/K1tten2005/avito_pvz/internal/models"
+	"github.com/K1tten2005/avito_pvz/internal/pkg/utils/logger"
+	"github.com/jackc/pgtype/pgxtype"
+)
+
+//go:embed sql/selectReceptionStats.sql
+var selectReceptionStats string
+
+//go:embed sql/selectProductCounts.sql
+var selectProductCounts string
+
+type ReportRepo struct {
+	db pgxtype.Querier
+}
+
+func CreateReportRepo(db pgxtype.Querier) *ReportRepo {
+	return &ReportRepo{
+		db: db,
+	}
+}
+
+func (repo *ReportRepo) SelectReceptionStats(ctx context.Context, filter models.IntakeReportFilter) ([]models.IntakeReportRow, error) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	rows, err := repo.db.Query(ctx, selectReceptionStats, filter.From, filter.To, filter.City, filter.GroupBy)
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return nil, err
+	}
+	defer rows.Close()
+
+	result := []models.IntakeReportRow{}
+	for rows.Next() {
+		var (
+			row         models.IntakeReportRow
+			avgDuration sql.NullFloat64
+			p95Duration sql.NullFloat64
+		)
+		if err := rows.Scan(&row.Key, &row.ReceptionsOpened, &row.ReceptionsClosed, &avgDuration, &p95Duration); err != nil {
+			loggerVar.Error(err.Error())
+			return nil, err
+		}
+		if avgDuration.Valid {
+			row.AvgReceptionDurationSec = &avgDuration.Float64
+		}
+		if p95Duration.Valid {
+			row.P95ReceptionDurationSec = &p95Duration.Float64
+		}
+		result = append(result, row)
+	}
+
+	if err := rows.Err(); err != nil {
+		loggerVar.Error(err.Error())
+		return nil, err
+	}
+
+	loggerVar.Info("Successful")
+	return result, nil
+}
+
+func (repo *ReportRepo) SelectProductCounts(ctx context.Context, filter models.IntakeReportFilter) ([]models.IntakeProductCount, error) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	rows, err := repo.db.Query(ctx, selectProductCounts, filter.From, filter.To, filter.City, filter.GroupBy)
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return nil, err
+	}
+	defer rows.Close()
+
+	result := []models.IntakeProductCount{}
+	for rows.Next() {
+		var count models.IntakeProductCount
+		if err := rows.Scan(&count.Key, &count.Type, &count.Count); err != nil {
+			loggerVar.Error(err.Error())
+			return nil, err
+		}
+		result = append(result, count)
+	}
+
+	if err := rows.Err(); err != nil {
+		loggerVar.Error(err.Error())
+		return nil, err
+	}
+
+	loggerVar.Info("Successful")
+	return result, nil
+}
@@ -0,0 +1,17 @@
+SELECT CASE $4::text
+        WHEN 'city' THEN pvz.city
+        WHEN 'pvz' THEN reception.pvz_id::text
+        WHEN 'product_type' THEN product.category
+        WHEN 'day' THEN to_char(date_trunc('day', reception.reception_time AT TIME ZONE 'UTC'), 'YYYY-MM-DD"T"HH24:MI:SS"Z"')
+        WHEN 'hour' THEN to_char(date_trunc('hour', reception.reception_time AT TIME ZONE 'UTC'), 'YYYY-MM-DD"T"HH24:MI:SS"Z"')
+    END AS key,
+    product.category,
+    count(*)
+FROM product
+JOIN reception ON reception.id = product.reception_id
+JOIN pvz ON pvz.id = reception.pvz_id
+WHERE product.deleted_at IS NULL
+    AND reception.reception_time >= $1 AND reception.reception_time < $2
+    AND ($3::text = '' OR pvz.city = $3)
+GROUP BY 1, 2
+ORDER BY 1, 2
@@ -0,0 +1,32 @@
+WITH rec AS (
+    SELECT reception.id, reception.pvz_id, pvz.city, reception.reception_time, reception.closed_at, reception.status
+    FROM reception
+    JOIN pvz ON pvz.id = reception.pvz_id
+    WHERE reception.reception_time >= $1 AND reception.reception_time < $2
+        AND ($3::text = '' OR pvz.city = $3)
+),
+keyed AS (
+    SELECT CASE $4::text
+            WHEN 'city' THEN rec.city
+            WHEN 'pvz' THEN rec.pvz_id::text
+            WHEN 'day' THEN to_char(date_trunc('day', rec.reception_time AT TIME ZONE 'UTC'), 'YYYY-MM-DD"T"HH24:MI:SS"Z"')
+            WHEN 'hour' THEN to_char(date_trunc('hour', rec.reception_time AT TIME ZONE 'UTC'), 'YYYY-MM-DD"T"HH24:MI:SS"Z"')
+        END AS key,
+        rec.status, rec.reception_time, rec.closed_at
+    FROM rec
+    WHERE $4::text <> 'product_type'
+    UNION ALL
+    -- приёмка попадает в группу каждого типа, который в ней принят
+    SELECT DISTINCT ON (product.category, rec.id) product.category, rec.status, rec.reception_time, rec.closed_at
+    FROM rec
+    JOIN product ON product.reception_id = rec.id AND product.deleted_at IS NULL
+    WHERE $4::text = 'product_type'
+)
+SELECT key,
+    count(*) AS opened,
+    count(*) FILTER (WHERE status = 'close') AS closed,
+    avg(EXTRACT(EPOCH FROM closed_at - reception_time))::float8 AS avg_duration,
+    percentile_cont(0.95) WITHIN GROUP (ORDER BY EXTRACT(EPOCH FROM closed_at - reception_time)) AS p95_duration
+FROM keyed
+GROUP BY key
+ORDER BY key
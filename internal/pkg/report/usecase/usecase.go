@@ -0,0 +1,93 @@
+package usecase
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/K1tten2005/avito_pvz/internal/models"
+	"github.com/K1tten2005/avito_pvz/internal/pkg/report"
+	"github.com/K1tten2005/avito_pvz/internal/pkg/utils/logger"
+	"github.com/K1tten2005/avito_pvz/internal/pkg/utils/validation"
+)
+
+const (
+	maxReportRange       = 366 * 24 * time.Hour
+	maxHourlyReportRange = 31 * 24 * time.Hour
+)
+
+type ReportUsecase struct {
+	repo report.ReportRepo
+}
+
+func CreateReportUsecase(repo report.ReportRepo) *ReportUsecase {
+	return &ReportUsecase{repo: repo}
+}
+
+func (uc *ReportUsecase) GetIntakeReport(ctx context.Context, filter models.IntakeReportFilter) (models.IntakeReport, error) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	if err := normalizeFilter(&filter); err != nil {
+		loggerVar.Error(err.Error())
+		return models.IntakeReport{}, err
+	}
+
+	rows, err := uc.repo.SelectReceptionStats(ctx, filter)
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return models.IntakeReport{}, err
+	}
+
+	counts, err := uc.repo.SelectProductCounts(ctx, filter)
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return models.IntakeReport{}, err
+	}
+
+	byKey := make(map[string]int, len(rows))
+	for i := range rows {
+		rows[i].ProductsByType = map[string]int{}
+		byKey[rows[i].Key] = i
+	}
+	for _, count := range counts {
+		i, ok := byKey[count.Key]
+		if !ok {
+			continue
+		}
+		rows[i].ProductsByType[count.Type] += count.Count
+		rows[i].Products += count.Count
+	}
+
+	loggerVar.Info("Success")
+	return models.IntakeReport{
+		GroupBy: filter.GroupBy,
+		From:    filter.From,
+		To:      filter.To,
+		City:    filter.City,
+		Rows:    rows,
+	}, nil
+}
+
+func normalizeFilter(filter *models.IntakeReportFilter) error {
+	maxRange := maxReportRange
+	switch filter.GroupBy {
+	case models.ReportGroupByCity, models.ReportGroupByPvz, models.ReportGroupByProductType, models.ReportGroupByDay:
+	case models.ReportGroupByHour:
+		maxRange = maxHourlyReportRange
+	default:
+		return report.ErrInvalidGroupBy
+	}
+
+	if !filter.From.Before(filter.To) || filter.To.Sub(filter.From) > maxRange {
+		return report.ErrInvalidRange
+	}
+
+	if filter.City != "" {
+		city, ok := validation.NormalizeCity(filter.City)
+		if !ok {
+			return report.ErrInvalidCity
+		}
+		filter.City = city
+	}
+	return nil
+}
@@ -0,0 +1,86 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/K1tten2005/avito_pvz/internal/models"
+	"github.com/K1tten2005/avito_pvz/internal/pkg/report"
+	"github.com/K1tten2005/avito_pvz/internal/pkg/report/mocks"
+	"github.com/K1tten2005/avito_pvz/internal/pkg/utils/validation"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReportUsecase_GetIntakeReport(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	validation.SetCities([]models.City{{Name: "Москва", Aliases: []string{"Msk"}, Enabled: true}})
+
+	to := time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC)
+	filter := models.IntakeReportFilter{GroupBy: models.ReportGroupByCity, From: to.AddDate(0, 0, -7), To: to, City: "msk"}
+	normalized := filter
+	normalized.City = "Москва"
+
+	avg, p95 := 600.0, 1500.0
+	repo := mocks.NewMockReportRepo(ctrl)
+	repo.EXPECT().SelectReceptionStats(gomock.Any(), normalized).Return([]models.IntakeReportRow{
+		{Key: "Москва", ReceptionsOpened: 3, ReceptionsClosed: 2, AvgReceptionDurationSec: &avg, P95ReceptionDurationSec: &p95},
+	}, nil)
+	repo.EXPECT().SelectProductCounts(gomock.Any(), normalized).Return([]models.IntakeProductCount{
+		{Key: "Москва", Type: "обувь", Count: 4},
+		{Key: "Москва", Type: "одежда", Count: 6},
+	}, nil)
+
+	result, err := CreateReportUsecase(repo).GetIntakeReport(context.Background(), filter)
+	require.NoError(t, err)
+	assert.Equal(t, "Москва", result.City)
+	require.Len(t, result.Rows, 1)
+	assert.Equal(t, 10, result.Rows[0].Products)
+	assert.Equal(t, map[string]int{"обувь": 4, "одежда": 6}, result.Rows[0].ProductsByType)
+	assert.Equal(t, 2, result.Rows[0].ReceptionsClosed)
+}
+
+func TestReportUsecase_GetIntakeReport_InvalidFilter(t *testing.T) {
+	to := time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		filter      models.IntakeReportFilter
+		expectedErr error
+	}{
+		{
+			name:        "unknown groupBy",
+			filter:      models.IntakeReportFilter{GroupBy: "week", From: to.AddDate(0, 0, -1), To: to},
+			expectedErr: report.ErrInvalidGroupBy,
+		},
+		{
+			name:        "reversed range",
+			filter:      models.IntakeReportFilter{GroupBy: models.ReportGroupByDay, From: to, To: to.AddDate(0, 0, -1)},
+			expectedErr: report.ErrInvalidRange,
+		},
+		{
+			name:        "hourly range too long",
+			filter:      models.IntakeReportFilter{GroupBy: models.ReportGroupByHour, From: to.AddDate(0, -2, 0), To: to},
+			expectedErr: report.ErrInvalidRange,
+		},
+		{
+			name:        "unknown city",
+			filter:      models.IntakeReportFilter{GroupBy: models.ReportGroupByPvz, From: to.AddDate(0, 0, -1), To: to, City: "Тверь"},
+			expectedErr: report.ErrInvalidCity,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			_, err := CreateReportUsecase(mocks.NewMockReportRepo(ctrl)).GetIntakeReport(context.Background(), tt.filter)
+			assert.ErrorIs(t, err, tt.expectedErr)
+		})
+	}
+}
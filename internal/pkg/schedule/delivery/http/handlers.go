@@ -0,0 +1,239 @@
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/K1tten2005/avito_pvz/internal/models"
+	"github.com/K1tten2005/avito_pvz/internal/pkg/schedule"
+	"github.com/K1tten2005/avito_pvz/internal/pkg/utils/logger"
+	"github.com/K1tten2005/avito_pvz/internal/pkg/utils/send_err"
+	"github.com/gorilla/mux"
+	"github.com/mailru/easyjson"
+	"github.com/satori/uuid"
+)
+
+type ScheduleHandler struct {
+	uc schedule.ScheduleUsecase
+}
+
+func CreateScheduleHandler(uc schedule.ScheduleUsecase) *ScheduleHandler {
+	return &ScheduleHandler{uc: uc}
+}
+
+func (h *ScheduleHandler) GetSchedule(w http.ResponseWriter, r *http.Request) {
+	loggerVar := logger.GetLoggerFromContext(r.Context()).With(slog.String("func", logger.GetFuncName()))
+
+	pvzID, err := uuid.FromString(mux.Vars(r)["pvzId"])
+	if err != nil {
+		logger.LogHandlerError(loggerVar, err, http.StatusBadRequest)
+		send_err.SendError(w, "wrong UUID format for pvzId query parameter", http.StatusBadRequest)
+		return
+	}
+
+	result, err := h.uc.GetSchedule(r.Context(), pvzID)
+	if err != nil {
+		statusCode := errStatus(err)
+		logger.LogHandlerError(loggerVar, fmt.Errorf("error on a level below (usecase): %w", err), statusCode)
+		send_err.SendError(w, err.Error(), statusCode)
+		return
+	}
+
+	sendJSON(w, loggerVar, result, http.StatusOK)
+}
+
+func (h *ScheduleHandler) UpdateSchedule(w http.ResponseWriter, r *http.Request) {
+	loggerVar := logger.GetLoggerFromContext(r.Context()).With(slog.String("func", logger.GetFuncName()))
+
+	pvzID, err := uuid.FromString(mux.Vars(r)["pvzId"])
+	if err != nil {
+		logger.LogHandlerError(loggerVar, err, http.StatusBadRequest)
+		send_err.SendError(w, "wrong UUID format for pvzId query parameter", http.StatusBadRequest)
+		return
+	}
+
+	var req models.UpdateScheduleReq
+	if err := easyjson.UnmarshalFromReader(r.Body, &req); err != nil {
+		logger.LogHandlerError(loggerVar, fmt.Errorf("error while parsing JSON: %w", err), http.StatusBadRequest)
+		send_err.SendError(w, "error while parsing JSON", http.StatusBadRequest)
+		return
+	}
+
+	result, err := h.uc.UpdateSchedule(r.Context(), pvzID, req)
+	if err != nil {
+		statusCode := errStatus(err)
+		logger.LogHandlerError(loggerVar, fmt.Errorf("error on a level below (usecase): %w", err), statusCode)
+		send_err.SendError(w, err.Error(), statusCode)
+		return
+	}
+
+	sendJSON(w, loggerVar, result, http.StatusOK)
+}
+
+func (h *ScheduleHandler) SetOverride(w http.ResponseWriter, r *http.Request) {
+	loggerVar := logger.GetLoggerFromContext(r.Context()).With(slog.String("func", logger.GetFuncName()))
+
+	pvzID, err := uuid.FromString(mux.Vars(r)["pvzId"])
+	if err != nil {
+		logger.LogHandlerError(loggerVar, err, http.StatusBadRequest)
+		send_err.SendError(w, "wrong UUID format for pvzId query parameter", http.StatusBadRequest)
+		return
+	}
+
+	var req models.ScheduleOverrideReq
+	if err := easyjson.UnmarshalFromReader(r.Body, &req); err != nil {
+		logger.LogHandlerError(loggerVar, fmt.Errorf("error while parsing JSON: %w", err), http.StatusBadRequest)
+		send_err.SendError(w, "error while parsing JSON", http.StatusBadRequest)
+		return
+	}
+
+	result, err := h.uc.SetOverride(r.Context(), pvzID, &req.Until)
+	if err != nil {
+		statusCode := errStatus(err)
+		logger.LogHandlerError(loggerVar, fmt.Errorf("error on a level below (usecase): %w", err), statusCode)
+		send_err.SendError(w, err.Error(), statusCode)
+		return
+	}
+
+	sendJSON(w, loggerVar, result, http.StatusOK)
+}
+
+func (h *ScheduleHandler) ClearOverride(w http.ResponseWriter, r *http.Request) {
+	loggerVar := logger.GetLoggerFromContext(r.Context()).With(slog.String("func", logger.GetFuncName()))
+
+	pvzID, err := uuid.FromString(mux.Vars(r)["pvzId"])
+	if err != nil {
+		logger.LogHandlerError(loggerVar, err, http.StatusBadRequest)
+		send_err.SendError(w, "wrong UUID format for pvzId query parameter", http.StatusBadRequest)
+		return
+	}
+
+	result, err := h.uc.SetOverride(r.Context(), pvzID, nil)
+	if err != nil {
+		statusCode := errStatus(err)
+		logger.LogHandlerError(loggerVar, fmt.Errorf("error on a level below (usecase): %w", err), statusCode)
+		send_err.SendError(w, err.Error(), statusCode)
+		return
+	}
+
+	sendJSON(w, loggerVar, result, http.StatusOK)
+}
+
+func (h *ScheduleHandler) GetHolidays(w http.ResponseWriter, r *http.Request) {
+	loggerVar := logger.GetLoggerFromContext(r.Context()).With(slog.String("func", logger.GetFuncName()))
+
+	pvzID, err := uuid.FromString(mux.Vars(r)["pvzId"])
+	if err != nil {
+		logger.LogHandlerError(loggerVar, err, http.StatusBadRequest)
+		send_err.SendError(w, "wrong UUID format for pvzId query parameter", http.StatusBadRequest)
+		return
+	}
+
+	filter := models.HolidayFilter{PvzId: pvzID}
+	if fromStr := r.URL.Query().Get("from"); fromStr != "" {
+		t, err := time.Parse(time.DateOnly, fromStr)
+		if err != nil {
+			logger.LogHandlerError(loggerVar, fmt.Errorf("wrong from format: %w", err), http.StatusBadRequest)
+			send_err.SendError(w, "wrong from format", http.StatusBadRequest)
+			return
+		}
+		filter.From = &t
+	}
+	if toStr := r.URL.Query().Get("to"); toStr != "" {
+		t, err := time.Parse(time.DateOnly, toStr)
+		if err != nil {
+			logger.LogHandlerError(loggerVar, fmt.Errorf("wrong to format: %w", err), http.StatusBadRequest)
+			send_err.SendError(w, "wrong to format", http.StatusBadRequest)
+			return
+		}
+		filter.To = &t
+	}
+
+	holidays, err := h.uc.GetHolidays(r.Context(), filter)
+	if err != nil {
+		statusCode := errStatus(err)
+		logger.LogHandlerError(loggerVar, fmt.Errorf("error on a level below (usecase): %w", err), statusCode)
+		send_err.SendError(w, err.Error(), statusCode)
+		return
+	}
+
+	sendJSON(w, loggerVar, holidays, http.StatusOK)
+}
+
+func (h *ScheduleHandler) PutHoliday(w http.ResponseWriter, r *http.Request) {
+	loggerVar := logger.GetLoggerFromContext(r.Context()).With(slog.String("func", logger.GetFuncName()))
+
+	pvzID, err := uuid.FromString(mux.Vars(r)["pvzId"])
+	if err != nil {
+		logger.LogHandlerError(loggerVar, err, http.StatusBadRequest)
+		send_err.SendError(w, "wrong UUID format for pvzId query parameter", http.StatusBadRequest)
+		return
+	}
+
+	var holiday models.PvzHoliday
+	if err := easyjson.UnmarshalFromReader(r.Body, &holiday); err != nil {
+		logger.LogHandlerError(loggerVar, fmt.Errorf("error while parsing JSON: %w", err), http.StatusBadRequest)
+		send_err.SendError(w, "error while parsing JSON", http.StatusBadRequest)
+		return
+	}
+	// Дата берётся из пути, в теле она не нужна
+	holiday.Date = mux.Vars(r)["date"]
+
+	result, err := h.uc.PutHoliday(r.Context(), pvzID, holiday)
+	if err != nil {
+		statusCode := errStatus(err)
+		logger.LogHandlerError(loggerVar, fmt.Errorf("error on a level below (usecase): %w", err), statusCode)
+		send_err.SendError(w, err.Error(), statusCode)
+		return
+	}
+
+	sendJSON(w, loggerVar, result, http.StatusOK)
+}
+
+func (h *ScheduleHandler) DeleteHoliday(w http.ResponseWriter, r *http.Request) {
+	loggerVar := logger.GetLoggerFromContext(r.Context()).With(slog.String("func", logger.GetFuncName()))
+
+	pvzID, err := uuid.FromString(mux.Vars(r)["pvzId"])
+	if err != nil {
+		logger.LogHandlerError(loggerVar, err, http.StatusBadRequest)
+		send_err.SendError(w, "wrong UUID format for pvzId query parameter", http.StatusBadRequest)
+		return
+	}
+
+	if err := h.uc.DeleteHoliday(r.Context(), pvzID, mux.Vars(r)["date"]); err != nil {
+		statusCode := errStatus(err)
+		logger.LogHandlerError(loggerVar, fmt.Errorf("error on a level below (usecase): %w", err), statusCode)
+		send_err.SendError(w, err.Error(), statusCode)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+	logger.LogHandlerInfo(loggerVar, "Successful", http.StatusNoContent)
+}
+
+func sendJSON(w http.ResponseWriter, loggerVar *slog.Logger, body any, statusCode int) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	if err := json.NewEncoder(w).Encode(body); err != nil {
+		logger.LogHandlerError(loggerVar, fmt.Errorf("error while forming JSON: %w", err), http.StatusInternalServerError)
+		send_err.SendError(w, "error while forming JSON", http.StatusInternalServerError)
+		return
+	}
+	logger.LogHandlerInfo(loggerVar, "Successful", statusCode)
+}
+
+func errStatus(err error) int {
+	switch {
+	case errors.Is(err, schedule.ErrPvzNotFound), errors.Is(err, schedule.ErrHolidayNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, schedule.ErrInvalidTimezone), errors.Is(err, schedule.ErrInvalidWorkingHours),
+		errors.Is(err, schedule.ErrInvalidDate), errors.Is(err, schedule.ErrInvalidOverride):
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,45 @@
+package schedule
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/K1tten2005/avito_pvz/internal/models"
+	"github.com/satori/uuid"
+)
+
+var (
+	ErrPvzNotFound         = errors.New("pvz not found")
+	ErrHolidayNotFound     = errors.New("holiday not found")
+	ErrInvalidTimezone     = errors.New("invalid timezone")
+	ErrInvalidWorkingHours = errors.New("invalid working hours")
+	ErrInvalidDate         = errors.New("invalid date")
+	ErrInvalidOverride     = errors.New("override must end in the future")
+	ErrOutsideWorkingHours = errors.New("pvz is outside working hours")
+)
+
+type ScheduleRepo interface {
+	// WithinTx выполняет fn в одной транзакции: все вызовы репозитория с полученным ctx попадают в неё
+	WithinTx(ctx context.Context, fn func(ctx context.Context) error) error
+	SelectSchedule(ctx context.Context, pvzID uuid.UUID) (models.PvzSchedule, error)
+	UpdateTimezone(ctx context.Context, pvzID uuid.UUID, timezone string) error
+	// ReplaceWorkingHours заменяет недельный график ПВЗ целиком
+	ReplaceWorkingHours(ctx context.Context, pvzID uuid.UUID, hours []models.WorkingHours) error
+	UpdateOverride(ctx context.Context, pvzID uuid.UUID, until *time.Time) error
+	SelectHolidays(ctx context.Context, filter models.HolidayFilter) ([]models.PvzHoliday, error)
+	UpsertHoliday(ctx context.Context, pvzID uuid.UUID, holiday models.PvzHoliday) error
+	DeleteHoliday(ctx context.Context, pvzID uuid.UUID, date string) error
+}
+
+type ScheduleUsecase interface {
+	GetSchedule(ctx context.Context, pvzID uuid.UUID) (models.PvzSchedule, error)
+	UpdateSchedule(ctx context.Context, pvzID uuid.UUID, req models.UpdateScheduleReq) (models.PvzSchedule, error)
+	// SetOverride разрешает приёмку вне графика до until; nil снимает разрешение
+	SetOverride(ctx context.Context, pvzID uuid.UUID, until *time.Time) (models.PvzSchedule, error)
+	GetHolidays(ctx context.Context, filter models.HolidayFilter) ([]models.PvzHoliday, error)
+	PutHoliday(ctx context.Context, pvzID uuid.UUID, holiday models.PvzHoliday) (models.PvzHoliday, error)
+	DeleteHoliday(ctx context.Context, pvzID uuid.UUID, date string) error
+	// CheckOpen возвращает ErrOutsideWorkingHours, если в момент at ПВЗ по графику закрыт
+	CheckOpen(ctx context.Context, pvzID uuid.UUID, at time.Time) error
+}
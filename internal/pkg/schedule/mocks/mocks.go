@@ -0,0 +1,278 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/pkg/schedule/interfaces.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	models "github.com/K1tten2005/avito_pvz/internal/models"
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/satori/uuid"
+)
+
+// MockScheduleRepo is a mock of ScheduleRepo interface.
+type MockScheduleRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockScheduleRepoMockRecorder
+}
+
+// MockScheduleRepoMockRecorder is the mock recorder for MockScheduleRepo.
+type MockScheduleRepoMockRecorder struct {
+	mock *MockScheduleRepo
+}
+
+// NewMockScheduleRepo creates a new mock instance.
+func NewMockScheduleRepo(ctrl *gomock.Controller) *MockScheduleRepo {
+	mock := &MockScheduleRepo{ctrl: ctrl}
+	mock.recorder = &MockScheduleRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockScheduleRepo) EXPECT() *MockScheduleRepoMockRecorder {
+	return m.recorder
+}
+
+// DeleteHoliday mocks base method.
+func (m *MockScheduleRepo) DeleteHoliday(ctx context.Context, pvzID uuid.UUID, date string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteHoliday", ctx, pvzID, date)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteHoliday indicates an expected call of DeleteHoliday.
+func (mr *MockScheduleRepoMockRecorder) DeleteHoliday(ctx, pvzID, date interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteHoliday", reflect.TypeOf((*MockScheduleRepo)(nil).DeleteHoliday), ctx, pvzID, date)
+}
+
+// ReplaceWorkingHours mocks base method.
+func (m *MockScheduleRepo) ReplaceWorkingHours(ctx context.Context, pvzID uuid.UUID, hours []models.WorkingHours) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceWorkingHours", ctx, pvzID, hours)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReplaceWorkingHours indicates an expected call of ReplaceWorkingHours.
+func (mr *MockScheduleRepoMockRecorder) ReplaceWorkingHours(ctx, pvzID, hours interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceWorkingHours", reflect.TypeOf((*MockScheduleRepo)(nil).ReplaceWorkingHours), ctx, pvzID, hours)
+}
+
+// SelectHolidays mocks base method.
+func (m *MockScheduleRepo) SelectHolidays(ctx context.Context, filter models.HolidayFilter) ([]models.PvzHoliday, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectHolidays", ctx, filter)
+	ret0, _ := ret[0].([]models.PvzHoliday)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectHolidays indicates an expected call of SelectHolidays.
+func (mr *MockScheduleRepoMockRecorder) SelectHolidays(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectHolidays", reflect.TypeOf((*MockScheduleRepo)(nil).SelectHolidays), ctx, filter)
+}
+
+// SelectSchedule mocks base method.
+func (m *MockScheduleRepo) SelectSchedule(ctx context.Context, pvzID uuid.UUID) (models.PvzSchedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectSchedule", ctx, pvzID)
+	ret0, _ := ret[0].(models.PvzSchedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectSchedule indicates an expected call of SelectSchedule.
+func (mr *MockScheduleRepoMockRecorder) SelectSchedule(ctx, pvzID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectSchedule", reflect.TypeOf((*MockScheduleRepo)(nil).SelectSchedule), ctx, pvzID)
+}
+
+// UpdateOverride mocks base method.
+func (m *MockScheduleRepo) UpdateOverride(ctx context.Context, pvzID uuid.UUID, until *time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateOverride", ctx, pvzID, until)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateOverride indicates an expected call of UpdateOverride.
+func (mr *MockScheduleRepoMockRecorder) UpdateOverride(ctx, pvzID, until interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateOverride", reflect.TypeOf((*MockScheduleRepo)(nil).UpdateOverride), ctx, pvzID, until)
+}
+
+// UpdateTimezone mocks base method.
+func (m *MockScheduleRepo) UpdateTimezone(ctx context.Context, pvzID uuid.UUID, timezone string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTimezone", ctx, pvzID, timezone)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateTimezone indicates an expected call of UpdateTimezone.
+func (mr *MockScheduleRepoMockRecorder) UpdateTimezone(ctx, pvzID, timezone interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTimezone", reflect.TypeOf((*MockScheduleRepo)(nil).UpdateTimezone), ctx, pvzID, timezone)
+}
+
+// UpsertHoliday mocks base method.
+func (m *MockScheduleRepo) UpsertHoliday(ctx context.Context, pvzID uuid.UUID, holiday models.PvzHoliday) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertHoliday", ctx, pvzID, holiday)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertHoliday indicates an expected call of UpsertHoliday.
+func (mr *MockScheduleRepoMockRecorder) UpsertHoliday(ctx, pvzID, holiday interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertHoliday", reflect.TypeOf((*MockScheduleRepo)(nil).UpsertHoliday), ctx, pvzID, holiday)
+}
+
+// WithinTx mocks base method.
+func (m *MockScheduleRepo) WithinTx(ctx context.Context, fn func(context.Context) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WithinTx", ctx, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WithinTx indicates an expected call of WithinTx.
+func (mr *MockScheduleRepoMockRecorder) WithinTx(ctx, fn interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithinTx", reflect.TypeOf((*MockScheduleRepo)(nil).WithinTx), ctx, fn)
+}
+
+// MockScheduleUsecase is a mock of ScheduleUsecase interface.
+type MockScheduleUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockScheduleUsecaseMockRecorder
+}
+
+// MockScheduleUsecaseMockRecorder is the mock recorder for MockScheduleUsecase.
+type MockScheduleUsecaseMockRecorder struct {
+	mock *MockScheduleUsecase
+}
+
+// NewMockScheduleUsecase creates a new mock instance.
+func NewMockScheduleUsecase(ctrl *gomock.Controller) *MockScheduleUsecase {
+	mock := &MockScheduleUsecase{ctrl: ctrl}
+	mock.recorder = &MockScheduleUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockScheduleUsecase) EXPECT() *MockScheduleUsecaseMockRecorder {
+	return m.recorder
+}
+
+// CheckOpen mocks base method.
+func (m *MockScheduleUsecase) CheckOpen(ctx context.Context, pvzID uuid.UUID, at time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckOpen", ctx, pvzID, at)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CheckOpen indicates an expected call of CheckOpen.
+func (mr *MockScheduleUsecaseMockRecorder) CheckOpen(ctx, pvzID, at interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckOpen", reflect.TypeOf((*MockScheduleUsecase)(nil).CheckOpen), ctx, pvzID, at)
+}
+
+// DeleteHoliday mocks base method.
+func (m *MockScheduleUsecase) DeleteHoliday(ctx context.Context, pvzID uuid.UUID, date string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteHoliday", ctx, pvzID, date)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteHoliday indicates an expected call of DeleteHoliday.
+func (mr *MockScheduleUsecaseMockRecorder) DeleteHoliday(ctx, pvzID, date interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteHoliday", reflect.TypeOf((*MockScheduleUsecase)(nil).DeleteHoliday), ctx, pvzID, date)
+}
+
+// GetHolidays mocks base method.
+func (m *MockScheduleUsecase) GetHolidays(ctx context.Context, filter models.HolidayFilter) ([]models.PvzHoliday, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHolidays", ctx, filter)
+	ret0, _ := ret[0].([]models.PvzHoliday)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHolidays indicates an expected call of GetHolidays.
+func (mr *MockScheduleUsecaseMockRecorder) GetHolidays(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHolidays", reflect.TypeOf((*MockScheduleUsecase)(nil).GetHolidays), ctx, filter)
+}
+
+// GetSchedule mocks base method.
+func (m *MockScheduleUsecase) GetSchedule(ctx context.Context, pvzID uuid.UUID) (models.PvzSchedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSchedule", ctx, pvzID)
+	ret0, _ := ret[0].(models.PvzSchedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSchedule indicates an expected call of GetSchedule.
+func (mr *MockScheduleUsecaseMockRecorder) GetSchedule(ctx, pvzID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSchedule", reflect.TypeOf((*MockScheduleUsecase)(nil).GetSchedule), ctx, pvzID)
+}
+
+// PutHoliday mocks base method.
+func (m *MockScheduleUsecase) PutHoliday(ctx context.Context, pvzID uuid.UUID, holiday models.PvzHoliday) (models.PvzHoliday, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutHoliday", ctx, pvzID, holiday)
+	ret0, _ := ret[0].(models.PvzHoliday)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutHoliday indicates an expected call of PutHoliday.
+func (mr *MockScheduleUsecaseMockRecorder) PutHoliday(ctx, pvzID, holiday interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutHoliday", reflect.TypeOf((*MockScheduleUsecase)(nil).PutHoliday), ctx, pvzID, holiday)
+}
+
+// SetOverride mocks base method.
+func (m *MockScheduleUsecase) SetOverride(ctx context.Context, pvzID uuid.UUID, until *time.Time) (models.PvzSchedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetOverride", ctx, pvzID, until)
+	ret0, _ := ret[0].(models.PvzSchedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetOverride indicates an expected call of SetOverride.
+func (mr *MockScheduleUsecaseMockRecorder) SetOverride(ctx, pvzID, until interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetOverride", reflect.TypeOf((*MockScheduleUsecase)(nil).SetOverride), ctx, pvzID, until)
+}
+
+// UpdateSchedule mocks base method.
+func (m *MockScheduleUsecase) UpdateSchedule(ctx context.Context, pvzID uuid.UUID, req models.UpdateScheduleReq) (models.PvzSchedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSchedule", ctx, pvzID, req)
+	ret0, _ := ret[0].(models.PvzSchedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateSchedule indicates an expected call of UpdateSchedule.
+func (mr *MockScheduleUsecaseMockRecorder) UpdateSchedule(ctx, pvzID, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSchedule", reflect.TypeOf((*MockScheduleUsecase)(nil).UpdateSchedule), ctx, pvzID, req)
+}
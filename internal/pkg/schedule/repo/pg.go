@@ -0,0 +1,213 @@
+package repo
+
+import (
+	"context"
+	_ "embed"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/K1tten2005/avito_pvz/internal/models"
+	"github.com/K1tten2005/avito_pvz/internal/pkg/schedule"
+	"github.com/K1tten2005/avito_pvz/internal/pkg/utils/logger"
+	"github.com/K1tten2005/avito_pvz/internal/pkg/utils/pgerr"
+	"github.com/K1tten2005/avito_pvz/internal/pkg/utils/pgtx"
+	"github.com/jackc/pgx/v4"
+	"github.com/satori/uuid"
+)
+
+//go:embed sql/selectSchedule.sql
+var selectSchedule string
+
+//go:embed sql/selectWorkingHours.sql
+var selectWorkingHours string
+
+//go:embed sql/updateTimezone.sql
+var updateTimezone string
+
+//go:embed sql/deleteWorkingHours.sql
+var deleteWorkingHours string
+
+//go:embed sql/insertWorkingHours.sql
+var insertWorkingHours string
+
+//go:embed sql/updateOverride.sql
+var updateOverride string
+
+//go:embed sql/selectHolidays.sql
+var selectHolidays string
+
+//go:embed sql/upsertHoliday.sql
+var upsertHoliday string
+
+//go:embed sql/deleteHoliday.sql
+var deleteHoliday string
+
+type ScheduleRepo struct {
+	db pgtx.Pool
+}
+
+func CreateScheduleRepo(db pgtx.Pool) *ScheduleRepo {
+	return &ScheduleRepo{
+		db: db,
+	}
+}
+
+func (repo *ScheduleRepo) conn(ctx context.Context) pgtx.Conn {
+	return pgtx.FromContext(ctx, repo.db)
+}
+
+func (repo *ScheduleRepo) WithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return pgtx.RunInTx(ctx, repo.db, fn)
+}
+
+func (repo *ScheduleRepo) SelectSchedule(ctx context.Context, pvzID uuid.UUID) (models.PvzSchedule, error) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	result := models.PvzSchedule{PvzId: pvzID, WeeklyHours: []models.WorkingHours{}}
+	err := repo.conn(ctx).QueryRow(ctx, selectSchedule, pvzID).Scan(&result.Timezone, &result.OverrideUntil)
+	if errors.Is(err, pgx.ErrNoRows) {
+		loggerVar.Error(schedule.ErrPvzNotFound.Error())
+		return models.PvzSchedule{}, schedule.ErrPvzNotFound
+	}
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return models.PvzSchedule{}, err
+	}
+
+	rows, err := repo.conn(ctx).Query(ctx, selectWorkingHours, pvzID)
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return models.PvzSchedule{}, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var hours models.WorkingHours
+		if err := rows.Scan(&hours.Weekday, &hours.OpensAt, &hours.ClosesAt); err != nil {
+			loggerVar.Error(err.Error())
+			return models.PvzSchedule{}, err
+		}
+		result.WeeklyHours = append(result.WeeklyHours, hours)
+	}
+	if err := rows.Err(); err != nil {
+		loggerVar.Error(err.Error())
+		return models.PvzSchedule{}, err
+	}
+
+	loggerVar.Info("Successful")
+	return result, nil
+}
+
+func (repo *ScheduleRepo) UpdateTimezone(ctx context.Context, pvzID uuid.UUID, timezone string) error {
+	return repo.updatePvz(ctx, updateTimezone, pvzID, timezone)
+}
+
+func (repo *ScheduleRepo) UpdateOverride(ctx context.Context, pvzID uuid.UUID, until *time.Time) error {
+	return repo.updatePvz(ctx, updateOverride, pvzID, until)
+}
+
+func (repo *ScheduleRepo) updatePvz(ctx context.Context, query string, args ...any) error {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	tag, err := repo.conn(ctx).Exec(ctx, query, args...)
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		loggerVar.Error(schedule.ErrPvzNotFound.Error())
+		return schedule.ErrPvzNotFound
+	}
+
+	loggerVar.Info("Successful")
+	return nil
+}
+
+func (repo *ScheduleRepo) ReplaceWorkingHours(ctx context.Context, pvzID uuid.UUID, hours []models.WorkingHours) error {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	if _, err := repo.conn(ctx).Exec(ctx, deleteWorkingHours, pvzID); err != nil {
+		loggerVar.Error(err.Error())
+		return err
+	}
+
+	weekdays := make([]int32, 0, len(hours))
+	opensAt := make([]string, 0, len(hours))
+	closesAt := make([]string, 0, len(hours))
+	for _, day := range hours {
+		weekdays = append(weekdays, int32(day.Weekday))
+		opensAt = append(opensAt, day.OpensAt)
+		closesAt = append(closesAt, day.ClosesAt)
+	}
+
+	if _, err := repo.conn(ctx).Exec(ctx, insertWorkingHours, pvzID, weekdays, opensAt, closesAt); err != nil {
+		loggerVar.Error(err.Error())
+		return err
+	}
+
+	loggerVar.Info("Successful")
+	return nil
+}
+
+func (repo *ScheduleRepo) SelectHolidays(ctx context.Context, filter models.HolidayFilter) ([]models.PvzHoliday, error) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	rows, err := repo.conn(ctx).Query(ctx, selectHolidays, filter.PvzId, filter.From, filter.To)
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return nil, err
+	}
+	defer rows.Close()
+
+	result := []models.PvzHoliday{}
+	for rows.Next() {
+		var holiday models.PvzHoliday
+		if err := rows.Scan(&holiday.Date, &holiday.OpensAt, &holiday.ClosesAt, &holiday.Note); err != nil {
+			loggerVar.Error(err.Error())
+			return nil, err
+		}
+		result = append(result, holiday)
+	}
+	if err := rows.Err(); err != nil {
+		loggerVar.Error(err.Error())
+		return nil, err
+	}
+
+	loggerVar.Info("Successful")
+	return result, nil
+}
+
+func (repo *ScheduleRepo) UpsertHoliday(ctx context.Context, pvzID uuid.UUID, holiday models.PvzHoliday) error {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	_, err := repo.conn(ctx).Exec(ctx, upsertHoliday, pvzID, holiday.Date, holiday.OpensAt, holiday.ClosesAt, holiday.Note)
+	if pgerr.IsForeignKeyViolation(err) {
+		loggerVar.Error(schedule.ErrPvzNotFound.Error())
+		return schedule.ErrPvzNotFound
+	}
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return err
+	}
+
+	loggerVar.Info("Successful")
+	return nil
+}
+
+func (repo *ScheduleRepo) DeleteHoliday(ctx context.Context, pvzID uuid.UUID, date string) error {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	tag, err := repo.conn(ctx).Exec(ctx, deleteHoliday, pvzID, date)
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		loggerVar.Error(schedule.ErrHolidayNotFound.Error())
+		return schedule.ErrHolidayNotFound
+	}
+
+	loggerVar.Info("Successful")
+	return nil
+}
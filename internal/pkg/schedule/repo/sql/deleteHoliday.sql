@@ -0,0 +1 @@
+DELETE FROM pvz_holiday WHERE pvz_id = $1 AND day = $2::text::date
\ No newline at end of file
@@ -0,0 +1 @@
+DELETE FROM pvz_working_hours WHERE pvz_id = $1
\ No newline at end of file
@@ -0,0 +1,3 @@
+INSERT INTO pvz_working_hours (pvz_id, weekday, opens_at, closes_at)
+SELECT $1, hours.weekday, hours.opens_at::time, hours.closes_at::time
+FROM unnest($2::int[], $3::text[], $4::text[]) AS hours (weekday, opens_at, closes_at)
\ No newline at end of file
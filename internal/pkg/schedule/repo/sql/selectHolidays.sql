@@ -0,0 +1,6 @@
+SELECT to_char(day, 'YYYY-MM-DD'), to_char(opens_at, 'HH24:MI'), to_char(closes_at, 'HH24:MI'), note
+FROM pvz_holiday
+WHERE pvz_id = $1
+    AND ($2::date IS NULL OR day >= $2)
+    AND ($3::date IS NULL OR day <= $3)
+ORDER BY day
\ No newline at end of file
@@ -0,0 +1 @@
+SELECT timezone, schedule_override_until FROM pvz WHERE id = $1
\ No newline at end of file
@@ -0,0 +1,4 @@
+SELECT weekday, to_char(opens_at, 'HH24:MI'), to_char(closes_at, 'HH24:MI')
+FROM pvz_working_hours
+WHERE pvz_id = $1
+ORDER BY weekday
\ No newline at end of file
@@ -0,0 +1 @@
+UPDATE pvz SET schedule_override_until = $2, updated_at = now() WHERE id = $1
\ No newline at end of file
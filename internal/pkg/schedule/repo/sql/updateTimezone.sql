@@ -0,0 +1 @@
+UPDATE pvz SET timezone = $2, updated_at = now() WHERE id = $1
\ No newline at end of file
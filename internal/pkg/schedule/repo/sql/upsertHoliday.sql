@@ -0,0 +1,6 @@
+INSERT INTO pvz_holiday (pvz_id, day, opens_at, closes_at, note)
+VALUES ($1, $2::text::date, $3::text::time, $4::text::time, $5)
+ON CONFLICT (pvz_id, day) DO UPDATE
+SET opens_at = EXCLUDED.opens_at,
+    closes_at = EXCLUDED.closes_at,
+    note = EXCLUDED.note
\ No newline at end of file
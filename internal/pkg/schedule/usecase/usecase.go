@@ -0,0 +1,280 @@
+package usecase
+
+import (
+	"context"
+	"log/slog"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/K1tten2005/avito_pvz/internal/models"
+	"github.com/K1tten2005/avito_pvz/internal/pkg/schedule"
+	"github.com/K1tten2005/avito_pvz/internal/pkg/utils/logger"
+	"github.com/satori/uuid"
+)
+
+const minutesPerDay = 24 * 60
+
+type ScheduleUsecase struct {
+	repo schedule.ScheduleRepo
+	now  func() time.Time
+}
+
+func CreateScheduleUsecase(repo schedule.ScheduleRepo) *ScheduleUsecase {
+	return &ScheduleUsecase{repo: repo, now: time.Now}
+}
+
+func (uc *ScheduleUsecase) GetSchedule(ctx context.Context, pvzID uuid.UUID) (models.PvzSchedule, error) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	result, err := uc.repo.SelectSchedule(ctx, pvzID)
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return models.PvzSchedule{}, err
+	}
+
+	loggerVar.Info("Success")
+	return result, nil
+}
+
+func (uc *ScheduleUsecase) UpdateSchedule(ctx context.Context, pvzID uuid.UUID, req models.UpdateScheduleReq) (models.PvzSchedule, error) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	if _, err := time.LoadLocation(req.Timezone); req.Timezone == "" || err != nil {
+		loggerVar.Error(schedule.ErrInvalidTimezone.Error())
+		return models.PvzSchedule{}, schedule.ErrInvalidTimezone
+	}
+
+	hours := slices.Clone(req.WeeklyHours)
+	slices.SortFunc(hours, func(a, b models.WorkingHours) int {
+		return a.Weekday - b.Weekday
+	})
+	for i, day := range hours {
+		if day.Weekday < 1 || day.Weekday > 7 || (i > 0 && hours[i-1].Weekday == day.Weekday) {
+			loggerVar.Error(schedule.ErrInvalidWorkingHours.Error())
+			return models.PvzSchedule{}, schedule.ErrInvalidWorkingHours
+		}
+		if _, _, ok := parseInterval(day.OpensAt, day.ClosesAt); !ok {
+			loggerVar.Error(schedule.ErrInvalidWorkingHours.Error())
+			return models.PvzSchedule{}, schedule.ErrInvalidWorkingHours
+		}
+	}
+
+	err := uc.repo.WithinTx(ctx, func(ctx context.Context) error {
+		if err := uc.repo.UpdateTimezone(ctx, pvzID, req.Timezone); err != nil {
+			return err
+		}
+		return uc.repo.ReplaceWorkingHours(ctx, pvzID, hours)
+	})
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return models.PvzSchedule{}, err
+	}
+
+	result, err := uc.repo.SelectSchedule(ctx, pvzID)
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return models.PvzSchedule{}, err
+	}
+
+	loggerVar.Info("Success")
+	return result, nil
+}
+
+func (uc *ScheduleUsecase) SetOverride(ctx context.Context, pvzID uuid.UUID, until *time.Time) (models.PvzSchedule, error) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	if until != nil && !until.After(uc.now()) {
+		loggerVar.Error(schedule.ErrInvalidOverride.Error())
+		return models.PvzSchedule{}, schedule.ErrInvalidOverride
+	}
+
+	if err := uc.repo.UpdateOverride(ctx, pvzID, until); err != nil {
+		loggerVar.Error(err.Error())
+		return models.PvzSchedule{}, err
+	}
+
+	result, err := uc.repo.SelectSchedule(ctx, pvzID)
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return models.PvzSchedule{}, err
+	}
+
+	loggerVar.Info("Success")
+	return result, nil
+}
+
+func (uc *ScheduleUsecase) GetHolidays(ctx context.Context, filter models.HolidayFilter) ([]models.PvzHoliday, error) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	if filter.From != nil && filter.To != nil && filter.From.After(*filter.To) {
+		loggerVar.Error(schedule.ErrInvalidDate.Error())
+		return nil, schedule.ErrInvalidDate
+	}
+
+	holidays, err := uc.repo.SelectHolidays(ctx, filter)
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return nil, err
+	}
+
+	loggerVar.Info("Success")
+	return holidays, nil
+}
+
+func (uc *ScheduleUsecase) PutHoliday(ctx context.Context, pvzID uuid.UUID, holiday models.PvzHoliday) (models.PvzHoliday, error) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	date, err := time.Parse(time.DateOnly, holiday.Date)
+	if err != nil {
+		loggerVar.Error(schedule.ErrInvalidDate.Error())
+		return models.PvzHoliday{}, schedule.ErrInvalidDate
+	}
+	holiday.Date = date.Format(time.DateOnly)
+	holiday.Note = strings.TrimSpace(holiday.Note)
+
+	// Время задаётся парой: сокращённый день, либо без времени — выходной
+	if (holiday.OpensAt == nil) != (holiday.ClosesAt == nil) {
+		loggerVar.Error(schedule.ErrInvalidWorkingHours.Error())
+		return models.PvzHoliday{}, schedule.ErrInvalidWorkingHours
+	}
+	if holiday.OpensAt != nil {
+		if _, _, ok := parseInterval(*holiday.OpensAt, *holiday.ClosesAt); !ok {
+			loggerVar.Error(schedule.ErrInvalidWorkingHours.Error())
+			return models.PvzHoliday{}, schedule.ErrInvalidWorkingHours
+		}
+	}
+
+	if err := uc.repo.UpsertHoliday(ctx, pvzID, holiday); err != nil {
+		loggerVar.Error(err.Error())
+		return models.PvzHoliday{}, err
+	}
+
+	loggerVar.Info("Success")
+	return holiday, nil
+}
+
+func (uc *ScheduleUsecase) DeleteHoliday(ctx context.Context, pvzID uuid.UUID, date string) error {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	if _, err := time.Parse(time.DateOnly, date); err != nil {
+		loggerVar.Error(schedule.ErrInvalidDate.Error())
+		return schedule.ErrInvalidDate
+	}
+
+	if err := uc.repo.DeleteHoliday(ctx, pvzID, date); err != nil {
+		loggerVar.Error(err.Error())
+		return err
+	}
+
+	loggerVar.Info("Success")
+	return nil
+}
+
+func (uc *ScheduleUsecase) CheckOpen(ctx context.Context, pvzID uuid.UUID, at time.Time) error {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	sch, err := uc.repo.SelectSchedule(ctx, pvzID)
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return err
+	}
+
+	if sch.OverrideUntil != nil && at.Before(*sch.OverrideUntil) {
+		loggerVar.Info("working hours overridden by moderator")
+		return nil
+	}
+	// Пока график не задан, ПВЗ считается круглосуточным
+	if len(sch.WeeklyHours) == 0 {
+		loggerVar.Info("Success")
+		return nil
+	}
+
+	loc, err := time.LoadLocation(sch.Timezone)
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return err
+	}
+	local := at.In(loc)
+	day := time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, time.UTC)
+
+	holidays, err := uc.repo.SelectHolidays(ctx, models.HolidayFilter{PvzId: pvzID, From: &day, To: &day})
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return err
+	}
+
+	if !isOpen(sch.WeeklyHours, holidays, local) {
+		loggerVar.Error(schedule.ErrOutsideWorkingHours.Error())
+		return schedule.ErrOutsideWorkingHours
+	}
+
+	loggerVar.Info("Success")
+	return nil
+}
+
+// isOpen проверяет местное время по графику; исключение на эту дату важнее недельного графика
+func isOpen(weekly []models.WorkingHours, holidays []models.PvzHoliday, local time.Time) bool {
+	var opensAt, closesAt string
+	if len(holidays) > 0 {
+		if holidays[0].OpensAt == nil {
+			return false
+		}
+		opensAt, closesAt = *holidays[0].OpensAt, *holidays[0].ClosesAt
+	} else {
+		// time.Weekday считает воскресенье нулевым днём, в графике оно седьмое
+		weekday := int(local.Weekday())
+		if weekday == 0 {
+			weekday = 7
+		}
+		i := slices.IndexFunc(weekly, func(hours models.WorkingHours) bool {
+			return hours.Weekday == weekday
+		})
+		if i < 0 {
+			return false
+		}
+		opensAt, closesAt = weekly[i].OpensAt, weekly[i].ClosesAt
+	}
+
+	opens, closes, ok := parseInterval(opensAt, closesAt)
+	if !ok {
+		return false
+	}
+	minute := local.Hour()*60 + local.Minute()
+	return minute >= opens && minute < closes
+}
+
+// parseInterval переводит часы работы в минуты от полуночи
+func parseInterval(opensAt, closesAt string) (int, int, bool) {
+	opens, ok := parseClock(opensAt)
+	if !ok {
+		return 0, 0, false
+	}
+	closes, ok := parseClock(closesAt)
+	if !ok || opens >= closes {
+		return 0, 0, false
+	}
+	return opens, closes, true
+}
+
+// parseClock разбирает время вида 15:04; 24:00 допускается как конец дня
+func parseClock(value string) (int, bool) {
+	hoursStr, minutesStr, found := strings.Cut(value, ":")
+	if !found || len(hoursStr) != 2 || len(minutesStr) != 2 {
+		return 0, false
+	}
+	hours, err := strconv.Atoi(hoursStr)
+	if err != nil {
+		return 0, false
+	}
+	minutes, err := strconv.Atoi(minutesStr)
+	if err != nil || minutes < 0 || minutes > 59 {
+		return 0, false
+	}
+	total := hours*60 + minutes
+	if hours < 0 || total > minutesPerDay {
+		return 0, false
+	}
+	return total, true
+}
@@ -0,0 +1,163 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/K1tten2005/avito_pvz/internal/models"
+	"github.com/K1tten2005/avito_pvz/internal/pkg/schedule"
+	"github.com/K1tten2005/avito_pvz/internal/pkg/schedule/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/satori/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScheduleUsecase_CheckOpen(t *testing.T) {
+	pvzID := uuid.NewV4()
+	weekdays := []models.WorkingHours{
+		{Weekday: 1, OpensAt: "09:00", ClosesAt: "21:00"},
+		{Weekday: 2, OpensAt: "09:00", ClosesAt: "21:00"},
+		{Weekday: 3, OpensAt: "09:00", ClosesAt: "21:00"},
+		{Weekday: 4, OpensAt: "09:00", ClosesAt: "21:00"},
+		{Weekday: 5, OpensAt: "09:00", ClosesAt: "21:00"},
+		{Weekday: 7, OpensAt: "10:00", ClosesAt: "24:00"},
+	}
+	shortDay := func(opensAt, closesAt string) []models.PvzHoliday {
+		return []models.PvzHoliday{{Date: "2025-03-10", OpensAt: &opensAt, ClosesAt: &closesAt}}
+	}
+	override := time.Date(2025, 3, 10, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		schedule    models.PvzSchedule
+		holidays    []models.PvzHoliday
+		at          time.Time
+		expectedErr error
+	}{
+		{
+			name:     "no schedule means always open",
+			schedule: models.PvzSchedule{Timezone: "Europe/Moscow", WeeklyHours: []models.WorkingHours{}},
+			at:       time.Date(2025, 3, 10, 1, 0, 0, 0, time.UTC),
+		},
+		{
+			// 06:30 UTC — это 09:30 в Москве
+			name:     "open in pvz timezone",
+			schedule: models.PvzSchedule{Timezone: "Europe/Moscow", WeeklyHours: weekdays},
+			at:       time.Date(2025, 3, 10, 6, 30, 0, 0, time.UTC),
+		},
+		{
+			name:        "before opening in pvz timezone",
+			schedule:    models.PvzSchedule{Timezone: "Asia/Vladivostok", WeeklyHours: weekdays},
+			at:          time.Date(2025, 3, 9, 22, 30, 0, 0, time.UTC),
+			expectedErr: schedule.ErrOutsideWorkingHours,
+		},
+		{
+			name:        "closing time is exclusive",
+			schedule:    models.PvzSchedule{Timezone: "UTC", WeeklyHours: weekdays},
+			at:          time.Date(2025, 3, 10, 21, 0, 0, 0, time.UTC),
+			expectedErr: schedule.ErrOutsideWorkingHours,
+		},
+		{
+			name:        "day off",
+			schedule:    models.PvzSchedule{Timezone: "UTC", WeeklyHours: weekdays},
+			at:          time.Date(2025, 3, 8, 12, 0, 0, 0, time.UTC),
+			expectedErr: schedule.ErrOutsideWorkingHours,
+		},
+		{
+			name:     "sunday until midnight",
+			schedule: models.PvzSchedule{Timezone: "UTC", WeeklyHours: weekdays},
+			at:       time.Date(2025, 3, 9, 23, 59, 0, 0, time.UTC),
+		},
+		{
+			name:        "holiday closed all day",
+			schedule:    models.PvzSchedule{Timezone: "UTC", WeeklyHours: weekdays},
+			holidays:    []models.PvzHoliday{{Date: "2025-03-10"}},
+			at:          time.Date(2025, 3, 10, 12, 0, 0, 0, time.UTC),
+			expectedErr: schedule.ErrOutsideWorkingHours,
+		},
+		{
+			name:        "holiday with short hours",
+			schedule:    models.PvzSchedule{Timezone: "UTC", WeeklyHours: weekdays},
+			holidays:    shortDay("10:00", "15:00"),
+			at:          time.Date(2025, 3, 10, 16, 0, 0, 0, time.UTC),
+			expectedErr: schedule.ErrOutsideWorkingHours,
+		},
+		{
+			name:     "moderator override",
+			schedule: models.PvzSchedule{Timezone: "UTC", WeeklyHours: weekdays, OverrideUntil: &override},
+			at:       time.Date(2025, 3, 10, 3, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repo := mocks.NewMockScheduleRepo(ctrl)
+			repo.EXPECT().SelectSchedule(gomock.Any(), pvzID).Return(tt.schedule, nil)
+			repo.EXPECT().SelectHolidays(gomock.Any(), gomock.Any()).Return(tt.holidays, nil).AnyTimes()
+
+			err := CreateScheduleUsecase(repo).CheckOpen(context.Background(), pvzID, tt.at)
+			assert.ErrorIs(t, err, tt.expectedErr)
+		})
+	}
+}
+
+func TestScheduleUsecase_UpdateSchedule_Invalid(t *testing.T) {
+	tests := []struct {
+		name        string
+		req         models.UpdateScheduleReq
+		expectedErr error
+	}{
+		{
+			name:        "unknown timezone",
+			req:         models.UpdateScheduleReq{Timezone: "Europe/Atlantis"},
+			expectedErr: schedule.ErrInvalidTimezone,
+		},
+		{
+			name: "duplicate weekday",
+			req: models.UpdateScheduleReq{Timezone: "UTC", WeeklyHours: []models.WorkingHours{
+				{Weekday: 1, OpensAt: "09:00", ClosesAt: "12:00"},
+				{Weekday: 1, OpensAt: "13:00", ClosesAt: "18:00"},
+			}},
+			expectedErr: schedule.ErrInvalidWorkingHours,
+		},
+		{
+			name: "closes before opening",
+			req: models.UpdateScheduleReq{Timezone: "UTC", WeeklyHours: []models.WorkingHours{
+				{Weekday: 2, OpensAt: "21:00", ClosesAt: "09:00"},
+			}},
+			expectedErr: schedule.ErrInvalidWorkingHours,
+		},
+		{
+			name: "malformed time",
+			req: models.UpdateScheduleReq{Timezone: "UTC", WeeklyHours: []models.WorkingHours{
+				{Weekday: 3, OpensAt: "9:00", ClosesAt: "24:30"},
+			}},
+			expectedErr: schedule.ErrInvalidWorkingHours,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			_, err := CreateScheduleUsecase(mocks.NewMockScheduleRepo(ctrl)).UpdateSchedule(context.Background(), uuid.NewV4(), tt.req)
+			assert.ErrorIs(t, err, tt.expectedErr)
+		})
+	}
+}
+
+func TestScheduleUsecase_SetOverride_InPast(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uc := CreateScheduleUsecase(mocks.NewMockScheduleRepo(ctrl))
+	past := time.Now().Add(-time.Hour)
+
+	_, err := uc.SetOverride(context.Background(), uuid.NewV4(), &past)
+	assert.ErrorIs(t, err, schedule.ErrInvalidOverride)
+}
@@ -0,0 +1,96 @@
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/K1tten2005/avito_pvz/internal/models"
+	"github.com/K1tten2005/avito_pvz/internal/pkg/stock"
+	"github.com/K1tten2005/avito_pvz/internal/pkg/utils/logger"
+	"github.com/K1tten2005/avito_pvz/internal/pkg/utils/pagination"
+	"github.com/K1tten2005/avito_pvz/internal/pkg/utils/send_err"
+	"github.com/gorilla/mux"
+	"github.com/satori/uuid"
+)
+
+type StockHandler struct {
+	uc stock.StockUsecase
+}
+
+func CreateStockHandler(uc stock.StockUsecase) *StockHandler {
+	return &StockHandler{uc: uc}
+}
+
+func (h *StockHandler) GetStock(w http.ResponseWriter, r *http.Request) {
+	loggerVar := logger.GetLoggerFromContext(r.Context()).With(slog.String("func", logger.GetFuncName()))
+
+	pvzID, err := uuid.FromString(mux.Vars(r)["pvzId"])
+	if err != nil {
+		logger.LogHandlerError(loggerVar, err, http.StatusBadRequest)
+		send_err.SendError(w, "wrong UUID format for pvzId query parameter", http.StatusBadRequest)
+		return
+	}
+
+	query := r.URL.Query()
+	filter := models.StockFilter{
+		PvzId:  pvzID,
+		Type:   query.Get("type"),
+		Status: query.Get("status"),
+	}
+
+	if limitStr := query.Get("limit"); limitStr != "" {
+		limit, err := strconv.Atoi(limitStr)
+		if err != nil || limit <= 0 {
+			logger.LogHandlerError(loggerVar, fmt.Errorf("invalid limit: %s", limitStr), http.StatusBadRequest)
+			send_err.SendError(w, "invalid limit", http.StatusBadRequest)
+			return
+		}
+		filter.Limit = limit
+	}
+
+	if cursorStr := query.Get("cursor"); cursorStr != "" {
+		cursor, err := pagination.DecodeStockCursor(cursorStr)
+		if err != nil {
+			logger.LogHandlerError(loggerVar, err, http.StatusBadRequest)
+			send_err.SendError(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		filter.Cursor = &cursor
+	}
+
+	result, err := h.uc.GetStock(r.Context(), filter)
+	if err != nil {
+		statusCode := errStatus(err)
+		logger.LogHandlerError(loggerVar, fmt.Errorf("error on a level below (usecase): %w", err), statusCode)
+		send_err.SendError(w, err.Error(), statusCode)
+		return
+	}
+
+	sendJSON(w, loggerVar, result, http.StatusOK)
+}
+
+func sendJSON(w http.ResponseWriter, loggerVar *slog.Logger, body any, statusCode int) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	if err := json.NewEncoder(w).Encode(body); err != nil {
+		logger.LogHandlerError(loggerVar, fmt.Errorf("error while forming JSON: %w", err), http.StatusInternalServerError)
+		send_err.SendError(w, "error while forming JSON", http.StatusInternalServerError)
+		return
+	}
+	logger.LogHandlerInfo(loggerVar, "Successful", statusCode)
+}
+
+func errStatus(err error) int {
+	switch {
+	case errors.Is(err, stock.ErrPvzNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, stock.ErrInvalidStatus):
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
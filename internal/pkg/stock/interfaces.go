@@ -0,0 +1,27 @@
+package stock
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/K1tten2005/avito_pvz/internal/models"
+	"github.com/satori/uuid"
+)
+
+var (
+	ErrPvzNotFound   = errors.New("pvz not found")
+	ErrInvalidStatus = errors.New("invalid stock status")
+)
+
+type StockRepo interface {
+	PvzExists(ctx context.Context, pvzID uuid.UUID) (bool, error)
+	// SelectStockCounts группирует товары на полке по типу, статусу и возрасту на момент now.
+	// Возраст раскладывается по границам ageBounds в секундах, как width_bucket в Postgres.
+	SelectStockCounts(ctx context.Context, pvzID uuid.UUID, now time.Time, ageBounds []float64) ([]models.StockCount, error)
+	SelectStockItems(ctx context.Context, filter models.StockFilter) ([]models.Product, error)
+}
+
+type StockUsecase interface {
+	GetStock(ctx context.Context, filter models.StockFilter) (models.Stock, error)
+}
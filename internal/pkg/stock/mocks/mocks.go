@@ -0,0 +1,121 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/pkg/stock/interfaces.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	models "github.com/K1tten2005/avito_pvz/internal/models"
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/satori/uuid"
+)
+
+// MockStockRepo is a mock of StockRepo interface.
+type MockStockRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockStockRepoMockRecorder
+}
+
+// MockStockRepoMockRecorder is the mock recorder for MockStockRepo.
+type MockStockRepoMockRecorder struct {
+	mock *MockStockRepo
+}
+
+// NewMockStockRepo creates a new mock instance.
+func NewMockStockRepo(ctrl *gomock.Controller) *MockStockRepo {
+	mock := &MockStockRepo{ctrl: ctrl}
+	mock.recorder = &MockStockRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStockRepo) EXPECT() *MockStockRepoMockRecorder {
+	return m.recorder
+}
+
+// PvzExists mocks base method.
+func (m *MockStockRepo) PvzExists(ctx context.Context, pvzID uuid.UUID) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PvzExists", ctx, pvzID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PvzExists indicates an expected call of PvzExists.
+func (mr *MockStockRepoMockRecorder) PvzExists(ctx, pvzID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PvzExists", reflect.TypeOf((*MockStockRepo)(nil).PvzExists), ctx, pvzID)
+}
+
+// SelectStockCounts mocks base method.
+func (m *MockStockRepo) SelectStockCounts(ctx context.Context, pvzID uuid.UUID, now time.Time, ageBounds []float64) ([]models.StockCount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectStockCounts", ctx, pvzID, now, ageBounds)
+	ret0, _ := ret[0].([]models.StockCount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectStockCounts indicates an expected call of SelectStockCounts.
+func (mr *MockStockRepoMockRecorder) SelectStockCounts(ctx, pvzID, now, ageBounds interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectStockCounts", reflect.TypeOf((*MockStockRepo)(nil).SelectStockCounts), ctx, pvzID, now, ageBounds)
+}
+
+// SelectStockItems mocks base method.
+func (m *MockStockRepo) SelectStockItems(ctx context.Context, filter models.StockFilter) ([]models.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectStockItems", ctx, filter)
+	ret0, _ := ret[0].([]models.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectStockItems indicates an expected call of SelectStockItems.
+func (mr *MockStockRepoMockRecorder) SelectStockItems(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectStockItems", reflect.TypeOf((*MockStockRepo)(nil).SelectStockItems), ctx, filter)
+}
+
+// MockStockUsecase is a mock of StockUsecase interface.
+type MockStockUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockStockUsecaseMockRecorder
+}
+
+// MockStockUsecaseMockRecorder is the mock recorder for MockStockUsecase.
+type MockStockUsecaseMockRecorder struct {
+	mock *MockStockUsecase
+}
+
+// NewMockStockUsecase creates a new mock instance.
+func NewMockStockUsecase(ctrl *gomock.Controller) *MockStockUsecase {
+	mock := &MockStockUsecase{ctrl: ctrl}
+	mock.recorder = &MockStockUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStockUsecase) EXPECT() *MockStockUsecaseMockRecorder {
+	return m.recorder
+}
+
+// GetStock mocks base method.
+func (m *MockStockUsecase) GetStock(ctx context.Context, filter models.StockFilter) (models.Stock, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStock", ctx, filter)
+	ret0, _ := ret[0].(models.Stock)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStock indicates an expected call of GetStock.
+func (mr *MockStockUsecaseMockRecorder) GetStock(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStock", reflect.TypeOf((*MockStockUsecase)(nil).GetStock), ctx, filter)
+}
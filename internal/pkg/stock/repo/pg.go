@@ -0,0 +1,140 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	_ "embed"
+	"log/slog"
+	"time"
+
+	"github.com/K1tten2005/avito_pvz/internal/models"
+	"github.com/K1tten2005/avito_pvz/internal/pkg/utils/logger"
+	"github.com/jackc/pgtype/pgxtype"
+	"github.com/satori/uuid"
+)
+
+//go:embed sql/selectPvzExists.sql
+var selectPvzExists string
+
+//go:embed sql/selectStockCounts.sql
+var selectStockCounts string
+
+//go:embed sql/selectStockItems.sql
+var selectStockItems string
+
+type StockRepo struct {
+	db pgxtype.Querier
+}
+
+func CreateStockRepo(db pgxtype.Querier) *StockRepo {
+	return &StockRepo{
+		db: db,
+	}
+}
+
+func (repo *StockRepo) PvzExists(ctx context.Context, pvzID uuid.UUID) (bool, error) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	var exists bool
+	if err := repo.db.QueryRow(ctx, selectPvzExists, pvzID).Scan(&exists); err != nil {
+		loggerVar.Error(err.Error())
+		return false, err
+	}
+
+	loggerVar.Info("Successful")
+	return exists, nil
+}
+
+func (repo *StockRepo) SelectStockCounts(ctx context.Context, pvzID uuid.UUID, now time.Time, ageBounds []float64) ([]models.StockCount, error) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	rows, err := repo.db.Query(ctx, selectStockCounts, pvzID, now, ageBounds)
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return nil, err
+	}
+	defer rows.Close()
+
+	result := []models.StockCount{}
+	for rows.Next() {
+		var count models.StockCount
+		if err := rows.Scan(&count.Type, &count.Status, &count.AgeBucket, &count.Count); err != nil {
+			loggerVar.Error(err.Error())
+			return nil, err
+		}
+		result = append(result, count)
+	}
+
+	if err := rows.Err(); err != nil {
+		loggerVar.Error(err.Error())
+		return nil, err
+	}
+
+	loggerVar.Info("Successful")
+	return result, nil
+}
+
+func (repo *StockRepo) SelectStockItems(ctx context.Context, filter models.StockFilter) ([]models.Product, error) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	var (
+		cursorDate *time.Time
+		cursorID   *uuid.UUID
+	)
+	if filter.Cursor != nil {
+		cursorDate = &filter.Cursor.DateTime
+		cursorID = &filter.Cursor.Id
+	}
+
+	rows, err := repo.db.Query(ctx, selectStockItems,
+		filter.PvzId, filter.Type, filter.Status, cursorDate, cursorID, filter.Limit,
+	)
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return nil, err
+	}
+	defer rows.Close()
+
+	result := []models.Product{}
+	for rows.Next() {
+		var (
+			product                                models.Product
+			barcode, barcodeFormat, sku, number    sql.NullString
+			weightGrams, lengthMm, widthMm, height sql.NullInt32
+		)
+		err := rows.Scan(
+			&product.Id, &product.DateTime, &product.Type, &product.ReceptionId,
+			&barcode, &barcodeFormat, &sku, &number,
+			&weightGrams, &lengthMm, &widthMm, &height,
+			&product.Status,
+		)
+		if err != nil {
+			loggerVar.Error(err.Error())
+			return nil, err
+		}
+		product.Barcode = barcode.String
+		product.BarcodeFormat = barcodeFormat.String
+		product.Sku = sku.String
+		product.OrderNumber = number.String
+		if weightGrams.Valid {
+			weight := int(weightGrams.Int32)
+			product.WeightGrams = &weight
+		}
+		if lengthMm.Valid && widthMm.Valid && height.Valid {
+			product.Dimensions = &models.Dimensions{
+				LengthMm: int(lengthMm.Int32),
+				WidthMm:  int(widthMm.Int32),
+				HeightMm: int(height.Int32),
+			}
+		}
+		result = append(result, product)
+	}
+
+	if err := rows.Err(); err != nil {
+		loggerVar.Error(err.Error())
+		return nil, err
+	}
+
+	loggerVar.Info("Successful")
+	return result, nil
+}
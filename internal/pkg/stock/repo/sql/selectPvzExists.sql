@@ -0,0 +1 @@
+SELECT EXISTS (SELECT 1 FROM pvz WHERE id = $1)
@@ -0,0 +1,12 @@
+SELECT
+    product.category,
+    product.status,
+    width_bucket(extract(epoch FROM $2::timestamptz - product.reception_time)::float8, $3::float8[]) AS age_bucket,
+    count(*)
+FROM product
+JOIN reception ON reception.id = product.reception_id
+WHERE reception.pvz_id = $1
+    AND reception.status = 'close'
+    AND product.status IN ('received', 'ready_for_pickup')
+    AND product.deleted_at IS NULL
+GROUP BY 1, 2, 3
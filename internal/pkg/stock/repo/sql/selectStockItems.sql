@@ -0,0 +1,16 @@
+SELECT
+    product.id, product.reception_time, product.category, product.reception_id,
+    product.barcode, product.barcode_format, product.sku, product.order_number,
+    product.weight_grams, product.length_mm, product.width_mm, product.height_mm,
+    product.status
+FROM product
+JOIN reception ON reception.id = product.reception_id
+WHERE reception.pvz_id = $1
+    AND reception.status = 'close'
+    AND product.status IN ('received', 'ready_for_pickup')
+    AND product.deleted_at IS NULL
+    AND ($2::text = '' OR product.category = $2)
+    AND ($3::text = '' OR product.status::text = $3)
+    AND ($4::timestamptz IS NULL OR (product.reception_time, product.id) > ($4::timestamptz, $5::uuid))
+ORDER BY product.reception_time, product.id
+LIMIT $6
@@ -0,0 +1,130 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/K1tten2005/avito_pvz/internal/models"
+	"github.com/K1tten2005/avito_pvz/internal/pkg/stock"
+	"github.com/K1tten2005/avito_pvz/internal/pkg/utils/logger"
+	"github.com/K1tten2005/avito_pvz/internal/pkg/utils/pagination"
+)
+
+const (
+	defaultStockLimit = 20
+	maxStockLimit     = 100
+)
+
+// ageBucketDays — границы корзин возраста товара на полке в днях; последняя корзина открыта сверху
+var ageBucketDays = []int{1, 3, 7, 14}
+
+type StockUsecase struct {
+	repo stock.StockRepo
+	now  func() time.Time
+}
+
+func CreateStockUsecase(repo stock.StockRepo) *StockUsecase {
+	return &StockUsecase{repo: repo, now: time.Now}
+}
+
+func (uc *StockUsecase) GetStock(ctx context.Context, filter models.StockFilter) (models.Stock, error) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	switch filter.Status {
+	case "", models.ProductStatusReceived, models.ProductStatusReadyForPickup:
+	default:
+		loggerVar.Error(stock.ErrInvalidStatus.Error())
+		return models.Stock{}, stock.ErrInvalidStatus
+	}
+	if filter.Limit <= 0 {
+		filter.Limit = defaultStockLimit
+	}
+	filter.Limit = min(filter.Limit, maxStockLimit)
+
+	exists, err := uc.repo.PvzExists(ctx, filter.PvzId)
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return models.Stock{}, err
+	}
+	if !exists {
+		loggerVar.Error(stock.ErrPvzNotFound.Error())
+		return models.Stock{}, stock.ErrPvzNotFound
+	}
+
+	bounds := make([]float64, 0, len(ageBucketDays))
+	for _, days := range ageBucketDays {
+		bounds = append(bounds, (time.Duration(days) * 24 * time.Hour).Seconds())
+	}
+	counts, err := uc.repo.SelectStockCounts(ctx, filter.PvzId, uc.now(), bounds)
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return models.Stock{}, err
+	}
+
+	// Ищем на одну запись больше, чтобы понять, есть ли следующая страница
+	pageFilter := filter
+	pageFilter.Limit++
+	items, err := uc.repo.SelectStockItems(ctx, pageFilter)
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return models.Stock{}, err
+	}
+
+	result := aggregate(counts)
+	result.PvzId = filter.PvzId
+	result.Items = items
+	if len(items) > filter.Limit {
+		result.Items = items[:filter.Limit]
+		last := result.Items[filter.Limit-1]
+		result.NextCursor = pagination.EncodeStockCursor(models.StockCursor{DateTime: last.DateTime, Id: last.Id})
+	}
+
+	loggerVar.Info("Success")
+	return result, nil
+}
+
+// aggregate сворачивает счётчики по (тип, статус, возраст) в итоги остатков.
+// Номера корзин приходят от width_bucket: 0 — моложе первой границы, len(ageBucketDays) — старше последней.
+func aggregate(counts []models.StockCount) models.Stock {
+	result := models.Stock{
+		ByType: []models.ProductTypeTotal{},
+		ByAge:  make([]models.StockAgeBucket, 0, len(ageBucketDays)+1),
+	}
+
+	from := 0
+	for i := range len(ageBucketDays) + 1 {
+		bucket := models.StockAgeBucket{FromDays: from}
+		if i < len(ageBucketDays) {
+			to := ageBucketDays[i]
+			bucket.ToDays = &to
+			bucket.Label = fmt.Sprintf("%d-%dd", from, to)
+			from = to
+		} else {
+			bucket.Label = fmt.Sprintf("%dd+", from)
+		}
+		result.ByAge = append(result.ByAge, bucket)
+	}
+
+	byType := map[string]int{}
+	for _, count := range counts {
+		result.Total += count.Count
+		if count.Status == models.ProductStatusReadyForPickup {
+			result.ReadyForPickup += count.Count
+		}
+		byType[count.Type] += count.Count
+		bucket := min(max(count.AgeBucket, 0), len(ageBucketDays))
+		result.ByAge[bucket].Count += count.Count
+	}
+
+	for productType, count := range byType {
+		result.ByType = append(result.ByType, models.ProductTypeTotal{Type: productType, Count: count})
+	}
+	slices.SortFunc(result.ByType, func(a, b models.ProductTypeTotal) int {
+		return strings.Compare(a.Type, b.Type)
+	})
+	return result
+}
@@ -0,0 +1,80 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/K1tten2005/avito_pvz/internal/models"
+	"github.com/K1tten2005/avito_pvz/internal/pkg/stock"
+	"github.com/K1tten2005/avito_pvz/internal/pkg/stock/mocks"
+	"github.com/K1tten2005/avito_pvz/internal/pkg/utils/pagination"
+	"github.com/golang/mock/gomock"
+	"github.com/satori/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStockUsecase_GetStock(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	now := time.Date(2025, 3, 10, 12, 0, 0, 0, time.UTC)
+	pvzID := uuid.NewV4()
+	items := []models.Product{
+		{Id: uuid.NewV4(), DateTime: now.Add(-72 * time.Hour), Type: "обувь", Status: models.ProductStatusReceived},
+		{Id: uuid.NewV4(), DateTime: now.Add(-48 * time.Hour), Type: "одежда", Status: models.ProductStatusReadyForPickup},
+		{Id: uuid.NewV4(), DateTime: now.Add(-time.Hour), Type: "одежда", Status: models.ProductStatusReceived},
+	}
+
+	repo := mocks.NewMockStockRepo(ctrl)
+	repo.EXPECT().PvzExists(gomock.Any(), pvzID).Return(true, nil)
+	repo.EXPECT().SelectStockCounts(gomock.Any(), pvzID, now, []float64{86400, 259200, 604800, 1209600}).Return([]models.StockCount{
+		{Type: "одежда", Status: models.ProductStatusReceived, AgeBucket: 0, Count: 1},
+		{Type: "одежда", Status: models.ProductStatusReadyForPickup, AgeBucket: 1, Count: 1},
+		{Type: "обувь", Status: models.ProductStatusReceived, AgeBucket: 2, Count: 1},
+		{Type: "обувь", Status: models.ProductStatusReceived, AgeBucket: 4, Count: 2},
+	}, nil)
+	repo.EXPECT().SelectStockItems(gomock.Any(), models.StockFilter{PvzId: pvzID, Limit: 3}).Return(items, nil)
+
+	uc := CreateStockUsecase(repo)
+	uc.now = func() time.Time { return now }
+
+	result, err := uc.GetStock(context.Background(), models.StockFilter{PvzId: pvzID, Limit: 2})
+	require.NoError(t, err)
+	assert.Equal(t, 5, result.Total)
+	assert.Equal(t, 1, result.ReadyForPickup)
+	assert.Equal(t, []models.ProductTypeTotal{{Type: "обувь", Count: 3}, {Type: "одежда", Count: 2}}, result.ByType)
+
+	require.Len(t, result.ByAge, 5)
+	assert.Equal(t, "0-1d", result.ByAge[0].Label)
+	assert.Equal(t, "14d+", result.ByAge[4].Label)
+	assert.Nil(t, result.ByAge[4].ToDays)
+	counts := make([]int, 0, len(result.ByAge))
+	for _, bucket := range result.ByAge {
+		counts = append(counts, bucket.Count)
+	}
+	assert.Equal(t, []int{1, 1, 1, 0, 2}, counts)
+
+	require.Len(t, result.Items, 2)
+	cursor, err := pagination.DecodeStockCursor(result.NextCursor)
+	require.NoError(t, err)
+	assert.Equal(t, items[1].Id, cursor.Id)
+	assert.True(t, items[1].DateTime.Equal(cursor.DateTime))
+}
+
+func TestStockUsecase_GetStock_Errors(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mocks.NewMockStockRepo(ctrl)
+	uc := CreateStockUsecase(repo)
+
+	_, err := uc.GetStock(context.Background(), models.StockFilter{PvzId: uuid.NewV4(), Status: models.ProductStatusIssued})
+	assert.ErrorIs(t, err, stock.ErrInvalidStatus)
+
+	pvzID := uuid.NewV4()
+	repo.EXPECT().PvzExists(gomock.Any(), pvzID).Return(false, nil)
+	_, err = uc.GetStock(context.Background(), models.StockFilter{PvzId: pvzID})
+	assert.ErrorIs(t, err, stock.ErrPvzNotFound)
+}
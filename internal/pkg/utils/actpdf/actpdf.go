@@ -0,0 +1,162 @@
+// Package actpdf печатает акт приёмки товаров. Шрифты Go встроены в бинарник
+// и содержат кириллицу, так что генерация не зависит от шрифтов системы.
+package actpdf
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/K1tten2005/avito_pvz/internal/models"
+	"github.com/go-pdf/fpdf"
+	"golang.org/x/image/font/gofont/gobold"
+	"golang.org/x/image/font/gofont/goregular"
+)
+
+const (
+	fontFamily = "Go"
+	timeLayout = "02.01.2006 15:04:05 UTC"
+
+	rowHeight    = 6
+	bottomMargin = 20
+)
+
+var productColumns = []struct {
+	title string
+	width float64
+}{
+	{"№", 10},
+	{"ID товара", 64},
+	{"Тип", 36},
+	{"Штрихкод", 40},
+	{"Время приёмки", 40},
+}
+
+// Render пишет акт в w; акт печатается только для закрытой приёмки, это проверяет вызывающий
+func Render(w io.Writer, act models.ReceptionAct) error {
+	pdf := fpdf.New("P", "mm", "A4", "")
+	pdf.AddUTF8FontFromBytes(fontFamily, "", goregular.TTF)
+	pdf.AddUTF8FontFromBytes(fontFamily, "B", gobold.TTF)
+	pdf.SetTitle("Акт приёмки "+act.Reception.Id.String(), true)
+	pdf.SetAutoPageBreak(true, bottomMargin)
+	pdf.AliasNbPages("")
+	pdf.SetFooterFunc(func() {
+		pdf.SetY(-15)
+		pdf.SetFont(fontFamily, "", 8)
+		pdf.CellFormat(0, 10, fmt.Sprintf("Страница %d из {nb}", pdf.PageNo()), "", 0, "C", false, 0, "")
+	})
+	pdf.AddPage()
+
+	reception := act.Reception
+	pdf.SetFont(fontFamily, "B", 14)
+	pdf.CellFormat(0, 10, "Акт приёмки товаров", "", 1, "C", false, 0, "")
+	pdf.SetFont(fontFamily, "", 10)
+	pdf.CellFormat(0, 6, "Приёмка № "+reception.Id.String(), "", 1, "C", false, 0, "")
+	pdf.Ln(4)
+
+	for _, detail := range details(act) {
+		pdf.SetFont(fontFamily, "B", 10)
+		pdf.CellFormat(45, rowHeight, detail[0]+":", "", 0, "L", false, 0, "")
+		pdf.SetFont(fontFamily, "", 10)
+		pdf.CellFormat(0, rowHeight, detail[1], "", 1, "L", false, 0, "")
+	}
+	pdf.Ln(4)
+
+	productsHeader(pdf)
+	for i, product := range reception.Products {
+		if pageFull(pdf) {
+			pdf.AddPage()
+			productsHeader(pdf)
+		}
+		cells := []string{
+			strconv.Itoa(i + 1),
+			product.Id.String(),
+			product.Type,
+			product.Barcode,
+			formatTime(&product.DateTime),
+		}
+		for j, cell := range cells {
+			pdf.CellFormat(productColumns[j].width, rowHeight, cell, "1", 0, "L", false, 0, "")
+		}
+		pdf.Ln(-1)
+	}
+	pdf.Ln(6)
+
+	if pageFull(pdf) {
+		pdf.AddPage()
+	}
+	pdf.SetFont(fontFamily, "B", 10)
+	pdf.CellFormat(0, rowHeight, "Итого по типам", "", 1, "L", false, 0, "")
+	pdf.SetFont(fontFamily, "", 10)
+	for _, total := range act.Totals {
+		pdf.CellFormat(80, rowHeight, total.Type, "1", 0, "L", false, 0, "")
+		pdf.CellFormat(30, rowHeight, strconv.Itoa(total.Count), "1", 1, "R", false, 0, "")
+	}
+	pdf.SetFont(fontFamily, "B", 10)
+	pdf.CellFormat(80, rowHeight, "Всего", "1", 0, "L", false, 0, "")
+	pdf.CellFormat(30, rowHeight, strconv.Itoa(len(reception.Products)), "1", 1, "R", false, 0, "")
+	pdf.Ln(12)
+
+	signer := closedBy(act)
+	if signer == "" {
+		signer = "____________________"
+	}
+	pdf.SetFont(fontFamily, "", 10)
+	pdf.CellFormat(95, rowHeight, "Сотрудник ПВЗ: "+signer, "", 0, "L", false, 0, "")
+	pdf.CellFormat(95, rowHeight, "Подпись: ____________________", "", 1, "L", false, 0, "")
+
+	return pdf.Output(w)
+}
+
+// details — шапка акта: поле и значение
+func details(act models.ReceptionAct) [][2]string {
+	reception := act.Reception
+	employee := closedBy(act)
+	if employee == "" {
+		employee = "—"
+	}
+	return [][2]string{
+		{"ПВЗ", reception.PvzId.String()},
+		{"Город", act.City},
+		{"Приёмка открыта", formatTime(&reception.DateTime)},
+		{"Приёмка закрыта", formatTime(reception.ClosedAt)},
+		{"Приёмку закрыл", employee},
+		{"Принято товаров", strconv.Itoa(len(reception.Products))},
+	}
+}
+
+// closedBy — сотрудник, закрывший приёмку: email или id, если email неизвестен.
+// Для приёмки, закрытой токеном без пользователя, пусто.
+func closedBy(act models.ReceptionAct) string {
+	switch {
+	case act.ClosedByEmail != "":
+		return act.ClosedByEmail
+	case act.Reception.ClosedBy != nil:
+		return act.Reception.ClosedBy.String()
+	default:
+		return ""
+	}
+}
+
+func productsHeader(pdf *fpdf.Fpdf) {
+	pdf.SetFont(fontFamily, "B", 9)
+	for _, column := range productColumns {
+		pdf.CellFormat(column.width, rowHeight, column.title, "1", 0, "C", false, 0, "")
+	}
+	pdf.Ln(-1)
+	pdf.SetFont(fontFamily, "", 8)
+}
+
+// pageFull — следующая строка не поместится на страницу
+func pageFull(pdf *fpdf.Fpdf) bool {
+	_, pageHeight := pdf.GetPageSize()
+	return pdf.GetY()+rowHeight > pageHeight-bottomMargin
+}
+
+func formatTime(t *time.Time) string {
+	if t == nil {
+		return "—"
+	}
+	return t.UTC().Format(timeLayout)
+}
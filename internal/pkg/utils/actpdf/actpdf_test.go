@@ -0,0 +1,65 @@
+package actpdf
+
+import (
+	"bytes"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/K1tten2005/avito_pvz/internal/models"
+	"github.com/satori/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRender(t *testing.T) {
+	closedAt := time.Date(2025, 3, 1, 18, 0, 0, 0, time.UTC)
+	reception := models.Reception{
+		Id:       uuid.NewV4(),
+		PvzId:    uuid.NewV4(),
+		DateTime: closedAt.Add(-time.Hour),
+		Status:   models.StatusClose,
+		ClosedAt: &closedAt,
+	}
+	// столько товаров не помещается на одну страницу
+	for i := 0; i < 60; i++ {
+		reception.Products = append(reception.Products, models.Product{
+			Id:       uuid.NewV4(),
+			Type:     "обувь",
+			DateTime: closedAt.Add(-time.Duration(i) * time.Minute),
+		})
+	}
+	act := models.ReceptionAct{
+		Reception:     reception,
+		City:          "Москва",
+		ClosedByEmail: "employee@mail.ru",
+		Totals:        []models.ProductTypeTotal{{Type: "обувь", Count: 60}},
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, Render(&buf, act))
+
+	assert.True(t, bytes.HasPrefix(buf.Bytes(), []byte("%PDF-")))
+	pages := regexp.MustCompile(`/Type /Page\b`).FindAll(buf.Bytes(), -1)
+	assert.Greater(t, len(pages), 1)
+}
+
+func TestDetails_ClosedBy(t *testing.T) {
+	userID := uuid.NewV4()
+
+	tests := []struct {
+		name     string
+		act      models.ReceptionAct
+		expected string
+	}{
+		{"employee email", models.ReceptionAct{Reception: models.Reception{ClosedBy: &userID}, ClosedByEmail: "employee@mail.ru"}, "employee@mail.ru"},
+		{"employee without email", models.ReceptionAct{Reception: models.Reception{ClosedBy: &userID}}, userID.String()},
+		{"token without user id", models.ReceptionAct{}, "—"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Contains(t, details(tt.act), [2]string{"Приёмку закрыл", tt.expected})
+		})
+	}
+}
@@ -9,21 +9,28 @@ import (
 	"github.com/K1tten2005/avito_pvz/internal/models"
 	"github.com/K1tten2005/avito_pvz/internal/pkg/auth"
 	"github.com/golang-jwt/jwt"
+	"github.com/satori/uuid"
 	"github.com/stretchr/testify/require"
 )
 
+// GenerateToken выпускает JWT пользователя. Пользователь без id (dummyLogin) не хранится в users,
+// поэтому его токен несёт только роль, без sub и email
 func GenerateToken(user models.User) (string, error) {
 	secret := os.Getenv("JWT_SECRET")
 	if secret == "" {
 		return "", auth.ErrGeneratingToken
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+	claims := jwt.MapClaims{
 		"role": user.Role,
 		"exp":  time.Now().Add(24 * time.Hour).Unix(),
-	})
+	}
+	if user.Id != uuid.Nil {
+		claims["sub"] = user.Id.String()
+		claims["email"] = user.Email
+	}
 
-	return token.SignedString([]byte(secret))
+	return jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(secret))
 }
 
 func GetRoleFromJWT(JWTStr string, claims jwt.MapClaims, secret string) (string, bool) {
@@ -44,6 +51,17 @@ func GetRoleFromJWT(JWTStr string, claims jwt.MapClaims, secret string) (string,
 	return role, ok
 }
 
+// GetPrincipalFromClaims собирает пользователя из claims, уже проверенных GetRoleFromJWT.
+// У токенов без sub (dummyLogin, старые токены) UserId остаётся uuid.Nil
+func GetPrincipalFromClaims(claims jwt.MapClaims, role string) models.Principal {
+	p := models.Principal{Role: role}
+	if sub, ok := claims["sub"].(string); ok {
+		p.UserId, _ = uuid.FromString(sub)
+	}
+	p.Email, _ = claims["email"].(string)
+	return p
+}
+
 func GenerateJWTForTest(t *testing.T, role, secret string) string {
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
 		"role": role,
@@ -4,8 +4,11 @@ import (
 	"testing"
 	"time"
 
+	"github.com/K1tten2005/avito_pvz/internal/models"
 	"github.com/golang-jwt/jwt"
+	"github.com/satori/uuid"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 const secret = "test_secret"
@@ -30,6 +33,42 @@ func TestGetRoleFromJWT(t *testing.T) {
 	assert.Equal(t, "employee", role)
 }
 
+func TestGenerateToken(t *testing.T) {
+	t.Setenv("JWT_SECRET", secret)
+	user := models.User{Id: uuid.NewV4(), Email: "employee@mail.ru", Role: models.RoleEmployee}
+
+	tokenStr, err := GenerateToken(user)
+	require.NoError(t, err)
+
+	claims := jwt.MapClaims{}
+	role, ok := GetRoleFromJWT(tokenStr, claims, secret)
+	require.True(t, ok)
+	assert.Equal(t, models.RoleEmployee, role)
+	assert.Equal(t, user.Id.String(), claims["sub"])
+
+	p := GetPrincipalFromClaims(claims, role)
+	assert.Equal(t, models.Principal{UserId: user.Id, Email: user.Email, Role: models.RoleEmployee}, p)
+}
+
+func TestGenerateToken_WithoutUserID(t *testing.T) {
+	t.Setenv("JWT_SECRET", secret)
+
+	tokenStr, err := GenerateToken(models.User{Role: models.RoleEmployee})
+	require.NoError(t, err)
+
+	claims := jwt.MapClaims{}
+	role, ok := GetRoleFromJWT(tokenStr, claims, secret)
+	require.True(t, ok)
+	assert.Equal(t, models.Principal{Role: models.RoleEmployee}, GetPrincipalFromClaims(claims, role))
+}
+
+func TestGetPrincipalFromClaims_NoSub(t *testing.T) {
+	p := GetPrincipalFromClaims(jwt.MapClaims{"role": "moderator"}, "moderator")
+	assert.Equal(t, uuid.Nil, p.UserId)
+	assert.Empty(t, p.Email)
+	assert.Equal(t, "moderator", p.Role)
+}
+
 func TestGenerateJWTForTest(t *testing.T) {
 	role := "employee"
 	secret := "secret"
@@ -0,0 +1,56 @@
+package pagination
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+
+	"github.com/K1tten2005/avito_pvz/internal/models"
+	"github.com/satori/uuid"
+)
+
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+func EncodeCursor(cursor models.PvzCursor) string {
+	data, _ := json.Marshal(cursor)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func DecodeCursor(cursorStr string) (models.PvzCursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(cursorStr)
+	if err != nil {
+		return models.PvzCursor{}, ErrInvalidCursor
+	}
+
+	var cursor models.PvzCursor
+	if err := json.Unmarshal(data, &cursor); err != nil {
+		return models.PvzCursor{}, ErrInvalidCursor
+	}
+	if cursor.Id == uuid.Nil || cursor.RegistrationDate.IsZero() {
+		return models.PvzCursor{}, ErrInvalidCursor
+	}
+
+	return cursor, nil
+}
+
+func EncodeStockCursor(cursor models.StockCursor) string {
+	data, _ := json.Marshal(cursor)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func DecodeStockCursor(cursorStr string) (models.StockCursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(cursorStr)
+	if err != nil {
+		return models.StockCursor{}, ErrInvalidCursor
+	}
+
+	var cursor models.StockCursor
+	if err := json.Unmarshal(data, &cursor); err != nil {
+		return models.StockCursor{}, ErrInvalidCursor
+	}
+	if cursor.Id == uuid.Nil || cursor.DateTime.IsZero() {
+		return models.StockCursor{}, ErrInvalidCursor
+	}
+
+	return cursor, nil
+}
@@ -0,0 +1,56 @@
+package pagination
+
+import (
+	"testing"
+	"time"
+
+	"github.com/K1tten2005/avito_pvz/internal/models"
+	"github.com/satori/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCursorRoundTrip(t *testing.T) {
+	cursor := models.PvzCursor{
+		RegistrationDate: time.Date(2025, 4, 10, 0, 0, 0, 0, time.UTC),
+		Id:               uuid.NewV4(),
+	}
+
+	decoded, err := DecodeCursor(EncodeCursor(cursor))
+	require.NoError(t, err)
+	assert.Equal(t, cursor.Id, decoded.Id)
+	assert.True(t, cursor.RegistrationDate.Equal(decoded.RegistrationDate))
+}
+
+func TestDecodeCursor_Invalid(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{"not base64", "!!!"},
+		{"not json", "bm90IGpzb24"},
+		{"empty object", "e30"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := DecodeCursor(tt.input)
+			assert.ErrorIs(t, err, ErrInvalidCursor)
+		})
+	}
+}
+
+func TestStockCursorRoundTrip(t *testing.T) {
+	cursor := models.StockCursor{
+		DateTime: time.Date(2025, 4, 10, 12, 30, 0, 123000, time.UTC),
+		Id:       uuid.NewV4(),
+	}
+
+	decoded, err := DecodeStockCursor(EncodeStockCursor(cursor))
+	require.NoError(t, err)
+	assert.Equal(t, cursor.Id, decoded.Id)
+	assert.True(t, cursor.DateTime.Equal(decoded.DateTime))
+
+	_, err = DecodeStockCursor("e30")
+	assert.ErrorIs(t, err, ErrInvalidCursor)
+}
@@ -0,0 +1,36 @@
+package pgerr
+
+import (
+	"errors"
+
+	"github.com/jackc/pgconn"
+)
+
+const (
+	uniqueViolation     = "23505"
+	foreignKeyViolation = "23503"
+)
+
+// IsForeignKeyViolation сообщает, ссылается ли запись на несуществующую строку
+func IsForeignKeyViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == foreignKeyViolation
+}
+
+// IsUniqueViolation сообщает, нарушено ли ограничение уникальности.
+// Если передан constraint, проверяется и имя ограничения.
+func IsUniqueViolation(err error, constraint ...string) bool {
+	var pgErr *pgconn.PgError
+	if !errors.As(err, &pgErr) || pgErr.Code != uniqueViolation {
+		return false
+	}
+	if len(constraint) == 0 {
+		return true
+	}
+	for _, name := range constraint {
+		if pgErr.ConstraintName == name {
+			return true
+		}
+	}
+	return false
+}
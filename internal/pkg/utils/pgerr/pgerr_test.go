@@ -0,0 +1,25 @@
+package pgerr
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/jackc/pgconn"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsUniqueViolation(t *testing.T) {
+	uniqueErr := &pgconn.PgError{Code: "23505", ConstraintName: "city_name_key"}
+
+	assert.True(t, IsUniqueViolation(uniqueErr))
+	assert.True(t, IsUniqueViolation(fmt.Errorf("wrapped: %w", uniqueErr), "city_name_key"))
+	assert.False(t, IsUniqueViolation(uniqueErr, "other_key"))
+	assert.False(t, IsUniqueViolation(&pgconn.PgError{Code: "23503"}))
+	assert.False(t, IsUniqueViolation(errors.New("plain error")))
+}
+
+func TestIsForeignKeyViolation(t *testing.T) {
+	assert.True(t, IsForeignKeyViolation(&pgconn.PgError{Code: "23503"}))
+	assert.False(t, IsForeignKeyViolation(&pgconn.PgError{Code: "23505"}))
+}
@@ -0,0 +1,56 @@
+package pgtx
+
+import (
+	"context"
+
+	"github.com/jackc/pgtype/pgxtype"
+	"github.com/jackc/pgx/v4"
+)
+
+// Conn — то, через что репозиторий выполняет запросы: пул или открытая транзакция
+type Conn interface {
+	pgxtype.Querier
+	Begin(ctx context.Context) (pgx.Tx, error)
+}
+
+// Pool — пул соединений, умеющий открывать транзакции с заданными параметрами
+type Pool interface {
+	Conn
+	BeginTx(ctx context.Context, txOptions pgx.TxOptions) (pgx.Tx, error)
+}
+
+type txKey struct{}
+
+// WithTx кладёт транзакцию в контекст, чтобы её подхватили все репозитории
+func WithTx(ctx context.Context, tx pgx.Tx) context.Context {
+	return context.WithValue(ctx, txKey{}, tx)
+}
+
+// FromContext возвращает транзакцию из контекста или fallback, если её нет
+func FromContext(ctx context.Context, fallback Conn) Conn {
+	if tx, ok := ctx.Value(txKey{}).(pgx.Tx); ok {
+		return tx
+	}
+	return fallback
+}
+
+// RunInTx выполняет fn в транзакции. Если транзакция уже открыта выше по стеку,
+// fn присоединяется к ней, а фиксирует изменения внешний вызов.
+func RunInTx(ctx context.Context, pool Pool, fn func(ctx context.Context) error) error {
+	if _, ok := ctx.Value(txKey{}).(pgx.Tx); ok {
+		return fn(ctx)
+	}
+
+	tx, err := pool.BeginTx(ctx, pgx.TxOptions{IsoLevel: pgx.ReadCommitted})
+	if err != nil {
+		return err
+	}
+	// после Commit откат вернёт ErrTxClosed, его можно игнорировать
+	defer tx.Rollback(ctx)
+
+	if err := fn(WithTx(ctx, tx)); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
@@ -0,0 +1,34 @@
+package pgtx
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeTx struct {
+	pgx.Tx
+}
+
+func TestFromContext(t *testing.T) {
+	tx := &fakeTx{}
+
+	assert.Nil(t, FromContext(context.Background(), nil))
+	assert.Equal(t, Conn(tx), FromContext(WithTx(context.Background(), tx), nil))
+}
+
+func TestRunInTx_JoinsOuterTx(t *testing.T) {
+	tx := &fakeTx{}
+	ctx := WithTx(context.Background(), tx)
+	expectedErr := errors.New("inner error")
+
+	// пул не нужен: внутренний вызов должен переиспользовать открытую транзакцию
+	err := RunInTx(ctx, nil, func(ctx context.Context) error {
+		assert.Equal(t, Conn(tx), FromContext(ctx, nil))
+		return expectedErr
+	})
+	assert.ErrorIs(t, err, expectedErr)
+}
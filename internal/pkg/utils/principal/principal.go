@@ -0,0 +1,31 @@
+package principal
+
+import (
+	"context"
+
+	"github.com/K1tten2005/avito_pvz/internal/models"
+	"github.com/satori/uuid"
+)
+
+type principalKey struct{}
+
+// WithPrincipal кладёт в контекст пользователя, прошедшего проверку доступа
+func WithPrincipal(ctx context.Context, p models.Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, p)
+}
+
+// FromContext возвращает пользователя запроса, если ACL положил его в контекст
+func FromContext(ctx context.Context) (models.Principal, bool) {
+	p, ok := ctx.Value(principalKey{}).(models.Principal)
+	return p, ok
+}
+
+// UserID возвращает id пользователя запроса или nil, если он неизвестен:
+// токен выдан до появления claim sub или получен через dummyLogin
+func UserID(ctx context.Context) *uuid.UUID {
+	p, ok := FromContext(ctx)
+	if !ok || p.UserId == uuid.Nil {
+		return nil
+	}
+	return &p.UserId
+}
@@ -0,0 +1,31 @@
+package principal
+
+import (
+	"context"
+	"testing"
+
+	"github.com/K1tten2005/avito_pvz/internal/models"
+	"github.com/satori/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFromContext(t *testing.T) {
+	_, ok := FromContext(context.Background())
+	assert.False(t, ok)
+
+	p := models.Principal{UserId: uuid.NewV4(), Email: "employee@mail.ru", Role: models.RoleEmployee}
+	got, ok := FromContext(WithPrincipal(context.Background(), p))
+	require.True(t, ok)
+	assert.Equal(t, p, got)
+}
+
+func TestUserID(t *testing.T) {
+	assert.Nil(t, UserID(context.Background()))
+	assert.Nil(t, UserID(WithPrincipal(context.Background(), models.Principal{Role: models.RoleEmployee})))
+
+	userID := uuid.NewV4()
+	got := UserID(WithPrincipal(context.Background(), models.Principal{UserId: userID, Role: models.RoleEmployee}))
+	require.NotNil(t, got)
+	assert.Equal(t, userID, *got)
+}
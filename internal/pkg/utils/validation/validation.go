@@ -3,6 +3,8 @@ package validation
 import (
 	"bytes"
 	"regexp"
+	"strings"
+	"sync"
 	"unicode"
 
 	"github.com/K1tten2005/avito_pvz/internal/models"
@@ -16,33 +18,191 @@ const (
 	maxPassLength  = 25
 )
 
-var AllowedCities = []string{
-	"Москва",
-	"Санкт Петербург",
-	"Казань",
+// productTypeCatalog хранит кэш активных кодов типов товаров из БД
+var productTypeCatalog = struct {
+	sync.RWMutex
+	codes map[string]struct{}
+}{codes: map[string]struct{}{}}
+
+func SetProductTypes(productTypes []models.ProductType) {
+	codes := make(map[string]struct{}, len(productTypes))
+	for _, productType := range productTypes {
+		if productType.Active {
+			codes[productType.Code] = struct{}{}
+		}
+	}
+
+	productTypeCatalog.Lock()
+	productTypeCatalog.codes = codes
+	productTypeCatalog.Unlock()
+}
+
+func IsValidProductType(productType string) bool {
+	productTypeCatalog.RLock()
+	defer productTypeCatalog.RUnlock()
+
+	_, ok := productTypeCatalog.codes[productType]
+	return ok
 }
 
-var AllowedProductTypes = []string{
-	"электроника",
-	"одежда",
-	"обувь",
+// cityCatalog хранит кэш справочника городов: нормализованное название
+// или синоним -> каноническое название. Кэш наполняется из БД через SetCities.
+var cityCatalog = struct {
+	sync.RWMutex
+	names map[string]string
+}{names: map[string]string{}}
+
+// CityKey приводит название города к виду, в котором сравниваются названия и синонимы
+func CityKey(city string) string {
+	return strings.ToLower(strings.Join(strings.Fields(city), " "))
 }
 
-func IsValidProductType (productType string) bool {
-	for _, val := range AllowedProductTypes {
-		if productType == val {
-			return true
+func SetCities(cities []models.City) {
+	names := make(map[string]string, len(cities))
+	for _, city := range cities {
+		if !city.Enabled {
+			continue
+		}
+		names[CityKey(city.Name)] = city.Name
+		for _, alias := range city.Aliases {
+			names[CityKey(alias)] = city.Name
 		}
 	}
-	return false
+
+	cityCatalog.Lock()
+	cityCatalog.names = names
+	cityCatalog.Unlock()
+}
+
+// NormalizeCity возвращает каноническое название города по названию или синониму
+func NormalizeCity(city string) (string, bool) {
+	cityCatalog.RLock()
+	defer cityCatalog.RUnlock()
+
+	name, ok := cityCatalog.names[CityKey(city)]
+	return name, ok
 }
 
 func IsValidCity(city string) bool {
-	for _, val := range AllowedCities {
-		if city == val {
-			return true
+	_, ok := NormalizeCity(city)
+	return ok
+}
+
+const (
+	ean13Length       = 13
+	maxCode128Length  = 80
+	code128StartBCode = 104
+	code128Modulo     = 103
+)
+
+// DetectBarcodeFormat определяет формат штрихкода, если клиент его не указал
+func DetectBarcodeFormat(code string) string {
+	if len(code) == ean13Length && isDigits(code) {
+		return models.BarcodeFormatEAN13
+	}
+	return models.BarcodeFormatCode128
+}
+
+// IsValidEAN13 проверяет длину и контрольную цифру EAN-13
+func IsValidEAN13(code string) bool {
+	if len(code) != ean13Length || !isDigits(code) {
+		return false
+	}
+
+	sum := 0
+	for i := 0; i < ean13Length-1; i++ {
+		digit := int(code[i] - '0')
+		if i%2 == 1 {
+			digit *= 3
+		}
+		sum += digit
+	}
+	check := (10 - sum%10) % 10
+	return check == int(code[ean13Length-1]-'0')
+}
+
+// Code128Checksum считает контрольный символ Code128 (набор B) для данных штрихкода
+func Code128Checksum(data string) int {
+	sum := code128StartBCode
+	for i := 0; i < len(data); i++ {
+		sum += (i + 1) * int(data[i]-' ')
+	}
+	return sum % code128Modulo
+}
+
+// IsValidCode128 проверяет, что данные кодируются набором B Code128 и сходятся
+// с контрольным символом. Без контрольного символа штрихкод не проверить, поэтому он обязателен.
+func IsValidCode128(data string, checksum *int) bool {
+	if checksum == nil || len(data) == 0 || len(data) > maxCode128Length {
+		return false
+	}
+	for i := 0; i < len(data); i++ {
+		if data[i] < ' ' || data[i] > '~' {
+			return false
+		}
+	}
+	return *checksum == Code128Checksum(data)
+}
+
+func IsValidBarcode(format, code string, checksum *int) bool {
+	switch format {
+	case models.BarcodeFormatEAN13:
+		return IsValidEAN13(code)
+	case models.BarcodeFormatCode128:
+		return IsValidCode128(code, checksum)
+	default:
+		return false
+	}
+}
+
+func isDigits(s string) bool {
+	for _, char := range s {
+		if char < '0' || char > '9' {
+			return false
 		}
 	}
+	return true
+}
+
+func IsValidPvzStatus(status string) bool {
+	return status == models.PvzStatusActive || status == models.PvzStatusSuspended || status == models.PvzStatusClosed
+}
+
+// IsValidCoordinates проверяет, что широта и долгота либо обе заданы и лежат в допустимых пределах, либо обе пусты
+func IsValidCoordinates(latitude, longitude *float64) bool {
+	if latitude == nil || longitude == nil {
+		return latitude == nil && longitude == nil
+	}
+	return *latitude >= -90 && *latitude <= 90 && *longitude >= -180 && *longitude <= 180
+}
+
+func IsValidDeleteReason(reason string) bool {
+	switch reason {
+	case models.DeleteReasonMisScan, models.DeleteReasonDuplicate, models.DeleteReasonDamaged, models.DeleteReasonOther:
+		return true
+	}
+	return false
+}
+
+const pickupCodeLength = 6
+
+func IsValidPickupCode(code string) bool {
+	return len(code) == pickupCodeLength && isDigits(code)
+}
+
+func IsValidProductStatus(status string) bool {
+	switch status {
+	case models.ProductStatusReceived, models.ProductStatusReadyForPickup, models.ProductStatusIssued, models.ProductStatusReturned:
+		return true
+	}
+	return false
+}
+
+func IsValidReturnReason(reason string) bool {
+	switch reason {
+	case models.ReturnReasonNotCollected, models.ReturnReasonRefused, models.ReturnReasonDamaged, models.ReturnReasonOther:
+		return true
+	}
 	return false
 }
 
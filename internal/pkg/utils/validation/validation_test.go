@@ -7,6 +7,12 @@ import (
 )
 
 func TestIsValidProductType(t *testing.T) {
+	SetProductTypes([]models.ProductType{
+		{Code: "электроника", Active: true},
+		{Code: "обувь", Active: true},
+		{Code: "мебель", Active: false},
+	})
+
 	tests := []struct {
 		input string
 		want  bool
@@ -14,6 +20,7 @@ func TestIsValidProductType(t *testing.T) {
 		{"электроника", true},
 		{"обувь", true},
 		{"мебель", false},
+		{"одежда", false},
 	}
 
 	for _, tt := range tests {
@@ -24,12 +31,19 @@ func TestIsValidProductType(t *testing.T) {
 }
 
 func TestIsValidCity(t *testing.T) {
+	SetCities([]models.City{
+		{Name: "Москва", Enabled: true},
+		{Name: "Санкт-Петербург", Aliases: []string{"Санкт Петербург"}, Enabled: true},
+		{Name: "Новосибирск", Enabled: false},
+	})
+
 	tests := []struct {
 		input string
 		want  bool
 	}{
 		{"Москва", true},
 		{"Санкт Петербург", true},
+		{"санкт-петербург", true},
 		{"Новосибирск", false},
 	}
 
@@ -40,6 +54,90 @@ func TestIsValidCity(t *testing.T) {
 	}
 }
 
+func TestNormalizeCity(t *testing.T) {
+	SetCities([]models.City{
+		{Name: "Санкт-Петербург", Aliases: []string{"Санкт Петербург", "СПб"}, Enabled: true},
+	})
+
+	name, ok := NormalizeCity("  спб ")
+	if !ok || name != "Санкт-Петербург" {
+		t.Errorf("NormalizeCity(%q) = %q, %v; want %q, true", "  спб ", name, ok, "Санкт-Петербург")
+	}
+}
+
+func TestIsValidEAN13(t *testing.T) {
+	tests := []struct {
+		input string
+		want  bool
+	}{
+		{"4006381333931", true},
+		{"4600051000057", true},
+		{"4006381333932", false},
+		{"400638133393", false},
+		{"40063813339a1", false},
+	}
+
+	for _, tt := range tests {
+		if got := IsValidEAN13(tt.input); got != tt.want {
+			t.Errorf("IsValidEAN13(%q) = %v; want %v", tt.input, got, tt.want)
+		}
+	}
+}
+
+func TestIsValidCode128(t *testing.T) {
+	// (104 + Σ позиция * значение символа) mod 103 для "Wikipedia" равно 88
+	valid, invalid := 88, 87
+
+	tests := []struct {
+		input    string
+		checksum *int
+		want     bool
+	}{
+		{"Wikipedia", &valid, true},
+		{"Wikipedia", nil, false},
+		{"Wikipedia", &invalid, false},
+		{"", &valid, false},
+		{"bad\x01code", &valid, false},
+	}
+
+	for _, tt := range tests {
+		if got := IsValidCode128(tt.input, tt.checksum); got != tt.want {
+			t.Errorf("IsValidCode128(%q) = %v; want %v", tt.input, got, tt.want)
+		}
+	}
+}
+
+func TestDetectBarcodeFormat(t *testing.T) {
+	if got := DetectBarcodeFormat("4006381333931"); got != models.BarcodeFormatEAN13 {
+		t.Errorf("DetectBarcodeFormat() = %q; want %q", got, models.BarcodeFormatEAN13)
+	}
+	if got := DetectBarcodeFormat("ORDER-42"); got != models.BarcodeFormatCode128 {
+		t.Errorf("DetectBarcodeFormat() = %q; want %q", got, models.BarcodeFormatCode128)
+	}
+}
+
+func TestIsValidCoordinates(t *testing.T) {
+	coord := func(v float64) *float64 { return &v }
+	tests := []struct {
+		name     string
+		lat, lon *float64
+		want     bool
+	}{
+		{"both empty", nil, nil, true},
+		{"moscow", coord(55.7558), coord(37.6173), true},
+		{"bounds", coord(-90), coord(180), true},
+		{"only latitude", coord(55.7558), nil, false},
+		{"latitude out of range", coord(90.5), coord(37.6173), false},
+		{"longitude out of range", coord(55.7558), coord(-180.1), false},
+	}
+
+	for _, tt := range tests {
+		if got := IsValidCoordinates(tt.lat, tt.lon); got != tt.want {
+			t.Errorf("IsValidCoordinates(%s) = %v; want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
 func TestIsValidRole(t *testing.T) {
 	tests := []struct {
 		input string
@@ -95,11 +193,11 @@ func TestValidPassword(t *testing.T) {
 		want  bool
 	}{
 		{"Aa1!aaaa", true},
-		{"short1!", false},        
-		{"alllowercase1!", false}, 
-		{"ALLUPPERCASE1!", false}, 
-		{"NoSpecialChar1", false}, 
-		{"NoDigit!Aa", false},     
+		{"short1!", false},
+		{"alllowercase1!", false},
+		{"ALLUPPERCASE1!", false},
+		{"NoSpecialChar1", false},
+		{"NoDigit!Aa", false},
 	}
 
 	for _, tt := range tests {
@@ -0,0 +1,60 @@
+package xlsx
+
+import (
+	"archive/zip"
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func readPart(t *testing.T, book *zip.Reader, name string) string {
+	f, err := book.Open(name)
+	require.NoError(t, err)
+	defer f.Close()
+
+	data, err := io.ReadAll(f)
+	require.NoError(t, err)
+	return string(data)
+}
+
+func TestWriter(t *testing.T) {
+	var buf bytes.Buffer
+	w := CreateWriter(&buf)
+
+	require.NoError(t, w.AddSheet("Москва"))
+	require.NoError(t, w.WriteRow([]string{"id", "type"}))
+	require.NoError(t, w.WriteRow([]string{"1", "<обувь>"}))
+	require.NoError(t, w.AddSheet("москва"))
+	require.NoError(t, w.Close())
+
+	book, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	require.NoError(t, err)
+
+	workbook := readPart(t, book, "xl/workbook.xml")
+	assert.Contains(t, workbook, `name="Москва"`)
+	assert.Contains(t, workbook, `name="москва (2)"`)
+
+	sheet := readPart(t, book, "xl/worksheets/sheet1.xml")
+	assert.Contains(t, sheet, `<c r="B2" t="inlineStr"><is><t xml:space="preserve">&lt;обувь&gt;</t></is></c>`)
+	assert.Contains(t, readPart(t, book, "[Content_Types].xml"), "/xl/worksheets/sheet2.xml")
+}
+
+func TestWriter_RowWithoutSheet(t *testing.T) {
+	assert.ErrorIs(t, CreateWriter(io.Discard).WriteRow([]string{"x"}), ErrNoSheet)
+}
+
+func TestSheetName(t *testing.T) {
+	assert.Equal(t, "a_b_c", SheetName("a/b:c"))
+	assert.Equal(t, "Sheet", SheetName("  "))
+	assert.Len(t, []rune(SheetName("Санкт-Петербург Санкт-Петербург Санкт")), maxSheetNameLength)
+}
+
+func TestColumnName(t *testing.T) {
+	assert.Equal(t, "A", columnName(0))
+	assert.Equal(t, "Z", columnName(25))
+	assert.Equal(t, "AA", columnName(26))
+	assert.Equal(t, "AZ", columnName(51))
+}
@@ -0,0 +1,182 @@
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	"github.com/K1tten2005/avito_pvz/internal/models"
+	"github.com/K1tten2005/avito_pvz/internal/pkg/utils/logger"
+	"github.com/K1tten2005/avito_pvz/internal/pkg/utils/send_err"
+	"github.com/K1tten2005/avito_pvz/internal/pkg/webhook"
+	"github.com/gorilla/mux"
+	"github.com/mailru/easyjson"
+	"github.com/satori/uuid"
+)
+
+type WebhookHandler struct {
+	uc webhook.WebhookUsecase
+}
+
+func CreateWebhookHandler(uc webhook.WebhookUsecase) *WebhookHandler {
+	return &WebhookHandler{uc: uc}
+}
+
+func (h *WebhookHandler) GetWebhooks(w http.ResponseWriter, r *http.Request) {
+	loggerVar := logger.GetLoggerFromContext(r.Context()).With(slog.String("func", logger.GetFuncName()))
+
+	webhooks, err := h.uc.GetWebhooks(r.Context())
+	if err != nil {
+		logger.LogHandlerError(loggerVar, fmt.Errorf("error on a level below (usecase): %w", err), http.StatusInternalServerError)
+		send_err.SendError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	sendJSON(w, loggerVar, webhooks, http.StatusOK)
+}
+
+func (h *WebhookHandler) CreateWebhook(w http.ResponseWriter, r *http.Request) {
+	loggerVar := logger.GetLoggerFromContext(r.Context()).With(slog.String("func", logger.GetFuncName()))
+
+	var req models.WebhookReq
+	if err := easyjson.UnmarshalFromReader(r.Body, &req); err != nil {
+		logger.LogHandlerError(loggerVar, fmt.Errorf("error while parsing JSON: %w", err), http.StatusBadRequest)
+		send_err.SendError(w, "error while parsing JSON", http.StatusBadRequest)
+		return
+	}
+
+	created, err := h.uc.CreateWebhook(r.Context(), req)
+	if err != nil {
+		statusCode := errStatus(err)
+		logger.LogHandlerError(loggerVar, fmt.Errorf("error on a level below (usecase): %w", err), statusCode)
+		send_err.SendError(w, err.Error(), statusCode)
+		return
+	}
+
+	sendJSON(w, loggerVar, created, http.StatusCreated)
+}
+
+func (h *WebhookHandler) DeleteWebhook(w http.ResponseWriter, r *http.Request) {
+	loggerVar := logger.GetLoggerFromContext(r.Context()).With(slog.String("func", logger.GetFuncName()))
+
+	id, err := uuid.FromString(mux.Vars(r)["id"])
+	if err != nil {
+		logger.LogHandlerError(loggerVar, err, http.StatusBadRequest)
+		send_err.SendError(w, "wrong UUID format for id query parameter", http.StatusBadRequest)
+		return
+	}
+
+	if err := h.uc.DeleteWebhook(r.Context(), id); err != nil {
+		statusCode := errStatus(err)
+		logger.LogHandlerError(loggerVar, fmt.Errorf("error on a level below (usecase): %w", err), statusCode)
+		send_err.SendError(w, err.Error(), statusCode)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+	logger.LogHandlerInfo(loggerVar, "Successful", http.StatusNoContent)
+}
+
+func (h *WebhookHandler) GetDeliveries(w http.ResponseWriter, r *http.Request) {
+	loggerVar := logger.GetLoggerFromContext(r.Context()).With(slog.String("func", logger.GetFuncName()))
+
+	id, err := uuid.FromString(mux.Vars(r)["id"])
+	if err != nil {
+		logger.LogHandlerError(loggerVar, err, http.StatusBadRequest)
+		send_err.SendError(w, "wrong UUID format for id query parameter", http.StatusBadRequest)
+		return
+	}
+
+	deliveries, err := h.uc.GetDeliveries(r.Context(), id, r.URL.Query().Get("status"))
+	if err != nil {
+		statusCode := errStatus(err)
+		logger.LogHandlerError(loggerVar, fmt.Errorf("error on a level below (usecase): %w", err), statusCode)
+		send_err.SendError(w, err.Error(), statusCode)
+		return
+	}
+
+	sendJSON(w, loggerVar, deliveries, http.StatusOK)
+}
+
+func (h *WebhookHandler) GetDeadLetters(w http.ResponseWriter, r *http.Request) {
+	loggerVar := logger.GetLoggerFromContext(r.Context()).With(slog.String("func", logger.GetFuncName()))
+
+	deliveries, err := h.uc.GetDeadLetters(r.Context())
+	if err != nil {
+		logger.LogHandlerError(loggerVar, fmt.Errorf("error on a level below (usecase): %w", err), http.StatusInternalServerError)
+		send_err.SendError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	sendJSON(w, loggerVar, deliveries, http.StatusOK)
+}
+
+func (h *WebhookHandler) GetDelivery(w http.ResponseWriter, r *http.Request) {
+	loggerVar := logger.GetLoggerFromContext(r.Context()).With(slog.String("func", logger.GetFuncName()))
+
+	id, err := uuid.FromString(mux.Vars(r)["id"])
+	if err != nil {
+		logger.LogHandlerError(loggerVar, err, http.StatusBadRequest)
+		send_err.SendError(w, "wrong UUID format for id query parameter", http.StatusBadRequest)
+		return
+	}
+
+	delivery, err := h.uc.GetDelivery(r.Context(), id)
+	if err != nil {
+		statusCode := errStatus(err)
+		logger.LogHandlerError(loggerVar, fmt.Errorf("error on a level below (usecase): %w", err), statusCode)
+		send_err.SendError(w, err.Error(), statusCode)
+		return
+	}
+
+	sendJSON(w, loggerVar, delivery, http.StatusOK)
+}
+
+func (h *WebhookHandler) Redeliver(w http.ResponseWriter, r *http.Request) {
+	loggerVar := logger.GetLoggerFromContext(r.Context()).With(slog.String("func", logger.GetFuncName()))
+
+	id, err := uuid.FromString(mux.Vars(r)["id"])
+	if err != nil {
+		logger.LogHandlerError(loggerVar, err, http.StatusBadRequest)
+		send_err.SendError(w, "wrong UUID format for id query parameter", http.StatusBadRequest)
+		return
+	}
+
+	delivery, err := h.uc.Redeliver(r.Context(), id)
+	if err != nil {
+		statusCode := errStatus(err)
+		logger.LogHandlerError(loggerVar, fmt.Errorf("error on a level below (usecase): %w", err), statusCode)
+		send_err.SendError(w, err.Error(), statusCode)
+		return
+	}
+
+	sendJSON(w, loggerVar, delivery, http.StatusAccepted)
+}
+
+func sendJSON(w http.ResponseWriter, loggerVar *slog.Logger, body any, statusCode int) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	if err := json.NewEncoder(w).Encode(body); err != nil {
+		logger.LogHandlerError(loggerVar, fmt.Errorf("error while forming JSON: %w", err), http.StatusInternalServerError)
+		send_err.SendError(w, "error while forming JSON", http.StatusInternalServerError)
+		return
+	}
+	logger.LogHandlerInfo(loggerVar, "Successful", statusCode)
+}
+
+func errStatus(err error) int {
+	switch {
+	case errors.Is(err, webhook.ErrWebhookNotFound), errors.Is(err, webhook.ErrDeliveryNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, webhook.ErrInvalidWebhookUrl), errors.Is(err, webhook.ErrInvalidEventType),
+		errors.Is(err, webhook.ErrInvalidScope), errors.Is(err, webhook.ErrInvalidCity),
+		errors.Is(err, webhook.ErrPvzNotFound), errors.Is(err, webhook.ErrInvalidStatus):
+		return http.StatusBadRequest
+	case errors.Is(err, webhook.ErrDeliveryNotDead):
+		return http.StatusConflict
+	default:
+		return http.StatusInternalServerError
+	}
+}
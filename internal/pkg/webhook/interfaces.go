@@ -0,0 +1,48 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/K1tten2005/avito_pvz/internal/models"
+	"github.com/satori/uuid"
+)
+
+var (
+	ErrWebhookNotFound    = errors.New("webhook not found")
+	ErrDeliveryNotFound   = errors.New("webhook delivery not found")
+	ErrDeliveryNotDead    = errors.New("only dead deliveries can be redelivered")
+	ErrInvalidWebhookUrl  = errors.New("invalid webhook url")
+	ErrInvalidEventType   = errors.New("invalid event type")
+	ErrInvalidScope       = errors.New("exactly one of pvzId or city must be set")
+	ErrInvalidCity        = errors.New("invalid city")
+	ErrPvzNotFound        = errors.New("pvz not found")
+	ErrInvalidStatus      = errors.New("invalid delivery status")
+	ErrUnexpectedResponse = errors.New("webhook receiver returned non-2xx status")
+)
+
+type WebhookRepo interface {
+	InsertWebhook(ctx context.Context, webhook models.Webhook) error
+	SelectWebhooks(ctx context.Context) ([]models.Webhook, error)
+	DeleteWebhook(ctx context.Context, id uuid.UUID) error
+	// EnqueueDeliveries создаёт доставки для всех подписок на событие; повтор события ничего не дублирует
+	EnqueueDeliveries(ctx context.Context, event models.Event, envelope []byte) (int64, error)
+	// ClaimDueDeliveries забирает созревшие доставки и продлевает их до leaseUntil, чтобы их не взял другой экземпляр
+	ClaimDueDeliveries(ctx context.Context, limit int, leaseUntil time.Time) ([]models.WebhookDelivery, error)
+	RecordAttempt(ctx context.Context, deliveryID uuid.UUID, attempt models.WebhookAttempt, status string, nextAttemptAt time.Time) error
+	SelectDeliveries(ctx context.Context, webhookID *uuid.UUID, status string, limit int) ([]models.WebhookDelivery, error)
+	SelectDelivery(ctx context.Context, id uuid.UUID) (models.WebhookDelivery, error)
+	SelectAttempts(ctx context.Context, deliveryID uuid.UUID) ([]models.WebhookAttempt, error)
+	RequeueDelivery(ctx context.Context, id uuid.UUID) error
+}
+
+type WebhookUsecase interface {
+	CreateWebhook(ctx context.Context, req models.WebhookReq) (models.Webhook, error)
+	GetWebhooks(ctx context.Context) ([]models.Webhook, error)
+	DeleteWebhook(ctx context.Context, id uuid.UUID) error
+	GetDeliveries(ctx context.Context, webhookID uuid.UUID, status string) ([]models.WebhookDelivery, error)
+	GetDeadLetters(ctx context.Context) ([]models.WebhookDelivery, error)
+	GetDelivery(ctx context.Context, id uuid.UUID) (models.WebhookDelivery, error)
+	Redeliver(ctx context.Context, id uuid.UUID) (models.WebhookDelivery, error)
+}
@@ -0,0 +1,324 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	_ "embed"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/K1tten2005/avito_pvz/internal/models"
+	"github.com/K1tten2005/avito_pvz/internal/pkg/utils/logger"
+	"github.com/K1tten2005/avito_pvz/internal/pkg/utils/pgerr"
+	"github.com/K1tten2005/avito_pvz/internal/pkg/utils/pgtx"
+	"github.com/K1tten2005/avito_pvz/internal/pkg/webhook"
+	"github.com/jackc/pgx/v4"
+	"github.com/satori/uuid"
+)
+
+//go:embed sql/insertWebhook.sql
+var insertWebhook string
+
+//go:embed sql/selectWebhooks.sql
+var selectWebhooks string
+
+//go:embed sql/deleteWebhook.sql
+var deleteWebhook string
+
+//go:embed sql/enqueueDeliveries.sql
+var enqueueDeliveries string
+
+//go:embed sql/claimDueDeliveries.sql
+var claimDueDeliveries string
+
+//go:embed sql/recordAttempt.sql
+var recordAttempt string
+
+//go:embed sql/selectDeliveries.sql
+var selectDeliveries string
+
+//go:embed sql/selectDelivery.sql
+var selectDelivery string
+
+//go:embed sql/selectAttempts.sql
+var selectAttempts string
+
+//go:embed sql/requeueDelivery.sql
+var requeueDelivery string
+
+type WebhookRepo struct {
+	db pgtx.Conn
+}
+
+func CreateWebhookRepo(db pgtx.Conn) *WebhookRepo {
+	return &WebhookRepo{
+		db: db,
+	}
+}
+
+// conn возвращает транзакцию из ctx: доставки ставятся в очередь в одной транзакции с отметкой события в outbox
+func (repo *WebhookRepo) conn(ctx context.Context) pgtx.Conn {
+	return pgtx.FromContext(ctx, repo.db)
+}
+
+func (repo *WebhookRepo) InsertWebhook(ctx context.Context, w models.Webhook) error {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	_, err := repo.conn(ctx).Exec(ctx, insertWebhook, w.Id, w.Url, w.Secret, w.EventTypes, w.PvzId, w.City, w.CreatedAt)
+	if pgerr.IsForeignKeyViolation(err) {
+		loggerVar.Error(webhook.ErrPvzNotFound.Error())
+		return webhook.ErrPvzNotFound
+	}
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return err
+	}
+
+	loggerVar.Info("Successful")
+	return nil
+}
+
+func (repo *WebhookRepo) SelectWebhooks(ctx context.Context) ([]models.Webhook, error) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	rows, err := repo.conn(ctx).Query(ctx, selectWebhooks)
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return nil, err
+	}
+	defer rows.Close()
+
+	result := []models.Webhook{}
+	for rows.Next() {
+		var (
+			w     models.Webhook
+			pvzID uuid.NullUUID
+			city  sql.NullString
+		)
+		if err := rows.Scan(&w.Id, &w.Url, &w.EventTypes, &pvzID, &city, &w.CreatedAt); err != nil {
+			loggerVar.Error(err.Error())
+			return nil, err
+		}
+		if pvzID.Valid {
+			w.PvzId = &pvzID.UUID
+		}
+		if city.Valid {
+			w.City = &city.String
+		}
+		result = append(result, w)
+	}
+
+	if err := rows.Err(); err != nil {
+		loggerVar.Error(err.Error())
+		return nil, err
+	}
+
+	loggerVar.Info("Successful")
+	return result, nil
+}
+
+func (repo *WebhookRepo) DeleteWebhook(ctx context.Context, id uuid.UUID) error {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	tag, err := repo.conn(ctx).Exec(ctx, deleteWebhook, id)
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		loggerVar.Error(webhook.ErrWebhookNotFound.Error())
+		return webhook.ErrWebhookNotFound
+	}
+
+	loggerVar.Info("Successful")
+	return nil
+}
+
+func (repo *WebhookRepo) EnqueueDeliveries(ctx context.Context, event models.Event, envelope []byte) (int64, error) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	tag, err := repo.conn(ctx).Exec(ctx, enqueueDeliveries, event.Id, event.Type, event.AggregateId, envelope)
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return 0, err
+	}
+
+	loggerVar.Info("Successful")
+	return tag.RowsAffected(), nil
+}
+
+func (repo *WebhookRepo) ClaimDueDeliveries(ctx context.Context, limit int, leaseUntil time.Time) ([]models.WebhookDelivery, error) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	rows, err := repo.conn(ctx).Query(ctx, claimDueDeliveries, limit, leaseUntil)
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return nil, err
+	}
+	defer rows.Close()
+
+	result := []models.WebhookDelivery{}
+	for rows.Next() {
+		var (
+			d       models.WebhookDelivery
+			payload []byte
+		)
+		if err := rows.Scan(&d.Id, &d.WebhookId, &d.EventId, &d.EventType, &payload, &d.Attempts, &d.Url, &d.Secret); err != nil {
+			loggerVar.Error(err.Error())
+			return nil, err
+		}
+		d.Payload = payload
+		d.Status = models.DeliveryStatusPending
+		d.NextAttemptAt = leaseUntil
+		result = append(result, d)
+	}
+
+	if err := rows.Err(); err != nil {
+		loggerVar.Error(err.Error())
+		return nil, err
+	}
+
+	loggerVar.Info("Successful")
+	return result, nil
+}
+
+func (repo *WebhookRepo) RecordAttempt(ctx context.Context, deliveryID uuid.UUID, attempt models.WebhookAttempt, status string, nextAttemptAt time.Time) error {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	_, err := repo.conn(ctx).Exec(ctx, recordAttempt,
+		deliveryID, attempt.AttemptedAt, attempt.StatusCode, attempt.Error, attempt.DurationMs,
+		status, nextAttemptAt,
+	)
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return err
+	}
+
+	loggerVar.Info("Successful")
+	return nil
+}
+
+func scanDelivery(row pgx.Row) (models.WebhookDelivery, error) {
+	var (
+		d              models.WebhookDelivery
+		lastStatusCode sql.NullInt32
+		lastError      sql.NullString
+		deliveredAt    sql.NullTime
+	)
+	err := row.Scan(&d.Id, &d.WebhookId, &d.EventId, &d.EventType, &d.Status, &d.Attempts, &d.NextAttemptAt,
+		&lastStatusCode, &lastError, &d.CreatedAt, &deliveredAt)
+	if err != nil {
+		return models.WebhookDelivery{}, err
+	}
+	if lastStatusCode.Valid {
+		code := int(lastStatusCode.Int32)
+		d.LastStatusCode = &code
+	}
+	d.LastError = lastError.String
+	if deliveredAt.Valid {
+		d.DeliveredAt = &deliveredAt.Time
+	}
+	return d, nil
+}
+
+func (repo *WebhookRepo) SelectDeliveries(ctx context.Context, webhookID *uuid.UUID, status string, limit int) ([]models.WebhookDelivery, error) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	rows, err := repo.conn(ctx).Query(ctx, selectDeliveries, webhookID, status, limit)
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return nil, err
+	}
+	defer rows.Close()
+
+	result := []models.WebhookDelivery{}
+	for rows.Next() {
+		d, err := scanDelivery(rows)
+		if err != nil {
+			loggerVar.Error(err.Error())
+			return nil, err
+		}
+		result = append(result, d)
+	}
+
+	if err := rows.Err(); err != nil {
+		loggerVar.Error(err.Error())
+		return nil, err
+	}
+
+	loggerVar.Info("Successful")
+	return result, nil
+}
+
+func (repo *WebhookRepo) SelectDelivery(ctx context.Context, id uuid.UUID) (models.WebhookDelivery, error) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	d, err := scanDelivery(repo.conn(ctx).QueryRow(ctx, selectDelivery, id))
+	if errors.Is(err, pgx.ErrNoRows) {
+		loggerVar.Error(webhook.ErrDeliveryNotFound.Error())
+		return models.WebhookDelivery{}, webhook.ErrDeliveryNotFound
+	}
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return models.WebhookDelivery{}, err
+	}
+
+	loggerVar.Info("Successful")
+	return d, nil
+}
+
+func (repo *WebhookRepo) SelectAttempts(ctx context.Context, deliveryID uuid.UUID) ([]models.WebhookAttempt, error) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	rows, err := repo.conn(ctx).Query(ctx, selectAttempts, deliveryID)
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return nil, err
+	}
+	defer rows.Close()
+
+	result := []models.WebhookAttempt{}
+	for rows.Next() {
+		var (
+			a          models.WebhookAttempt
+			statusCode sql.NullInt32
+			errText    sql.NullString
+		)
+		if err := rows.Scan(&a.AttemptedAt, &statusCode, &errText, &a.DurationMs); err != nil {
+			loggerVar.Error(err.Error())
+			return nil, err
+		}
+		if statusCode.Valid {
+			code := int(statusCode.Int32)
+			a.StatusCode = &code
+		}
+		a.Error = errText.String
+		result = append(result, a)
+	}
+
+	if err := rows.Err(); err != nil {
+		loggerVar.Error(err.Error())
+		return nil, err
+	}
+
+	loggerVar.Info("Successful")
+	return result, nil
+}
+
+func (repo *WebhookRepo) RequeueDelivery(ctx context.Context, id uuid.UUID) error {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	tag, err := repo.conn(ctx).Exec(ctx, requeueDelivery, id)
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		loggerVar.Error(webhook.ErrDeliveryNotDead.Error())
+		return webhook.ErrDeliveryNotDead
+	}
+
+	loggerVar.Info("Successful")
+	return nil
+}
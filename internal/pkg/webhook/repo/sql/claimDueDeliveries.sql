@@ -0,0 +1,15 @@
+WITH due AS (
+    SELECT id
+    FROM webhook_delivery
+    WHERE status = 'pending' AND next_attempt_at <= now()
+    ORDER BY next_attempt_at
+    LIMIT $1
+    FOR UPDATE SKIP LOCKED
+)
+UPDATE webhook_delivery
+SET next_attempt_at = $2
+FROM due, webhook
+WHERE webhook_delivery.id = due.id AND webhook.id = webhook_delivery.webhook_id
+RETURNING webhook_delivery.id, webhook_delivery.webhook_id, webhook_delivery.event_id,
+    webhook_delivery.event_type, webhook_delivery.payload, webhook_delivery.attempts,
+    webhook.url, webhook.secret
@@ -0,0 +1,7 @@
+INSERT INTO webhook_delivery (id, webhook_id, event_id, event_type, payload)
+SELECT uuid_generate_v4(), webhook.id, $1, $2, $4
+FROM webhook
+LEFT JOIN pvz ON pvz.id = $3
+WHERE $2 = ANY(webhook.event_types)
+    AND (webhook.pvz_id = $3 OR webhook.city = pvz.city)
+ON CONFLICT (webhook_id, event_id) DO NOTHING
@@ -0,0 +1,2 @@
+INSERT INTO webhook (id, url, secret, event_types, pvz_id, city, created_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
@@ -0,0 +1,12 @@
+WITH attempt AS (
+    INSERT INTO webhook_attempt (delivery_id, attempted_at, status_code, error, duration_ms)
+    VALUES ($1, $2, $3, NULLIF($4, ''), $5)
+)
+UPDATE webhook_delivery
+SET attempts = attempts + 1,
+    status = $6::webhook_delivery_status,
+    next_attempt_at = $7,
+    last_status_code = $3,
+    last_error = NULLIF($4, ''),
+    delivered_at = CASE WHEN $6::webhook_delivery_status = 'delivered' THEN $2 ELSE delivered_at END
+WHERE id = $1
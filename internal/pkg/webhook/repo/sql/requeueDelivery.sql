@@ -0,0 +1,3 @@
+UPDATE webhook_delivery
+SET status = 'pending', attempts = 0, next_attempt_at = now()
+WHERE id = $1 AND status = 'dead'
@@ -0,0 +1,4 @@
+SELECT attempted_at, status_code, error, duration_ms
+FROM webhook_attempt
+WHERE delivery_id = $1
+ORDER BY attempted_at, id
@@ -0,0 +1,7 @@
+SELECT id, webhook_id, event_id, event_type, status, attempts, next_attempt_at,
+    last_status_code, last_error, created_at, delivered_at
+FROM webhook_delivery
+WHERE ($1::uuid IS NULL OR webhook_id = $1)
+    AND ($2 = '' OR status = $2::webhook_delivery_status)
+ORDER BY created_at DESC, id
+LIMIT $3
@@ -0,0 +1,4 @@
+SELECT id, webhook_id, event_id, event_type, status, attempts, next_attempt_at,
+    last_status_code, last_error, created_at, delivered_at
+FROM webhook_delivery
+WHERE id = $1
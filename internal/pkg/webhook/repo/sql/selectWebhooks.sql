@@ -0,0 +1,3 @@
+SELECT id, url, event_types, pvz_id, city, created_at
+FROM webhook
+ORDER BY created_at, id
@@ -0,0 +1,337 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/K1tten2005/avito_pvz/internal/models"
+	"github.com/K1tten2005/avito_pvz/internal/pkg/utils/logger"
+	"github.com/K1tten2005/avito_pvz/internal/pkg/utils/validation"
+	"github.com/K1tten2005/avito_pvz/internal/pkg/webhook"
+	"github.com/mailru/easyjson"
+	"github.com/satori/uuid"
+)
+
+const (
+	HeaderEvent     = "X-Pvz-Event"
+	HeaderDelivery  = "X-Pvz-Delivery"
+	HeaderTimestamp = "X-Pvz-Timestamp"
+	HeaderSignature = "X-Pvz-Signature"
+
+	secretLength     = 32
+	maxDeliveries    = 100
+	maxErrorLength   = 500
+	signaturePrefix  = "sha256="
+	maxResponseBytes = 64 << 10
+)
+
+// Config — параметры доставки вебхуков
+type Config struct {
+	// MaxAttempts — после стольких неудачных попыток доставка уходит в dead letters
+	MaxAttempts int
+	// BaseBackoff и MaxBackoff задают экспоненциальную задержку между попытками
+	BaseBackoff time.Duration
+	MaxBackoff  time.Duration
+	// BatchSize — сколько доставок диспетчер забирает за один тик
+	BatchSize int
+	// Lease — на сколько доставка резервируется за диспетчером; должен покрывать отправку всей пачки
+	Lease time.Duration
+}
+
+type WebhookUsecase struct {
+	repo   webhook.WebhookRepo
+	client *http.Client
+	cfg    Config
+}
+
+func CreateWebhookUsecase(repo webhook.WebhookRepo, client *http.Client, cfg Config) *WebhookUsecase {
+	return &WebhookUsecase{repo: repo, client: client, cfg: cfg}
+}
+
+// SignPayload считает подпись, которую получатель сверяет с заголовком X-Pvz-Signature
+func SignPayload(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte{'.'})
+	mac.Write(body)
+	return signaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
+
+func (uc *WebhookUsecase) CreateWebhook(ctx context.Context, req models.WebhookReq) (models.Webhook, error) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	hook, err := newWebhook(req)
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return models.Webhook{}, err
+	}
+
+	secret := make([]byte, secretLength)
+	if _, err := rand.Read(secret); err != nil {
+		loggerVar.Error(err.Error())
+		return models.Webhook{}, err
+	}
+	hook.Secret = hex.EncodeToString(secret)
+
+	if err := uc.repo.InsertWebhook(ctx, hook); err != nil {
+		loggerVar.Error(err.Error())
+		return models.Webhook{}, err
+	}
+
+	loggerVar.Info("Success")
+	return hook, nil
+}
+
+func newWebhook(req models.WebhookReq) (models.Webhook, error) {
+	target, err := url.Parse(req.Url)
+	if err != nil || (target.Scheme != "http" && target.Scheme != "https") || target.Host == "" {
+		return models.Webhook{}, webhook.ErrInvalidWebhookUrl
+	}
+
+	if len(req.EventTypes) == 0 {
+		return models.Webhook{}, webhook.ErrInvalidEventType
+	}
+	eventTypes := slices.Clone(req.EventTypes)
+	for _, eventType := range eventTypes {
+		if !slices.Contains(models.WebhookEventTypes, eventType) {
+			return models.Webhook{}, fmt.Errorf("%w: %s", webhook.ErrInvalidEventType, eventType)
+		}
+	}
+	slices.Sort(eventTypes)
+	eventTypes = slices.Compact(eventTypes)
+
+	if (req.PvzId == nil) == (req.City == nil) {
+		return models.Webhook{}, webhook.ErrInvalidScope
+	}
+
+	hook := models.Webhook{
+		Id:         uuid.NewV4(),
+		Url:        req.Url,
+		EventTypes: eventTypes,
+		PvzId:      req.PvzId,
+		CreatedAt:  time.Now(),
+	}
+	if req.City != nil {
+		city, ok := validation.NormalizeCity(*req.City)
+		if !ok {
+			return models.Webhook{}, webhook.ErrInvalidCity
+		}
+		hook.City = &city
+	}
+	return hook, nil
+}
+
+func (uc *WebhookUsecase) GetWebhooks(ctx context.Context) ([]models.Webhook, error) {
+	return uc.repo.SelectWebhooks(ctx)
+}
+
+func (uc *WebhookUsecase) DeleteWebhook(ctx context.Context, id uuid.UUID) error {
+	return uc.repo.DeleteWebhook(ctx, id)
+}
+
+func (uc *WebhookUsecase) GetDeliveries(ctx context.Context, webhookID uuid.UUID, status string) ([]models.WebhookDelivery, error) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	switch status {
+	case "", models.DeliveryStatusPending, models.DeliveryStatusDelivered, models.DeliveryStatusDead:
+	default:
+		loggerVar.Error(webhook.ErrInvalidStatus.Error())
+		return nil, webhook.ErrInvalidStatus
+	}
+
+	deliveries, err := uc.repo.SelectDeliveries(ctx, &webhookID, status, maxDeliveries)
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return nil, err
+	}
+
+	loggerVar.Info("Success")
+	return deliveries, nil
+}
+
+func (uc *WebhookUsecase) GetDeadLetters(ctx context.Context) ([]models.WebhookDelivery, error) {
+	return uc.repo.SelectDeliveries(ctx, nil, models.DeliveryStatusDead, maxDeliveries)
+}
+
+func (uc *WebhookUsecase) GetDelivery(ctx context.Context, id uuid.UUID) (models.WebhookDelivery, error) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	delivery, err := uc.repo.SelectDelivery(ctx, id)
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return models.WebhookDelivery{}, err
+	}
+
+	delivery.History, err = uc.repo.SelectAttempts(ctx, id)
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return models.WebhookDelivery{}, err
+	}
+
+	loggerVar.Info("Success")
+	return delivery, nil
+}
+
+// Redeliver возвращает доставку из dead letters в очередь со свежим счётчиком попыток; история сохраняется
+func (uc *WebhookUsecase) Redeliver(ctx context.Context, id uuid.UUID) (models.WebhookDelivery, error) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	if _, err := uc.repo.SelectDelivery(ctx, id); err != nil {
+		loggerVar.Error(err.Error())
+		return models.WebhookDelivery{}, err
+	}
+
+	if err := uc.repo.RequeueDelivery(ctx, id); err != nil {
+		loggerVar.Error(err.Error())
+		return models.WebhookDelivery{}, err
+	}
+
+	delivery, err := uc.repo.SelectDelivery(ctx, id)
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return models.WebhookDelivery{}, err
+	}
+
+	loggerVar.Info("Success")
+	return delivery, nil
+}
+
+// Publish реализует outbox.Sink: событие раскладывается по подходящим подпискам,
+// а сама отправка идёт в диспетчере, чтобы медленный получатель не тормозил outbox
+func (uc *WebhookUsecase) Publish(ctx context.Context, events []models.Event) error {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	var enqueued int64
+	for _, event := range events {
+		envelope, err := easyjson.Marshal(event)
+		if err != nil {
+			loggerVar.Error(err.Error())
+			return err
+		}
+
+		count, err := uc.repo.EnqueueDeliveries(ctx, event, envelope)
+		if err != nil {
+			loggerVar.Error(err.Error())
+			return err
+		}
+		enqueued += count
+	}
+
+	loggerVar.Info("Success", slog.Int64("enqueued", enqueued))
+	return nil
+}
+
+// DispatchDue отправляет одну пачку созревших доставок и возвращает её размер
+func (uc *WebhookUsecase) DispatchDue(ctx context.Context) (int, error) {
+	loggerVar := logger.GetLoggerFromContext(ctx).With(slog.String("func", logger.GetFuncName()))
+
+	deliveries, err := uc.repo.ClaimDueDeliveries(ctx, uc.cfg.BatchSize, time.Now().Add(uc.cfg.Lease))
+	if err != nil {
+		loggerVar.Error(err.Error())
+		return 0, err
+	}
+	if len(deliveries) == 0 {
+		return 0, nil
+	}
+
+	for _, delivery := range deliveries {
+		attempt := uc.send(ctx, delivery)
+
+		status := models.DeliveryStatusDelivered
+		nextAttemptAt := attempt.AttemptedAt
+		if attempt.Error != "" {
+			status, nextAttemptAt = uc.retryAfter(delivery.Attempts+1, attempt.AttemptedAt)
+		}
+
+		if err := uc.repo.RecordAttempt(ctx, delivery.Id, attempt, status, nextAttemptAt); err != nil {
+			loggerVar.Error(err.Error())
+			return 0, err
+		}
+	}
+
+	loggerVar.Info("Success", slog.Int("dispatched", len(deliveries)))
+	return len(deliveries), nil
+}
+
+// retryAfter решает, что делать после неудачной попытки номер attempts
+func (uc *WebhookUsecase) retryAfter(attempts int, now time.Time) (string, time.Time) {
+	if attempts >= uc.cfg.MaxAttempts {
+		return models.DeliveryStatusDead, now
+	}
+
+	backoff := uc.cfg.BaseBackoff
+	for i := 1; i < attempts && backoff < uc.cfg.MaxBackoff; i++ {
+		backoff *= 2
+	}
+	return models.DeliveryStatusPending, now.Add(min(backoff, uc.cfg.MaxBackoff))
+}
+
+func (uc *WebhookUsecase) send(ctx context.Context, delivery models.WebhookDelivery) models.WebhookAttempt {
+	start := time.Now()
+	attempt := models.WebhookAttempt{AttemptedAt: start}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, delivery.Url, bytes.NewReader(delivery.Payload))
+	if err != nil {
+		attempt.Error = err.Error()
+		return attempt
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(HeaderEvent, delivery.EventType)
+	req.Header.Set(HeaderDelivery, delivery.Id.String())
+	req.Header.Set(HeaderTimestamp, strconv.FormatInt(start.Unix(), 10))
+	req.Header.Set(HeaderSignature, SignPayload(delivery.Secret, start.Unix(), delivery.Payload))
+
+	resp, err := uc.client.Do(req)
+	attempt.DurationMs = time.Since(start).Milliseconds()
+	if err != nil {
+		attempt.Error = truncate(err.Error())
+		return attempt
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, io.LimitReader(resp.Body, maxResponseBytes))
+
+	attempt.StatusCode = &resp.StatusCode
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		attempt.Error = fmt.Errorf("%w: %d", webhook.ErrUnexpectedResponse, resp.StatusCode).Error()
+	}
+	return attempt
+}
+
+func truncate(s string) string {
+	if len(s) <= maxErrorLength {
+		return s
+	}
+	return s[:maxErrorLength]
+}
+
+// RunDispatcher на каждом тике отправляет созревшие доставки, пока они идут полными пачками
+func (uc *WebhookUsecase) RunDispatcher(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			for {
+				dispatched, err := uc.DispatchDue(ctx)
+				if err != nil || dispatched < uc.cfg.BatchSize {
+					break
+				}
+			}
+		}
+	}
+}
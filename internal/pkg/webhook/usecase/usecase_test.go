@@ -0,0 +1,253 @@
+package usecase
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/K1tten2005/avito_pvz/internal/models"
+	"github.com/K1tten2005/avito_pvz/internal/pkg/utils/validation"
+	"github.com/K1tten2005/avito_pvz/internal/pkg/webhook"
+	"github.com/K1tten2005/avito_pvz/internal/pkg/webhook/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/satori/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testConfig = Config{
+	MaxAttempts: 3,
+	BaseBackoff: 10 * time.Second,
+	MaxBackoff:  time.Minute,
+	BatchSize:   10,
+	Lease:       time.Minute,
+}
+
+func TestWebhookUsecase_CreateWebhook(t *testing.T) {
+	validation.SetCities([]models.City{{Name: "Москва", Aliases: []string{"Msk"}, Enabled: true}})
+	pvzID := uuid.NewV4()
+	city := "msk"
+	unknownCity := "Тверь"
+
+	tests := []struct {
+		name         string
+		req          models.WebhookReq
+		mockBehavior func(repo *mocks.MockWebhookRepo)
+		expectedErr  error
+	}{
+		{
+			name: "pvz scope",
+			req: models.WebhookReq{
+				Url:        "https://partner.example/hook",
+				EventTypes: []string{models.EventReceptionClosed, models.EventReceptionClosed},
+				PvzId:      &pvzID,
+			},
+			mockBehavior: func(repo *mocks.MockWebhookRepo) {
+				repo.EXPECT().InsertWebhook(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, hook models.Webhook) error {
+						assert.Equal(t, []string{models.EventReceptionClosed}, hook.EventTypes)
+						assert.Len(t, hook.Secret, 2*secretLength)
+						return nil
+					})
+			},
+		},
+		{
+			name: "city scope is normalized",
+			req: models.WebhookReq{
+				Url:        "http://partner.example/hook",
+				EventTypes: []string{models.EventProductAdded},
+				City:       &city,
+			},
+			mockBehavior: func(repo *mocks.MockWebhookRepo) {
+				repo.EXPECT().InsertWebhook(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, hook models.Webhook) error {
+						assert.Equal(t, "Москва", *hook.City)
+						return nil
+					})
+			},
+		},
+		{
+			name:         "invalid url",
+			req:          models.WebhookReq{Url: "ftp://partner.example", EventTypes: []string{models.EventProductAdded}, PvzId: &pvzID},
+			mockBehavior: func(repo *mocks.MockWebhookRepo) {},
+			expectedErr:  webhook.ErrInvalidWebhookUrl,
+		},
+		{
+			name:         "unknown event type",
+			req:          models.WebhookReq{Url: "https://partner.example", EventTypes: []string{"pvz.deleted"}, PvzId: &pvzID},
+			mockBehavior: func(repo *mocks.MockWebhookRepo) {},
+			expectedErr:  webhook.ErrInvalidEventType,
+		},
+		{
+			name:         "both scopes",
+			req:          models.WebhookReq{Url: "https://partner.example", EventTypes: []string{models.EventProductAdded}, PvzId: &pvzID, City: &city},
+			mockBehavior: func(repo *mocks.MockWebhookRepo) {},
+			expectedErr:  webhook.ErrInvalidScope,
+		},
+		{
+			name:         "unknown city",
+			req:          models.WebhookReq{Url: "https://partner.example", EventTypes: []string{models.EventProductAdded}, City: &unknownCity},
+			mockBehavior: func(repo *mocks.MockWebhookRepo) {},
+			expectedErr:  webhook.ErrInvalidCity,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repo := mocks.NewMockWebhookRepo(ctrl)
+			tt.mockBehavior(repo)
+
+			created, err := CreateWebhookUsecase(repo, http.DefaultClient, testConfig).CreateWebhook(context.Background(), tt.req)
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.NotEmpty(t, created.Secret)
+		})
+	}
+}
+
+func TestWebhookUsecase_DispatchDue_SignsRequest(t *testing.T) {
+	const secret = "s3cret"
+	payload := []byte(`{"id":"e1","type":"reception.closed"}`)
+
+	received := make(chan *http.Request, 1)
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		timestamp, err := strconv.ParseInt(r.Header.Get(HeaderTimestamp), 10, 64)
+		require.NoError(t, err)
+		assert.Equal(t, SignPayload(secret, timestamp, body), r.Header.Get(HeaderSignature))
+		assert.Equal(t, payload, body)
+		received <- r
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer receiver.Close()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	delivery := models.WebhookDelivery{
+		Id:        uuid.NewV4(),
+		EventType: models.EventReceptionClosed,
+		Payload:   payload,
+		Url:       receiver.URL,
+		Secret:    secret,
+	}
+
+	repo := mocks.NewMockWebhookRepo(ctrl)
+	repo.EXPECT().ClaimDueDeliveries(gomock.Any(), 10, gomock.Any()).Return([]models.WebhookDelivery{delivery}, nil)
+	repo.EXPECT().RecordAttempt(gomock.Any(), delivery.Id, gomock.Any(), models.DeliveryStatusDelivered, gomock.Any()).DoAndReturn(
+		func(ctx context.Context, id uuid.UUID, attempt models.WebhookAttempt, status string, next time.Time) error {
+			require.NotNil(t, attempt.StatusCode)
+			assert.Equal(t, http.StatusNoContent, *attempt.StatusCode)
+			assert.Empty(t, attempt.Error)
+			return nil
+		})
+
+	dispatched, err := CreateWebhookUsecase(repo, receiver.Client(), testConfig).DispatchDue(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 1, dispatched)
+
+	r := <-received
+	assert.Equal(t, models.EventReceptionClosed, r.Header.Get(HeaderEvent))
+	assert.Equal(t, delivery.Id.String(), r.Header.Get(HeaderDelivery))
+}
+
+func TestWebhookUsecase_DispatchDue_Retries(t *testing.T) {
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer receiver.Close()
+
+	tests := []struct {
+		name           string
+		attempts       int
+		expectedStatus string
+		expectedDelay  time.Duration
+	}{
+		{name: "first failure", attempts: 0, expectedStatus: models.DeliveryStatusPending, expectedDelay: 10 * time.Second},
+		{name: "backoff doubles", attempts: 1, expectedStatus: models.DeliveryStatusPending, expectedDelay: 20 * time.Second},
+		{name: "attempts exhausted", attempts: 2, expectedStatus: models.DeliveryStatusDead},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			delivery := models.WebhookDelivery{Id: uuid.NewV4(), Attempts: tt.attempts, Url: receiver.URL, Payload: []byte(`{}`)}
+
+			repo := mocks.NewMockWebhookRepo(ctrl)
+			repo.EXPECT().ClaimDueDeliveries(gomock.Any(), 10, gomock.Any()).Return([]models.WebhookDelivery{delivery}, nil)
+			repo.EXPECT().RecordAttempt(gomock.Any(), delivery.Id, gomock.Any(), tt.expectedStatus, gomock.Any()).DoAndReturn(
+				func(ctx context.Context, id uuid.UUID, attempt models.WebhookAttempt, status string, next time.Time) error {
+					assert.Contains(t, attempt.Error, webhook.ErrUnexpectedResponse.Error())
+					assert.Equal(t, tt.expectedDelay, next.Sub(attempt.AttemptedAt))
+					return nil
+				})
+
+			_, err := CreateWebhookUsecase(repo, receiver.Client(), testConfig).DispatchDue(context.Background())
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestWebhookUsecase_Redeliver(t *testing.T) {
+	id := uuid.NewV4()
+
+	tests := []struct {
+		name         string
+		mockBehavior func(repo *mocks.MockWebhookRepo)
+		expectedErr  error
+	}{
+		{
+			name: "dead delivery is requeued",
+			mockBehavior: func(repo *mocks.MockWebhookRepo) {
+				repo.EXPECT().SelectDelivery(gomock.Any(), id).Return(models.WebhookDelivery{Id: id, Status: models.DeliveryStatusDead}, nil)
+				repo.EXPECT().RequeueDelivery(gomock.Any(), id).Return(nil)
+				repo.EXPECT().SelectDelivery(gomock.Any(), id).Return(models.WebhookDelivery{Id: id, Status: models.DeliveryStatusPending}, nil)
+			},
+		},
+		{
+			name: "not found",
+			mockBehavior: func(repo *mocks.MockWebhookRepo) {
+				repo.EXPECT().SelectDelivery(gomock.Any(), id).Return(models.WebhookDelivery{}, webhook.ErrDeliveryNotFound)
+			},
+			expectedErr: webhook.ErrDeliveryNotFound,
+		},
+		{
+			name: "not dead",
+			mockBehavior: func(repo *mocks.MockWebhookRepo) {
+				repo.EXPECT().SelectDelivery(gomock.Any(), id).Return(models.WebhookDelivery{Id: id, Status: models.DeliveryStatusPending}, nil)
+				repo.EXPECT().RequeueDelivery(gomock.Any(), id).Return(webhook.ErrDeliveryNotDead)
+			},
+			expectedErr: webhook.ErrDeliveryNotDead,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repo := mocks.NewMockWebhookRepo(ctrl)
+			tt.mockBehavior(repo)
+
+			delivery, err := CreateWebhookUsecase(repo, http.DefaultClient, testConfig).Redeliver(context.Background(), id)
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, models.DeliveryStatusPending, delivery.Status)
+		})
+	}
+}